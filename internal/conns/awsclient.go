@@ -11,18 +11,24 @@ import (
 	"math/rand" // nosemgrep: go.lang.security.audit.crypto.math_random.math-random-used -- Deterministic PRNG required for VCR test reproducibility
 	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"sync"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	apigatewayv2_types "github.com/aws/aws-sdk-go-v2/service/apigatewayv2/types"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/dns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/guardrails"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -30,16 +36,29 @@ import (
 
 type AWSClient struct {
 	accountID                 string
+	allowedRegions            []string                               // From provider configuration.
+	assumeRoles               []AssumeRole                           // From provider configuration.
+	assumeRoleCredentials     map[AssumeRole]aws.CredentialsProvider // Per-resource IAM role override -> credentials provider.
+	assumeRoleLock            sync.Mutex
 	awsConfig                 *aws.Config
-	clients                   map[string]map[string]any // Region -> service package name -> API client.
+	backupBeforeDestroyConfig *guardrails.BackupBeforeDestroyConfig
+	baseCredentialSource      string                    // Source of the credentials used to assume any IAM roles.
+	baseEndpoint              string                    // From provider configuration, environment variables or the shared configuration file.
+	clients                   map[string]map[string]any // Region, and any per-resource IAM role override, -> service package name -> API client.
 	defaultTagsConfig         *tftags.DefaultConfig
 	endpoints                 map[string]string // From provider configuration.
+	forbiddenRegions          []string          // From provider configuration.
+	guardrailsConfig          *guardrails.Config
 	httpClient                *http.Client
+	iamPolicyValidationConfig *iampolicy.ValidationConfig
 	ignoreTagsConfig          *tftags.IgnoreConfig
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
+	namingPolicy              *create.NamingPolicy
+	operationDefaults         []OperationDefaults // From provider configuration.
 	partition                 endpoints.Partition
 	randomnessSource          rand.Source // For VCR deterministic randomness.
+	regionOptStatuses         sync.Map    // Account ID and Region -> Region opt-in status.
 	servicePackages           map[string]ServicePackage
 	s3ExpressClient           *s3.Client
 	s3OriginalRegion          string // Original region for S3-compatible storage
@@ -71,17 +90,35 @@ func (c *AWSClient) TerraformVersion(_ context.Context) string {
 }
 
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
-func (c *AWSClient) CredentialsProvider(context.Context) aws.CredentialsProvider {
+// If the currently in-process operation has defined a per-resource IAM role override,
+// the credentials provider for that role is returned.
+func (c *AWSClient) CredentialsProvider(ctx context.Context) aws.CredentialsProvider {
 	if c.awsConfig == nil {
 		return nil
 	}
-	return c.awsConfig.Credentials
+	return c.effectiveAWSConfig(ctx).Credentials
+}
+
+func (c *AWSClient) BackupBeforeDestroyConfig(context.Context) *guardrails.BackupBeforeDestroyConfig {
+	return c.backupBeforeDestroyConfig
 }
 
 func (c *AWSClient) DefaultTagsConfig(context.Context) *tftags.DefaultConfig {
 	return c.defaultTagsConfig
 }
 
+func (c *AWSClient) GuardrailsConfig(context.Context) *guardrails.Config {
+	return c.guardrailsConfig
+}
+
+func (c *AWSClient) NamingPolicy(context.Context) *create.NamingPolicy {
+	return c.namingPolicy
+}
+
+func (c *AWSClient) IAMPolicyValidationConfig(context.Context) *iampolicy.ValidationConfig {
+	return c.iamPolicyValidationConfig
+}
+
 func (c *AWSClient) IgnoreTagsConfig(context.Context) *tftags.IgnoreConfig {
 	return c.ignoreTagsConfig
 }
@@ -90,12 +127,47 @@ func (c *AWSClient) TagPolicyConfig(context.Context) *tftags.TagPolicyConfig {
 	return c.tagPolicyConfig
 }
 
-func (c *AWSClient) AwsConfig(context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
-	return c.awsConfig.Copy()
+// BaseCredentialSource returns the source, as reported by the AWS SDK for Go v2, of the provider's base credentials.
+// The base credentials are used to assume the first of any IAM roles; without IAM roles they are the provider's credentials.
+func (c *AWSClient) BaseCredentialSource(context.Context) string {
+	return c.baseCredentialSource
 }
 
-// AccountID returns the configured AWS account ID.
-func (c *AWSClient) AccountID(context.Context) string {
+// AssumedRoles returns the IAM roles assumed, in order, to obtain the credentials in effect for the currently in-process operation.
+func (c *AWSClient) AssumedRoles(ctx context.Context) []AssumeRole {
+	roles := slices.Clone(c.assumeRoles)
+
+	if v := overrideAssumeRoleFromContext(ctx); v != nil {
+		roles = append(roles, *v)
+	}
+
+	return roles
+}
+
+// OperationTimeouts returns the provider-level default operation timeouts for the specified resource type.
+func (c *AWSClient) OperationTimeouts(_ context.Context, servicePackageName, typeName string) OperationTimeouts {
+	return operationTimeouts(c.operationDefaults, servicePackageName, typeName)
+}
+
+func (c *AWSClient) AwsConfig(ctx context.Context) aws.Config { // nosemgrep:ci.aws-in-func-name
+	return c.effectiveAWSConfig(ctx).Copy()
+}
+
+// AccountID returns the ID of the effective AWS account.
+// If the currently in-process operation has defined a per-resource IAM role override,
+// the ID of the account owning the role is returned, otherwise the configured account ID is returned.
+func (c *AWSClient) AccountID(ctx context.Context) string {
+	if v := overrideAssumeRoleFromContext(ctx); v != nil {
+		if v, err := arn.Parse(v.RoleARN); err == nil {
+			return v.AccountID
+		}
+	}
+
+	return c.accountID
+}
+
+// ProviderAccountID returns the ID of the provider's configured AWS account, ignoring any per-resource IAM role override.
+func (c *AWSClient) ProviderAccountID(context.Context) string {
 	return c.accountID
 }
 
@@ -334,19 +406,108 @@ func (c *AWSClient) EC2PublicDNSNameForIP(ctx context.Context, ip string) string
 	return c.PartitionHostname(ctx, fmt.Sprintf("ec2-%s.%s", convertIPToDashIP(ip), c.EC2RegionalPublicDNSSuffix(ctx)))
 }
 
-// ValidateInContextRegionInPartition verifies that the value of the top-level `region` attribute is in the configured AWS partition.
+// ValidateInContextRegionInPartition verifies that the value of the top-level `region` attribute is in the configured AWS partition,
+// is allowed by the provider's `allowed_regions` or `forbidden_regions` configuration and, where its opt-in status is available, is enabled.
 func (c *AWSClient) ValidateInContextRegionInPartition(ctx context.Context) error {
 	if inContext, ok := FromContext(ctx); ok {
-		if r, p := inContext.OverrideRegion(), c.Partition(ctx); r != "" && p != "" {
+		r := inContext.OverrideRegion()
+		if r == "" {
+			return nil
+		}
+
+		if p := c.Partition(ctx); p != "" {
 			if got, want := names.PartitionForRegion(r).ID(), p; got != want {
 				return fmt.Errorf("partition (%s) for per-resource Region (%s) is not the provider's configured partition (%s)", got, r, want)
 			}
 		}
+
+		if err := verifyRegionAllowed(r, c.allowedRegions, c.forbiddenRegions); err != nil {
+			return err
+		}
+
+		if err := c.verifyRegionEnabled(ctx, r); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
+// overrideAssumeRoleFromContext returns any currently in effect per-resource IAM role override.
+func overrideAssumeRoleFromContext(ctx context.Context) *AssumeRole {
+	if inContext, ok := FromContext(ctx); ok {
+		return inContext.OverrideAssumeRole()
+	}
+
+	return nil
+}
+
+// effectiveAWSConfig returns the AWS SDK for Go v2 configuration for the currently in-process operation.
+// If a per-resource IAM role override is in effect, the configuration's credentials are those of the assumed role.
+func (c *AWSClient) effectiveAWSConfig(ctx context.Context) *aws.Config {
+	v := overrideAssumeRoleFromContext(ctx)
+	if v == nil {
+		return c.awsConfig
+	}
+
+	cfg := c.awsConfig.Copy()
+	cfg.Credentials = c.assumeRoleCredentialsProvider(ctx, *v)
+	cfg = withExpiredCredentialsRetry(cfg)
+
+	return &cfg
+}
+
+// assumeRoleCredentialsProvider returns the cached credentials provider for the specified IAM role.
+// Roles are assumed using the provider's configured credentials. Credentials are retrieved on first use and refreshed before they expire.
+func (c *AWSClient) assumeRoleCredentialsProvider(ctx context.Context, assumeRole AssumeRole) aws.CredentialsProvider {
+	c.assumeRoleLock.Lock()
+	defer c.assumeRoleLock.Unlock()
+
+	if v, ok := c.assumeRoleCredentials[assumeRole]; ok {
+		return v
+	}
+
+	// The STS API client is created directly, rather than via the client cache, as the cache lock may be held by the caller.
+	conn := sts.NewFromConfig(*c.awsConfig, func(o *sts.Options) {
+		if c.stsRegion != "" {
+			o.Region = c.stsRegion
+		}
+		if v := c.endpoints[names.STS]; v != "" {
+			o.BaseEndpoint = aws.String(v)
+		}
+	})
+	provider := newRefreshingCredentialsCache(stscreds.NewAssumeRoleProvider(conn, assumeRole.RoleARN, func(o *stscreds.AssumeRoleOptions) {
+		if v := assumeRole.ExternalID; v != "" {
+			o.ExternalID = aws.String(v)
+		}
+		if v := assumeRole.SessionName; v != "" {
+			o.RoleSessionName = v
+		}
+	}))
+
+	tflog.Debug(ctx, "Creating per-resource assume role credentials provider", map[string]any{
+		"tf_aws.assume_role.role_arn": assumeRole.RoleARN,
+	})
+
+	if c.assumeRoleCredentials == nil {
+		c.assumeRoleCredentials = make(map[AssumeRole]aws.CredentialsProvider)
+	}
+	c.assumeRoleCredentials[assumeRole] = provider
+
+	return provider
+}
+
+// clientCacheKey returns the key under which default API clients are cached for the currently in-process operation.
+func (c *AWSClient) clientCacheKey(ctx context.Context) string {
+	key := c.Region(ctx)
+
+	if v := overrideAssumeRoleFromContext(ctx); v != nil {
+		key = strings.Join([]string{key, v.RoleARN, v.SessionName, v.ExternalID}, "|")
+	}
+
+	return key
+}
+
 func convertIPToDashIP(ip string) string {
 	return strings.Replace(ip, ".", "-", -1)
 }
@@ -354,13 +515,14 @@ func convertIPToDashIP(ip string) string {
 // apiClientConfig returns the AWS API client configuration parameters for the specified service.
 func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName string) map[string]any {
 	m := map[string]any{
-		"aws_sdkv2_config": c.awsConfig,
+		"aws_sdkv2_config": withRetryableErrorCodes(c.effectiveAWSConfig(ctx), retryableErrorCodes(c.operationDefaults, servicePackageName)),
 		"endpoint":         c.endpoints[servicePackageName],
 		"partition":        c.Partition(ctx),
 		"region":           c.Region(ctx),
 	}
 	switch servicePackageName {
 	case names.S3:
+		m["base_endpoint"] = c.baseEndpoint
 		m["s3_use_path_style"] = c.s3UsePathStyle
 		// AWS SDK for Go v2 does not use the AWS_S3_US_EAST_1_REGIONAL_ENDPOINT environment variable during configuration.
 		// For compatibility, read it now.
@@ -383,7 +545,7 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 // This function is not a method on `AWSClient` as methods can't be parameterized (https://go.googlesource.com/proposal/+/refs/heads/master/design/43651-type-parameters.md#no-parameterized-methods).
 func client[T any](ctx context.Context, c *AWSClient, servicePackageName string, extra map[string]any) (T, error) {
 	ctx = tflog.SetField(ctx, "tf_aws.service_package", servicePackageName)
-	region := c.Region(ctx)
+	key := c.clientCacheKey(ctx)
 
 	isDefault := len(extra) == 0
 	// Default service client is cached.
@@ -391,7 +553,7 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 		c.lock.Lock()
 		defer c.lock.Unlock() // Runs at function exit, NOT block.
 
-		if v, ok := c.clients[region]; ok {
+		if v, ok := c.clients[key]; ok {
 			if raw, ok := v[servicePackageName]; ok {
 				if client, ok := raw.(T); ok {
 					return client, nil
@@ -425,10 +587,10 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string,
 	// All customization for AWS SDK for Go v2 API clients must be done during construction.
 
 	if isDefault {
-		if _, ok := c.clients[region]; !ok {
-			c.clients[region] = make(map[string]any, 0)
+		if _, ok := c.clients[key]; !ok {
+			c.clients[key] = make(map[string]any, 0)
 		}
-		c.clients[region][servicePackageName] = client
+		c.clients[key][servicePackageName] = client
 	}
 
 	return client, nil
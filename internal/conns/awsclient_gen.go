@@ -26,7 +26,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/apprunner"
 	"github.com/aws/aws-sdk-go-v2/service/appstream"
 	"github.com/aws/aws-sdk-go-v2/service/appsync"
-	"github.com/aws/aws-sdk-go-v2/service/arcregionswitch"
 	"github.com/aws/aws-sdk-go-v2/service/arczonalshift"
 	"github.com/aws/aws-sdk-go-v2/service/athena"
 	"github.com/aws/aws-sdk-go-v2/service/auditmanager"
@@ -222,7 +221,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3outposts"
 	"github.com/aws/aws-sdk-go-v2/service/s3tables"
 	"github.com/aws/aws-sdk-go-v2/service/s3vectors"
-	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
 	"github.com/aws/aws-sdk-go-v2/service/savingsplans"
 	"github.com/aws/aws-sdk-go-v2/service/scheduler"
 	"github.com/aws/aws-sdk-go-v2/service/schemas"
@@ -266,7 +264,6 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
 	"github.com/aws/aws-sdk-go-v2/service/workmail"
 	"github.com/aws/aws-sdk-go-v2/service/workspaces"
-	"github.com/aws/aws-sdk-go-v2/service/workspacesweb"
 	"github.com/aws/aws-sdk-go-v2/service/xray"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -292,9 +289,6 @@ func (c *AWSClient) APIGatewayV2Client(ctx context.Context) *apigatewayv2.Client
 	return errs.Must(client[*apigatewayv2.Client](ctx, c, names.APIGatewayV2, make(map[string]any)))
 }
 
-func (c *AWSClient) ARCRegionSwitchClient(ctx context.Context) *arcregionswitch.Client {
-	return errs.Must(client[*arcregionswitch.Client](ctx, c, names.ARCRegionSwitch, make(map[string]any)))
-}
 
 func (c *AWSClient) ARCZonalShiftClient(ctx context.Context) *arczonalshift.Client {
 	return errs.Must(client[*arczonalshift.Client](ctx, c, names.ARCZonalShift, make(map[string]any)))
@@ -1188,9 +1182,6 @@ func (c *AWSClient) SWFClient(ctx context.Context) *swf.Client {
 	return errs.Must(client[*swf.Client](ctx, c, names.SWF, make(map[string]any)))
 }
 
-func (c *AWSClient) SageMakerClient(ctx context.Context) *sagemaker.Client {
-	return errs.Must(client[*sagemaker.Client](ctx, c, names.SageMaker, make(map[string]any)))
-}
 
 func (c *AWSClient) SavingsPlansClient(ctx context.Context) *savingsplans.Client {
 	return errs.Must(client[*savingsplans.Client](ctx, c, names.SavingsPlans, make(map[string]any)))
@@ -1308,9 +1299,6 @@ func (c *AWSClient) WorkSpacesClient(ctx context.Context) *workspaces.Client {
 	return errs.Must(client[*workspaces.Client](ctx, c, names.WorkSpaces, make(map[string]any)))
 }
 
-func (c *AWSClient) WorkSpacesWebClient(ctx context.Context) *workspacesweb.Client {
-	return errs.Must(client[*workspacesweb.Client](ctx, c, names.WorkSpacesWeb, make(map[string]any)))
-}
 
 func (c *AWSClient) XRayClient(ctx context.Context) *xray.Client {
 	return errs.Must(client[*xray.Client](ctx, c, names.XRay, make(map[string]any)))
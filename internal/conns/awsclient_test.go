@@ -4,6 +4,7 @@
 package conns
 
 import (
+	"slices"
 	"testing"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -598,3 +599,55 @@ func TestAWSClientRegionalARNWithRegion(t *testing.T) { // nosemgrep:ci.aws-in-f
 		})
 	}
 }
+
+func TestAWSClientAccountIDOverrideAssumeRole(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := t.Context()
+	client := &AWSClient{
+		accountID: "111111111111",
+	}
+
+	if got, want := client.AccountID(ctx), "111111111111"; got != want {
+		t.Errorf("no context: got %s, expected %s", got, want)
+	}
+
+	ctx = NewResourceContext(ctx, "ec2", "VPC", "aws_vpc", "")
+
+	if got, want := client.AccountID(NewOverrideAssumeRoleContext(ctx, nil)), "111111111111"; got != want {
+		t.Errorf("no override: got %s, expected %s", got, want)
+	}
+
+	ctx = NewOverrideAssumeRoleContext(ctx, &AssumeRole{RoleARN: "arn:aws:iam::222222222222:role/test"})
+
+	if got, want := client.AccountID(ctx), "222222222222"; got != want {
+		t.Errorf("override: got %s, expected %s", got, want)
+	}
+	if got, want := client.ProviderAccountID(ctx), "111111111111"; got != want {
+		t.Errorf("override, provider account: got %s, expected %s", got, want)
+	}
+}
+
+func TestAWSClientAssumedRolesOverrideAssumeRole(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := t.Context()
+	client := &AWSClient{
+		assumeRoles: []AssumeRole{{RoleARN: "arn:aws:iam::111111111111:role/base"}},
+	}
+
+	ctx = NewResourceContext(ctx, "iam", "Role", "aws_iam_role", "")
+
+	if got, want := client.AssumedRoles(ctx), []AssumeRole{{RoleARN: "arn:aws:iam::111111111111:role/base"}}; !slices.Equal(got, want) {
+		t.Errorf("no override: got %v, expected %v", got, want)
+	}
+
+	ctx = NewOverrideAssumeRoleContext(ctx, &AssumeRole{RoleARN: "arn:aws:iam::222222222222:role/test", SessionName: "test"})
+
+	if got, want := client.AssumedRoles(ctx), []AssumeRole{{RoleARN: "arn:aws:iam::111111111111:role/base"}, {RoleARN: "arn:aws:iam::222222222222:role/test", SessionName: "test"}}; !slices.Equal(got, want) {
+		t.Errorf("override: got %v, expected %v", got, want)
+	}
+	if got, want := len(client.assumeRoles), 1; got != want {
+		t.Errorf("provider roles modified: got %d, expected %d", got, want)
+	}
+}
@@ -4,6 +4,7 @@
 package conns
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"strings"
@@ -11,6 +12,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	basediag "github.com/hashicorp/aws-sdk-go-base/v2/diag"
 	"github.com/hashicorp/aws-sdk-go-base/v2/endpoints"
@@ -18,8 +20,11 @@ import (
 	basevalidation "github.com/hashicorp/aws-sdk-go-base/v2/validation"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/guardrails"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tags/tagpolicy"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
@@ -30,8 +35,11 @@ import (
 type Config struct {
 	AccessKey                      string
 	AllowedAccountIds              []string
+	AllowedRegions                 []string
 	AssumeRole                     []awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	BackupBeforeDestroyConfig      *guardrails.BackupBeforeDestroyConfig
+	BaseEndpoint                   string
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
 	EC2MetadataServiceEnableState  imds.ClientEnableState
@@ -39,17 +47,23 @@ type Config struct {
 	EC2MetadataServiceEndpointMode string
 	Endpoints                      map[string]string
 	ForbiddenAccountIds            []string
+	ForbiddenRegions               []string
+	GuardrailsConfig               *guardrails.Config
 	HTTPProxy                      *string
 	HTTPSProxy                     *string
+	IAMPolicyValidationConfig      *iampolicy.ValidationConfig
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
 	MaxRetries                     int
+	NamingPolicy                   *create.NamingPolicy
 	NoProxy                        string
+	OperationDefaults              []OperationDefaults
 	Profile                        string
 	Region                         string
 	RetryMode                      aws.RetryMode
 	S3OriginalRegion               string
 	S3UsePathStyle                 bool
+	S3UsePathStyleConfigured       bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
 	SharedConfigFiles              []string
@@ -87,14 +101,13 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 				{Name: "terraform-provider-aws", Version: version.ProviderVersion, Comment: "+https://registry.terraform.io/providers/hashicorp/aws"},
 			},
 		},
-		AssumeRole:                     c.AssumeRole,
 		AssumeRoleWithWebIdentity:      c.AssumeRoleWithWebIdentity,
 		Backoff:                        &v1CompatibleBackoff{maxRetryDelay: maxBackoff},
 		CallerDocumentationURL:         "https://registry.terraform.io/providers/hashicorp/aws",
 		CallerName:                     "Terraform AWS Provider",
 		EC2MetadataServiceEnableState:  c.EC2MetadataServiceEnableState,
 		ForbiddenAccountIds:            c.ForbiddenAccountIds,
-		IamEndpoint:                    c.Endpoints[names.IAM],
+		IamEndpoint:                    cmp.Or(c.Endpoints[names.IAM], c.BaseEndpoint),
 		Insecure:                       c.Insecure,
 		HTTPClient:                     client.HTTPClient(ctx),
 		HTTPProxy:                      c.HTTPProxy,
@@ -110,8 +123,8 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		SecretKey:                      c.SecretKey,
 		SkipCredsValidation:            c.SkipCredsValidation,
 		SkipRequestingAccountId:        c.SkipRequestingAccountId,
-		SsoEndpoint:                    c.Endpoints[names.SSO],
-		StsEndpoint:                    c.Endpoints[names.STS],
+		SsoEndpoint:                    cmp.Or(c.Endpoints[names.SSO], c.BaseEndpoint),
+		StsEndpoint:                    cmp.Or(c.Endpoints[names.STS], c.BaseEndpoint),
 		SuppressDebugLog:               c.SuppressDebugLog,
 		Token:                          c.Token,
 		TokenBucketRateLimiterCapacity: c.TokenBucketRateLimiterCapacity,
@@ -161,6 +174,35 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	// The base credentials are those used to assume the first IAM role in any assume_role chain.
+	baseCredentials, err := cfg.Credentials.Retrieve(ctx)
+	if err != nil {
+		return nil, sdkdiag.AppendErrorf(diags, "retrieving AWS credentials: %s", err)
+	}
+
+	// The assume_role chain is built here, rather than by aws-sdk-go-base, so that the base credentials are known.
+	if len(c.AssumeRole) > 0 {
+		credentialsProvider, err := newAssumeRoleChainCredentialsProvider(ctx, cfg, c.AssumeRole, func(o *sts.Options) {
+			if c.STSRegion != "" {
+				o.Region = c.STSRegion
+			}
+			if v := cmp.Or(c.Endpoints[names.STS], c.BaseEndpoint); v != "" {
+				o.BaseEndpoint = aws.String(v)
+			}
+		})
+		if err != nil {
+			summary, detail := c.cannotAssumeRoleDiagnostic(err)
+			return nil, append(diags, errs.NewErrorDiagnostic(summary, detail))
+		}
+		cfg.Credentials = credentialsProvider
+	}
+
+	// Assumed role sessions may be shorter than a long-running apply.
+	if c.AssumeRoleWithWebIdentity != nil || len(c.AssumeRole) > 0 {
+		cfg.Credentials = newRefreshingCredentialsCache(cfg.Credentials)
+		cfg = withExpiredCredentialsRetry(cfg)
+	}
+
 	if !c.SkipRegionValidation {
 		if err := basevalidation.SupportedRegion(cfg.Region); err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
@@ -168,9 +210,29 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 	c.Region = cfg.Region
 
+	if err := verifyRegionAllowed(c.Region, c.AllowedRegions, c.ForbiddenRegions); err != nil {
+		return nil, sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// The provider-configured base endpoint, e.g. of a local AWS stand-in, replaces any base endpoint from the
+	// AWS_ENDPOINT_URL environment variable or the shared configuration file.
+	if c.BaseEndpoint != "" {
+		cfg.BaseEndpoint = aws.String(c.BaseEndpoint)
+	}
+	baseEndpoint := aws.ToString(cfg.BaseEndpoint)
+
+	// AWS stand-ins don't generally support S3 virtual hosted-style requests.
+	// Use path-style requests with a base endpoint unless s3_use_path_style is explicitly configured.
+	if baseEndpoint != "" && !c.S3UsePathStyleConfigured {
+		tflog.Info(ctx, "Using S3 path-style requests with base endpoint", map[string]any{
+			"base_endpoint": baseEndpoint,
+		})
+		c.S3UsePathStyle = true
+	}
+
 	// Handle custom S3 regions for S3-compatible storage (Ceph, MinIO, etc.)
 	// AWS SDK v2 validates regions strictly, but S3-compatible storage may use non-standard region strings
-	if customS3Endpoint := c.Endpoints[names.S3]; customS3Endpoint != "" {
+	if customS3Endpoint := cmp.Or(c.Endpoints[names.S3], c.BaseEndpoint); customS3Endpoint != "" {
 		if !inttypes.IsAWSRegion(cfg.Region) {
 			c.S3OriginalRegion = cfg.Region
 			cfg.Region = "us-east-1" // Use compliant dummy region for SDK initialization
@@ -200,7 +262,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 			"See https://registry.terraform.io/providers/hashicorp/aws/latest/docs#skip_requesting_account_id for implications."))
 	}
 
-	err := awsbaseConfig.VerifyAccountIDAllowed(accountID)
+	err = awsbaseConfig.VerifyAccountIDAllowed(accountID)
 	if err != nil {
 		return nil, sdkdiag.AppendErrorf(diags, "%s", err.Error())
 	}
@@ -227,13 +289,23 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 
 	client.accountID = accountID
+	client.allowedRegions = c.AllowedRegions
+	client.assumeRoles = c.assumeRoles()
+	client.backupBeforeDestroyConfig = c.BackupBeforeDestroyConfig
+	client.baseCredentialSource = baseCredentials.Source
 	client.defaultTagsConfig = c.DefaultTagsConfig
+	client.forbiddenRegions = c.ForbiddenRegions
+	client.guardrailsConfig = c.GuardrailsConfig
+	client.iamPolicyValidationConfig = c.IAMPolicyValidationConfig
 	client.ignoreTagsConfig = c.IgnoreTagsConfig
+	client.namingPolicy = c.NamingPolicy
+	client.operationDefaults = c.OperationDefaults
 	client.tagPolicyConfig = c.TagPolicyConfig
 	client.terraformVersion = c.TerraformVersion
 
 	// Used for lazy-loading AWS API clients.
 	client.awsConfig = &cfg
+	client.baseEndpoint = baseEndpoint
 	client.clients = make(map[string]map[string]any, 0)
 	client.endpoints = c.Endpoints
 	client.logger = logger
@@ -242,6 +314,13 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
 
+	// Verify that the provider's Region is enabled for the account.
+	if !c.SkipRegionValidation && !c.SkipRequestingAccountId {
+		if err := client.verifyRegionEnabled(ctx, c.Region); err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return client, diags
 }
 
@@ -256,6 +335,28 @@ func baseSeverityToSDKSeverity(s basediag.Severity) diag.Severity {
 	}
 }
 
+// assumeRoles returns the IAM roles assumed, in order, to obtain the provider's credentials.
+func (c *Config) assumeRoles() []AssumeRole {
+	var roles []AssumeRole
+
+	if v := c.AssumeRoleWithWebIdentity; v != nil && v.RoleARN != "" {
+		roles = append(roles, AssumeRole{
+			RoleARN:     v.RoleARN,
+			SessionName: v.SessionName,
+		})
+	}
+
+	for _, v := range c.AssumeRole {
+		roles = append(roles, AssumeRole{
+			ExternalID:  v.ExternalID,
+			RoleARN:     v.RoleARN,
+			SessionName: v.SessionName,
+		})
+	}
+
+	return roles
+}
+
 func NormalizeS3USEast1RegionalEndpoint(v string) string {
 	switch v := strings.ToLower(v); v {
 	case "legacy", "regional":
@@ -264,3 +365,35 @@ func NormalizeS3USEast1RegionalEndpoint(v string) string {
 		return ""
 	}
 }
+
+// cannotAssumeRoleDiagnostic returns the summary and detail of a diagnostic reporting that an IAM role in the assume_role chain cannot be assumed.
+// For a multi-hop chain, the failing hop is identified.
+func (c *Config) cannotAssumeRoleDiagnostic(err error) (string, string) {
+	summary, roleARN, cause := "Cannot assume IAM Role", "", err
+	var prefix string
+	if v, ok := errs.As[*cannotAssumeRoleError](err); ok {
+		roleARN, cause = v.roleARN, v.err
+
+		if i, n := v.index, len(c.AssumeRole); n > 1 || c.AssumeRoleWithWebIdentity != nil {
+			summary = fmt.Sprintf("%s: assume_role[%d] (hop %d of %d)", summary, i, i+1, n)
+			switch {
+			case i > 0:
+				prefix = fmt.Sprintf("The IAM Role is assumed using the credentials of assume_role[%d] (%s), which was assumed successfully.\n\n", i-1, c.AssumeRole[i-1].RoleARN)
+			case c.AssumeRoleWithWebIdentity != nil:
+				prefix = fmt.Sprintf("The IAM Role is assumed using the credentials of assume_role_with_web_identity (%s), which was assumed successfully.\n\n", c.AssumeRoleWithWebIdentity.RoleARN)
+			default:
+				prefix = "The IAM Role is the first hop in the assume_role chain and is assumed using the provider's base credentials.\n\n"
+			}
+		}
+	}
+
+	return summary, prefix + fmt.Sprintf(`IAM Role (%s) cannot be assumed.
+
+There are a number of possible causes of this - the most common are:
+  * The credentials used in order to assume the role are invalid
+  * The credentials do not have appropriate permission to assume the role
+  * The role ARN is not valid
+
+Error: %s
+`, roleARN, cause)
+}
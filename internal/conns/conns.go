@@ -7,6 +7,7 @@ import (
 	"context"
 	"iter"
 
+	"github.com/hashicorp/terraform-provider-aws/internal/guardrails"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/vcr"
 )
@@ -35,6 +36,23 @@ type ServicePackageWithEphemeralResources interface {
 	EphemeralResources(context.Context) []*inttypes.ServicePackageEphemeralResource
 }
 
+// ServicePackageWithBackupBeforeDestroy is an interface that extends ServicePackage with backups taken before resources are destroyed.
+type ServicePackageWithBackupBeforeDestroy interface {
+	ServicePackage
+	// BackupBeforeDestroy returns the functions backing up resources, keyed by resource type name.
+	BackupBeforeDestroy(context.Context) map[string]BackupBeforeDestroyFunc
+}
+
+// BackupBeforeDestroyFunc backs up a resource before it is destroyed.
+// It returns the ID of the completed backup, or "" if the resource no longer exists.
+type BackupBeforeDestroyFunc func(ctx context.Context, d BackupResourceData, meta any, config *guardrails.BackupBeforeDestroyConfig) (string, error)
+
+// BackupResourceData is the subset of a resource's state used to back it up.
+type BackupResourceData interface {
+	Get(string) any
+	Id() string
+}
+
 type ServicePackageWithFrameworkListResources interface {
 	ServicePackage
 	FrameworkListResources(context.Context) iter.Seq[*inttypes.ServicePackageFrameworkListResource]
@@ -53,13 +71,32 @@ var (
 	contextKey contextKeyType
 )
 
+// AssumeRole is an IAM role to assume for the currently in-process operation.
+type AssumeRole struct {
+	ExternalID  string
+	RoleARN     string
+	SessionName string
+}
+
 // InContext represents the resource information kept in Context.
 type InContext struct {
-	overrideRegion     string // Any currently in effect per-resource Region override.
-	resourceName       string // Friendly resource name, e.g. "Subnet"
-	typeName           string // Resource type name, e.g. "aws_iam_role"
-	servicePackageName string // Canonical name defined as a constant in names package
-	vcrEnabled         bool   // Whether VCR testing is enabled
+	operationTimeouts  OperationTimeouts // Provider-level default operation timeouts.
+	overrideAssumeRole *AssumeRole       // Any currently in effect per-resource IAM role override.
+	overrideRegion     string            // Any currently in effect per-resource Region override.
+	resourceName       string            // Friendly resource name, e.g. "Subnet"
+	typeName           string            // Resource type name, e.g. "aws_iam_role"
+	servicePackageName string            // Canonical name defined as a constant in names package
+	vcrEnabled         bool              // Whether VCR testing is enabled
+}
+
+// OperationTimeouts returns the provider-level default operation timeouts for the resource.
+func (c *InContext) OperationTimeouts() OperationTimeouts {
+	return c.operationTimeouts
+}
+
+// OverrideAssumeRole returns any currently in effect per-resource IAM role override.
+func (c *InContext) OverrideAssumeRole() *AssumeRole {
+	return c.overrideAssumeRole
 }
 
 // OverrideRegion returns any currently in effect per-resource Region override.
@@ -99,6 +136,34 @@ func NewResourceContext(ctx context.Context, servicePackageName, resourceName, t
 	return context.WithValue(ctx, contextKey, &v)
 }
 
+// NewOverrideAssumeRoleContext returns a Context in which the per-resource IAM role override is in effect.
+// It must be called after NewResourceContext.
+func NewOverrideAssumeRoleContext(ctx context.Context, assumeRole *AssumeRole) context.Context {
+	v, ok := FromContext(ctx)
+	if !ok || assumeRole == nil {
+		return ctx
+	}
+
+	inContext := *v
+	inContext.overrideAssumeRole = assumeRole
+
+	return context.WithValue(ctx, contextKey, &inContext)
+}
+
+// NewOperationTimeoutsContext returns a Context in which the provider-level default operation timeouts are in effect.
+// It must be called after NewResourceContext.
+func NewOperationTimeoutsContext(ctx context.Context, timeouts OperationTimeouts) context.Context {
+	v, ok := FromContext(ctx)
+	if !ok || timeouts == (OperationTimeouts{}) {
+		return ctx
+	}
+
+	inContext := *v
+	inContext.operationTimeouts = timeouts
+
+	return context.WithValue(ctx, contextKey, &inContext)
+}
+
 func FromContext(ctx context.Context) (*InContext, bool) {
 	v, ok := ctx.Value(contextKey).(*InContext)
 	return v, ok
@@ -0,0 +1,184 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// credentialsExpiryWindow is how long before their expiry assumed role credentials are refreshed.
+	// Jitter spreads refreshes across the last half of the window.
+	credentialsExpiryWindow           = 5 * time.Minute
+	credentialsExpiryWindowJitterFrac = 0.5
+)
+
+// expiredCredentialsErrorCodes are the API error codes returned when a request is signed with expired credentials.
+var expiredCredentialsErrorCodes = []string{
+	"ExpiredToken",
+	"ExpiredTokenException",
+}
+
+// newRefreshingCredentialsCache returns a credentials cache that proactively refreshes the specified
+// assumed role credentials before they expire.
+// The credentials caches created by aws-sdk-go-base only retrieve new credentials once the cached credentials
+// have expired, which can fail requests signed just before expiry and long-running waiters.
+// Refreshing invalidates the underlying cache so that the role (or final role in a chain) is assumed again;
+// for assume_role_with_web_identity the web identity token file is re-read.
+func newRefreshingCredentialsCache(provider aws.CredentialsProvider) *aws.CredentialsCache {
+	cache, ok := provider.(*aws.CredentialsCache)
+	if !ok {
+		cache = aws.NewCredentialsCache(provider)
+	}
+
+	return aws.NewCredentialsCache(&refreshingCredentialsProvider{cache: cache}, func(o *aws.CredentialsCacheOptions) {
+		o.ExpiryWindow = credentialsExpiryWindow
+		o.ExpiryWindowJitterFrac = credentialsExpiryWindowJitterFrac
+	})
+}
+
+type refreshingCredentialsProvider struct {
+	cache     *aws.CredentialsCache
+	mutex     sync.Mutex
+	retrieved bool
+	expires   time.Time
+}
+
+func (p *refreshingCredentialsProvider) Retrieve(ctx context.Context) (aws.Credentials, error) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	// The first retrieval uses the credentials obtained when the provider was configured.
+	if p.retrieved {
+		tflog.Info(ctx, "Refreshing AWS credentials", map[string]any{
+			"tf_aws.credentials.expires": p.expires,
+			"tf_aws.credentials.expired": !time.Now().Before(p.expires),
+		})
+		p.cache.Invalidate()
+	}
+
+	creds, err := p.cache.Retrieve(ctx)
+	if err != nil {
+		return creds, err
+	}
+
+	p.retrieved = true
+	p.expires = creds.Expires
+
+	if creds.CanExpire {
+		tflog.Debug(ctx, "Retrieved AWS credentials", map[string]any{
+			"tf_aws.credentials.source":  creds.Source,
+			"tf_aws.credentials.expires": creds.Expires,
+		})
+	}
+
+	return creds, nil
+}
+
+// withExpiredCredentialsRetry returns a copy of the specified AWS configuration whose Retryer retries
+// operations that fail solely due to credential expiry.
+// The configuration's cached credentials are invalidated so that the retried request is signed with refreshed credentials.
+func withExpiredCredentialsRetry(cfg aws.Config) aws.Config {
+	creds, ok := cfg.Credentials.(*aws.CredentialsCache)
+	if !ok {
+		return cfg
+	}
+
+	newRetryer := cfg.Retryer
+	cfg.Retryer = func() aws.Retryer {
+		var r aws.RetryerV2 = retry.NewStandard()
+		if newRetryer != nil {
+			if v, ok := newRetryer().(aws.RetryerV2); ok {
+				r = v
+			}
+		}
+
+		return AddIsErrorRetryables(r, retry.IsErrorRetryableFunc(func(err error) aws.Ternary {
+			if tfawserr.ErrCodeEquals(err, expiredCredentialsErrorCodes...) {
+				creds.Invalidate()
+				return aws.TrueTernary
+			}
+
+			return aws.UnknownTernary // Delegate to configured Retryer.
+		}))
+	}
+
+	return cfg
+}
+
+// cannotAssumeRoleError occurs when an IAM role in the provider's assume_role chain cannot be assumed.
+type cannotAssumeRoleError struct {
+	index   int // Index of the failing hop in the assume_role chain.
+	roleARN string
+	err     error
+}
+
+func (e *cannotAssumeRoleError) Error() string {
+	return fmt.Sprintf("IAM Role (%s) cannot be assumed: %s", e.roleARN, e.err)
+}
+
+func (e *cannotAssumeRoleError) Unwrap() error {
+	return e.err
+}
+
+// newAssumeRoleChainCredentialsProvider returns a credentials provider for the final IAM role in the specified chain.
+// Each role is assumed using the credentials of the previous role, the first using the specified configuration's credentials.
+// Roles are assumed immediately so that an invalid chain is reported when the provider is configured.
+func newAssumeRoleChainCredentialsProvider(ctx context.Context, cfg aws.Config, roles []awsbase.AssumeRole, optFns ...func(*sts.Options)) (aws.CredentialsProvider, error) {
+	for i, role := range roles {
+		if role.RoleARN == "" {
+			return nil, &cannotAssumeRoleError{index: i, err: errors.New("IAM Role ARN not set")}
+		}
+
+		tflog.Info(ctx, "Assuming IAM Role", map[string]any{
+			"tf_aws.assume_role.role_arn":        role.RoleARN,
+			"tf_aws.assume_role.session_name":    role.SessionName,
+			"tf_aws.assume_role.external_id":     role.ExternalID,
+			"tf_aws.assume_role.source_identity": role.SourceIdentity,
+		})
+
+		conn := sts.NewFromConfig(cfg, optFns...)
+		provider := aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(conn, role.RoleARN, func(o *stscreds.AssumeRoleOptions) {
+			o.Duration = role.Duration
+			o.RoleSessionName = role.SessionName
+			if v := role.ExternalID; v != "" {
+				o.ExternalID = aws.String(v)
+			}
+			if v := role.Policy; v != "" {
+				o.Policy = aws.String(v)
+			}
+			for _, v := range role.PolicyARNs {
+				o.PolicyARNs = append(o.PolicyARNs, awstypes.PolicyDescriptorType{Arn: aws.String(v)})
+			}
+			if v := role.SourceIdentity; v != "" {
+				o.SourceIdentity = aws.String(v)
+			}
+			for k, v := range role.Tags {
+				o.Tags = append(o.Tags, awstypes.Tag{Key: aws.String(k), Value: aws.String(v)})
+			}
+			o.TransitiveTagKeys = role.TransitiveTagKeys
+		}))
+
+		if _, err := provider.Retrieve(ctx); err != nil {
+			return nil, &cannotAssumeRoleError{index: i, roleARN: role.RoleARN, err: err}
+		}
+
+		cfg.Credentials = provider
+	}
+
+	return cfg.Credentials, nil
+}
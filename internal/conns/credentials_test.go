@@ -0,0 +1,326 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/smithy-go"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+type countingCredentialsProvider struct {
+	count    int
+	duration time.Duration
+}
+
+func (p *countingCredentialsProvider) Retrieve(context.Context) (aws.Credentials, error) {
+	p.count++
+
+	return aws.Credentials{
+		AccessKeyID:     "AKID",
+		SecretAccessKey: "SECRET",
+		SessionToken:    "TOKEN",
+		CanExpire:       true,
+		Expires:         time.Now().Add(p.duration),
+	}, nil
+}
+
+func TestRefreshingCredentialsCache(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	testCases := map[string]struct {
+		duration      time.Duration
+		expectedCount int
+	}{
+		"outside expiry window": {
+			duration:      time.Hour,
+			expectedCount: 1,
+		},
+		"within expiry window": {
+			duration:      time.Minute,
+			expectedCount: 3,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := &countingCredentialsProvider{duration: testCase.duration}
+			cache := newRefreshingCredentialsCache(aws.NewCredentialsCache(provider))
+
+			for range 3 {
+				if _, err := cache.Retrieve(ctx); err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			}
+
+			if got, want := provider.count, testCase.expectedCount; got != want {
+				t.Errorf("credentials retrieved %d times, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestWithExpiredCredentialsRetry(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	testCases := map[string]struct {
+		err           error
+		expected      bool
+		expectedCount int
+	}{
+		"ExpiredToken": {
+			err:           &smithy.GenericAPIError{Code: "ExpiredToken"},
+			expected:      true,
+			expectedCount: 2,
+		},
+		"ExpiredTokenException": {
+			err:           &smithy.GenericAPIError{Code: "ExpiredTokenException"},
+			expected:      true,
+			expectedCount: 2,
+		},
+		"AccessDenied": {
+			err:           &smithy.GenericAPIError{Code: "AccessDenied"},
+			expectedCount: 1,
+		},
+		"other error": {
+			err:           errors.New("test"),
+			expectedCount: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			provider := &countingCredentialsProvider{duration: time.Hour}
+			cfg := withExpiredCredentialsRetry(aws.Config{
+				Credentials: aws.NewCredentialsCache(provider),
+			})
+
+			if _, err := cfg.Credentials.Retrieve(ctx); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := cfg.Retryer().IsErrorRetryable(testCase.err), testCase.expected; got != want {
+				t.Errorf("IsErrorRetryable = %t, want %t", got, want)
+			}
+
+			if _, err := cfg.Credentials.Retrieve(ctx); err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := provider.count, testCase.expectedCount; got != want {
+				t.Errorf("credentials retrieved %d times, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestNewAssumeRoleChainCredentialsProvider(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	const (
+		role1ARN = "arn:aws:iam::123456789012:role/role1" //lintignore:AWSAT005
+		role2ARN = "arn:aws:iam::123456789012:role/role2" //lintignore:AWSAT005
+		role3ARN = "arn:aws:iam::123456789012:role/role3" //lintignore:AWSAT005
+	)
+
+	testCases := map[string]struct {
+		roles             []awsbase.AssumeRole
+		deniedRoleARN     string
+		expectedAccessKey string
+		expectedIndex     int
+		expectedRoleARN   string
+		expectedErr       bool
+	}{
+		"single hop": {
+			roles:             []awsbase.AssumeRole{{RoleARN: role1ARN}},
+			expectedAccessKey: "AKID-role1",
+		},
+		"multiple hops": {
+			roles:             []awsbase.AssumeRole{{RoleARN: role1ARN}, {RoleARN: role2ARN}, {RoleARN: role3ARN}},
+			expectedAccessKey: "AKID-role3",
+		},
+		"first hop denied": {
+			roles:           []awsbase.AssumeRole{{RoleARN: role1ARN}, {RoleARN: role2ARN}, {RoleARN: role3ARN}},
+			deniedRoleARN:   role1ARN,
+			expectedErr:     true,
+			expectedIndex:   0,
+			expectedRoleARN: role1ARN,
+		},
+		"middle hop denied": {
+			roles:           []awsbase.AssumeRole{{RoleARN: role1ARN}, {RoleARN: role2ARN}, {RoleARN: role3ARN}},
+			deniedRoleARN:   role2ARN,
+			expectedErr:     true,
+			expectedIndex:   1,
+			expectedRoleARN: role2ARN,
+		},
+		"same role ARN in multiple hops": {
+			roles:           []awsbase.AssumeRole{{RoleARN: role1ARN}, {RoleARN: role2ARN}, {RoleARN: role1ARN}, {RoleARN: role3ARN}},
+			deniedRoleARN:   role3ARN,
+			expectedErr:     true,
+			expectedIndex:   3,
+			expectedRoleARN: role3ARN,
+		},
+		"no role ARN": {
+			roles:         []awsbase.AssumeRole{{RoleARN: role1ARN}, {}},
+			expectedErr:   true,
+			expectedIndex: 1,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if err := r.ParseForm(); err != nil {
+					http.Error(w, err.Error(), http.StatusBadRequest)
+					return
+				}
+
+				roleARN := r.Form.Get("RoleArn")
+				if roleARN == testCase.deniedRoleARN {
+					w.WriteHeader(http.StatusForbidden)
+					fmt.Fprint(w, `<ErrorResponse><Error><Type>Sender</Type><Code>AccessDenied</Code><Message>denied</Message></Error><RequestId>test</RequestId></ErrorResponse>`)
+					return
+				}
+
+				_, roleName, _ := strings.Cut(roleARN, "/")
+				fmt.Fprintf(w, `<AssumeRoleResponse><AssumeRoleResult><Credentials><AccessKeyId>AKID-%[1]s</AccessKeyId><SecretAccessKey>SECRET</SecretAccessKey><SessionToken>TOKEN</SessionToken><Expiration>2099-01-01T00:00:00Z</Expiration></Credentials><AssumedRoleUser><Arn>%[2]s</Arn><AssumedRoleId>ID:%[1]s</AssumedRoleId></AssumedRoleUser></AssumeRoleResult><ResponseMetadata><RequestId>test</RequestId></ResponseMetadata></AssumeRoleResponse>`, roleName, roleARN)
+			}))
+			t.Cleanup(server.Close)
+
+			cfg := aws.Config{
+				Credentials: aws.NewCredentialsCache(&countingCredentialsProvider{duration: time.Hour}),
+				Region:      "us-west-2", //lintignore:AWSAT003
+				Retryer:     func() aws.Retryer { return aws.NopRetryer{} },
+			}
+			provider, err := newAssumeRoleChainCredentialsProvider(ctx, cfg, testCase.roles, func(o *sts.Options) {
+				o.BaseEndpoint = aws.String(server.URL)
+			})
+
+			if testCase.expectedErr {
+				v, ok := errs.As[*cannotAssumeRoleError](err)
+				if !ok {
+					t.Fatalf("err = %v, want cannotAssumeRoleError", err)
+				}
+				if got, want := v.index, testCase.expectedIndex; got != want {
+					t.Errorf("index = %d, want %d", got, want)
+				}
+				if got, want := v.roleARN, testCase.expectedRoleARN; got != want {
+					t.Errorf("roleARN = %q, want %q", got, want)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			credentials, err := provider.Retrieve(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got, want := credentials.AccessKeyID, testCase.expectedAccessKey; got != want {
+				t.Errorf("AccessKeyID = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestConfigCannotAssumeRoleDiagnostic(t *testing.T) {
+	t.Parallel()
+
+	const (
+		role1ARN           = "arn:aws:iam::123456789012:role/role1"    //lintignore:AWSAT005
+		role2ARN           = "arn:aws:iam::123456789012:role/role2"    //lintignore:AWSAT005
+		webIdentityRoleARN = "arn:aws:iam::123456789012:role/identity" //lintignore:AWSAT005
+	)
+
+	testCases := map[string]struct {
+		config          Config
+		err             error
+		expectedSummary string
+		expectedPrefix  string
+	}{
+		"single hop": {
+			config:          Config{AssumeRole: []awsbase.AssumeRole{{RoleARN: role1ARN}}},
+			err:             &cannotAssumeRoleError{index: 0, roleARN: role1ARN, err: errors.New("test")},
+			expectedSummary: "Cannot assume IAM Role",
+			expectedPrefix:  "IAM Role (" + role1ARN + ") cannot be assumed.",
+		},
+		"first of multiple hops": {
+			config:          Config{AssumeRole: []awsbase.AssumeRole{{RoleARN: role1ARN}, {RoleARN: role2ARN}}},
+			err:             &cannotAssumeRoleError{index: 0, roleARN: role1ARN, err: errors.New("test")},
+			expectedSummary: "Cannot assume IAM Role: assume_role[0] (hop 1 of 2)",
+			expectedPrefix:  "The IAM Role is the first hop in the assume_role chain and is assumed using the provider's base credentials.",
+		},
+		"second of multiple hops": {
+			config:          Config{AssumeRole: []awsbase.AssumeRole{{RoleARN: role1ARN}, {RoleARN: role2ARN}}},
+			err:             &cannotAssumeRoleError{index: 1, roleARN: role2ARN, err: errors.New("test")},
+			expectedSummary: "Cannot assume IAM Role: assume_role[1] (hop 2 of 2)",
+			expectedPrefix:  "The IAM Role is assumed using the credentials of assume_role[0] (" + role1ARN + "), which was assumed successfully.",
+		},
+		"same role ARN in multiple hops": {
+			config:          Config{AssumeRole: []awsbase.AssumeRole{{RoleARN: role1ARN}, {RoleARN: role2ARN}, {RoleARN: role1ARN}}},
+			err:             &cannotAssumeRoleError{index: 2, roleARN: role1ARN, err: errors.New("test")},
+			expectedSummary: "Cannot assume IAM Role: assume_role[2] (hop 3 of 3)",
+			expectedPrefix:  "The IAM Role is assumed using the credentials of assume_role[1] (" + role2ARN + "), which was assumed successfully.",
+		},
+		"web identity": {
+			config: Config{
+				AssumeRole:                []awsbase.AssumeRole{{RoleARN: role1ARN}},
+				AssumeRoleWithWebIdentity: &awsbase.AssumeRoleWithWebIdentity{RoleARN: webIdentityRoleARN},
+			},
+			err:             &cannotAssumeRoleError{index: 0, roleARN: role1ARN, err: errors.New("test")},
+			expectedSummary: "Cannot assume IAM Role: assume_role[0] (hop 1 of 1)",
+			expectedPrefix:  "The IAM Role is assumed using the credentials of assume_role_with_web_identity (" + webIdentityRoleARN + "), which was assumed successfully.",
+		},
+		"wrapped": {
+			config:          Config{AssumeRole: []awsbase.AssumeRole{{RoleARN: role1ARN}, {RoleARN: role2ARN}}},
+			err:             fmt.Errorf("wrapped: %w", &cannotAssumeRoleError{index: 1, roleARN: role2ARN, err: errors.New("test")}),
+			expectedSummary: "Cannot assume IAM Role: assume_role[1] (hop 2 of 2)",
+			expectedPrefix:  "The IAM Role is assumed using the credentials of assume_role[0] (" + role1ARN + "), which was assumed successfully.",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			summary, detail := testCase.config.cannotAssumeRoleDiagnostic(testCase.err)
+
+			if got, want := summary, testCase.expectedSummary; got != want {
+				t.Errorf("summary = %q, want %q", got, want)
+			}
+			if !strings.HasPrefix(detail, testCase.expectedPrefix) {
+				t.Errorf("detail = %q, want prefix %q", detail, testCase.expectedPrefix)
+			}
+			if !strings.Contains(detail, "Error: test") {
+				t.Errorf("detail = %q, want cause", detail)
+			}
+		})
+	}
+}
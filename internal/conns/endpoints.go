@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	smithyendpoints "github.com/aws/smithy-go/endpoints"
+)
+
+var (
+	contextType  = reflect.TypeFor[context.Context]()
+	endpointType = reflect.TypeFor[smithyendpoints.Endpoint]()
+	errorType    = reflect.TypeFor[error]()
+)
+
+// ResolveEndpoint returns the URL of the endpoint that the specified service's API client uses in the in-context Region.
+// Operation-specific endpoint parameters, e.g. an S3 bucket name, are not taken into account.
+func (c *AWSClient) ResolveEndpoint(ctx context.Context, servicePackageName string) (string, error) {
+	sp := c.ServicePackage(ctx, servicePackageName)
+	if sp == nil {
+		return "", fmt.Errorf("unknown service package: %s", servicePackageName)
+	}
+
+	// All AWS SDK for Go v2 API clients have the same shape, but no common interface.
+	// Each step is checked so that an unexpected shape is reported as an error rather than a panic.
+	config := c.apiClientConfig(ctx, servicePackageName)
+	newClient := reflect.ValueOf(sp).MethodByName("NewClient")
+	if !isFunc(newClient, []reflect.Type{contextType, reflect.TypeOf(config)}, 2) || !newClient.Type().Out(1).Implements(errorType) {
+		return "", fmt.Errorf("no AWS SDK v2 API client factory: %s", servicePackageName)
+	}
+
+	results := newClient.Call([]reflect.Value{reflect.ValueOf(ctx), reflect.ValueOf(config)})
+	if err, ok := results[1].Interface().(error); ok && err != nil {
+		return "", err
+	}
+
+	optionsFunc := results[0].MethodByName("Options")
+	if !isFunc(optionsFunc, nil, 1) {
+		return "", fmt.Errorf("AWS SDK v2 API client (%s): no Options method", servicePackageName)
+	}
+	options := optionsFunc.Call(nil)[0]
+	if options.Kind() != reflect.Struct {
+		return "", fmt.Errorf("AWS SDK v2 API client (%s): unexpected Options type: %s", servicePackageName, options.Type())
+	}
+
+	resolver := options.FieldByName("EndpointResolverV2")
+	if !resolver.IsValid() || resolver.Kind() != reflect.Interface || resolver.IsNil() {
+		return "", fmt.Errorf("AWS SDK v2 API client (%s): no endpoint resolver", servicePackageName)
+	}
+
+	resolveEndpoint := resolver.MethodByName("ResolveEndpoint")
+	if !resolveEndpoint.IsValid() || resolveEndpoint.Kind() != reflect.Func || resolveEndpoint.Type().NumIn() != 2 {
+		return "", fmt.Errorf("AWS SDK v2 API client (%s): unexpected endpoint resolver", servicePackageName)
+	}
+	paramsType := resolveEndpoint.Type().In(1)
+	if paramsType.Kind() != reflect.Struct || !isFunc(resolveEndpoint, []reflect.Type{contextType, paramsType}, 2) || resolveEndpoint.Type().Out(0) != endpointType || !resolveEndpoint.Type().Out(1).Implements(errorType) {
+		return "", fmt.Errorf("AWS SDK v2 API client (%s): unexpected endpoint resolver", servicePackageName)
+	}
+
+	params := reflect.New(paramsType).Elem()
+	setParam := func(name string, v any) {
+		if field := params.FieldByName(name); field.IsValid() && field.CanSet() && field.Type() == reflect.TypeOf(v) {
+			field.Set(reflect.ValueOf(v))
+		}
+	}
+	if v, ok := fieldValue[*string](options, "BaseEndpoint"); ok {
+		setParam("Endpoint", v)
+	}
+	if v, ok := fieldValue[string](options, "Region"); ok {
+		setParam("Region", aws.String(v))
+	}
+	if endpointOptions := options.FieldByName("EndpointOptions"); endpointOptions.IsValid() && endpointOptions.Kind() == reflect.Struct {
+		if v, ok := fieldValue[aws.DualStackEndpointState](endpointOptions, "UseDualStackEndpoint"); ok {
+			setParam("UseDualStack", aws.Bool(v == aws.DualStackEndpointStateEnabled))
+		}
+		if v, ok := fieldValue[aws.FIPSEndpointState](endpointOptions, "UseFIPSEndpoint"); ok {
+			setParam("UseFIPS", aws.Bool(v == aws.FIPSEndpointStateEnabled))
+		}
+	}
+
+	results = resolveEndpoint.Call([]reflect.Value{reflect.ValueOf(ctx), params})
+	if err, ok := results[1].Interface().(error); ok && err != nil {
+		return "", fmt.Errorf("resolving %s endpoint: %w", servicePackageName, err)
+	}
+
+	endpoint, ok := results[0].Interface().(smithyendpoints.Endpoint)
+	if !ok {
+		return "", fmt.Errorf("resolving %s endpoint: unexpected result type: %s", servicePackageName, results[0].Type())
+	}
+
+	return endpoint.URI.String(), nil
+}
+
+// isFunc returns whether v is a function that can be called with arguments of the specified types and returns numOut results.
+func isFunc(v reflect.Value, in []reflect.Type, numOut int) bool {
+	if !v.IsValid() || v.Kind() != reflect.Func {
+		return false
+	}
+
+	t := v.Type()
+	if t.IsVariadic() || t.NumIn() != len(in) || t.NumOut() != numOut {
+		return false
+	}
+
+	for i, v := range in {
+		if v == nil || !v.AssignableTo(t.In(i)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// fieldValue returns the value of the specified struct field if it is of type T.
+func fieldValue[T any](v reflect.Value, name string) (T, bool) {
+	var zero T
+
+	field := v.FieldByName(name)
+	if !field.IsValid() || !field.CanInterface() {
+		return zero, false
+	}
+
+	t, ok := field.Interface().(T)
+
+	return t, ok
+}
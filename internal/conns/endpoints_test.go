@@ -0,0 +1,185 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	smithyendpoints "github.com/aws/smithy-go/endpoints"
+)
+
+type testEndpointParameters struct {
+	Endpoint *string
+	Region   *string
+	UseFIPS  *bool
+}
+
+type testEndpointResolver interface {
+	ResolveEndpoint(context.Context, testEndpointParameters) (smithyendpoints.Endpoint, error)
+}
+
+type testDefaultEndpointResolver struct{}
+
+func (testDefaultEndpointResolver) ResolveEndpoint(_ context.Context, params testEndpointParameters) (smithyendpoints.Endpoint, error) {
+	endpoint := "https://test." + aws.ToString(params.Region) + ".amazonaws.com"
+	if aws.ToBool(params.UseFIPS) {
+		endpoint = "https://test-fips." + aws.ToString(params.Region) + ".amazonaws.com"
+	}
+	if v := aws.ToString(params.Endpoint); v != "" {
+		endpoint = v
+	}
+
+	uri, err := url.Parse(endpoint)
+	if err != nil {
+		return smithyendpoints.Endpoint{}, err
+	}
+
+	return smithyendpoints.Endpoint{URI: *uri}, nil
+}
+
+type testEndpointOptions struct {
+	UseFIPSEndpoint aws.FIPSEndpointState
+}
+
+type testOptions struct {
+	BaseEndpoint       *string
+	EndpointOptions    testEndpointOptions
+	EndpointResolverV2 testEndpointResolver
+	Region             string
+}
+
+type testClient struct {
+	options testOptions
+}
+
+func (c *testClient) Options() testOptions {
+	return c.options
+}
+
+type testServicePackage struct {
+	ServicePackage
+	options testOptions
+}
+
+func (p *testServicePackage) NewClient(_ context.Context, config map[string]any) (*testClient, error) {
+	options := p.options
+	options.Region = config["region"].(string)
+
+	return &testClient{options: options}, nil
+}
+
+type testNoClientOptionsServicePackage struct {
+	ServicePackage
+}
+
+func (p *testNoClientOptionsServicePackage) NewClient(context.Context, map[string]any) (*struct{}, error) {
+	return &struct{}{}, nil
+}
+
+type testUnexpectedClientFactoryServicePackage struct {
+	ServicePackage
+}
+
+func (p *testUnexpectedClientFactoryServicePackage) NewClient(context.Context) *testClient {
+	return &testClient{}
+}
+
+func TestAWSClientResolveEndpoint(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	ctx := t.Context()
+	client := &AWSClient{
+		awsConfig: &aws.Config{
+			Region: "us-west-2", //lintignore:AWSAT003
+		},
+		partition: standardPartition,
+		servicePackages: map[string]ServicePackage{
+			"default": &testServicePackage{
+				options: testOptions{EndpointResolverV2: testDefaultEndpointResolver{}},
+			},
+			"fips": &testServicePackage{
+				options: testOptions{
+					EndpointOptions:    testEndpointOptions{UseFIPSEndpoint: aws.FIPSEndpointStateEnabled},
+					EndpointResolverV2: testDefaultEndpointResolver{},
+				},
+			},
+			"custom": &testServicePackage{
+				options: testOptions{
+					BaseEndpoint:       aws.String("https://custom.example.com"),
+					EndpointResolverV2: testDefaultEndpointResolver{},
+				},
+			},
+			"noresolver":   &testServicePackage{},
+			"nooptions":    &testNoClientOptionsServicePackage{},
+			"unexpected":   &testUnexpectedClientFactoryServicePackage{},
+			"noclientfunc": &struct{ ServicePackage }{},
+		},
+	}
+
+	testCases := map[string]struct {
+		servicePackageName string
+		want               string
+		wantErr            string
+	}{
+		"default": {
+			servicePackageName: "default",
+			want:               "https://test.us-west-2.amazonaws.com", //lintignore:AWSAT003
+		},
+		"FIPS": {
+			servicePackageName: "fips",
+			want:               "https://test-fips.us-west-2.amazonaws.com", //lintignore:AWSAT003
+		},
+		"base endpoint": {
+			servicePackageName: "custom",
+			want:               "https://custom.example.com",
+		},
+		"unknown service package": {
+			servicePackageName: "unknown",
+			wantErr:            "unknown service package",
+		},
+		"no API client factory": {
+			servicePackageName: "noclientfunc",
+			wantErr:            "no AWS SDK v2 API client factory",
+		},
+		"unexpected API client factory": {
+			servicePackageName: "unexpected",
+			wantErr:            "no AWS SDK v2 API client factory",
+		},
+		"no Options method": {
+			servicePackageName: "nooptions",
+			wantErr:            "no Options method",
+		},
+		"no endpoint resolver": {
+			servicePackageName: "noresolver",
+			wantErr:            "no endpoint resolver",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := client.ResolveEndpoint(ctx, testCase.servicePackageName)
+
+			if testCase.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+					t.Fatalf("err = %v, want error containing %q", err, testCase.wantErr)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("ResolveEndpoint = %q, want %q", got, testCase.want)
+			}
+		})
+	}
+}
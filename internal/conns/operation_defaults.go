@@ -0,0 +1,106 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"cmp"
+	"path"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+)
+
+// OperationTimeouts are default resource operation timeouts.
+// A zero value means that the resource's own default is used.
+type OperationTimeouts struct {
+	Create time.Duration
+	Read   time.Duration
+	Update time.Duration
+	Delete time.Duration
+}
+
+// OperationDefaults are the provider-level defaults for operations on resources of the matching types or service packages.
+type OperationDefaults struct {
+	// ResourceTypes are patterns, e.g. "aws_eks_*", matching resource types.
+	ResourceTypes []string
+	// ServicePackages are the names of service packages, e.g. "eks".
+	ServicePackages []string
+	// RetryableErrorCodes are the API error codes retried by the API clients of ServicePackages.
+	RetryableErrorCodes []string
+	Timeouts            OperationTimeouts
+}
+
+func (o *OperationDefaults) matches(servicePackageName, typeName string) bool {
+	if slices.Contains(o.ServicePackages, servicePackageName) {
+		return true
+	}
+
+	return slices.ContainsFunc(o.ResourceTypes, func(pattern string) bool {
+		ok, _ := path.Match(pattern, typeName)
+		return ok
+	})
+}
+
+// operationTimeouts returns the default operation timeouts for the specified resource type.
+// For each operation, the first matching defaults that set a timeout take precedence.
+func operationTimeouts(defaults []OperationDefaults, servicePackageName, typeName string) OperationTimeouts {
+	var timeouts OperationTimeouts
+
+	for _, v := range defaults {
+		if !v.matches(servicePackageName, typeName) {
+			continue
+		}
+
+		timeouts.Create = cmp.Or(timeouts.Create, v.Timeouts.Create)
+		timeouts.Read = cmp.Or(timeouts.Read, v.Timeouts.Read)
+		timeouts.Update = cmp.Or(timeouts.Update, v.Timeouts.Update)
+		timeouts.Delete = cmp.Or(timeouts.Delete, v.Timeouts.Delete)
+	}
+
+	return timeouts
+}
+
+// retryableErrorCodes returns the additional retryable API error codes for the specified service package.
+func retryableErrorCodes(defaults []OperationDefaults, servicePackageName string) []string {
+	var codes []string
+
+	for _, v := range defaults {
+		if slices.Contains(v.ServicePackages, servicePackageName) {
+			codes = append(codes, v.RetryableErrorCodes...)
+		}
+	}
+
+	return codes
+}
+
+// withRetryableErrorCodes returns a copy of the specified AWS configuration whose Retryer also retries the specified API error codes.
+func withRetryableErrorCodes(cfg *aws.Config, codes []string) *aws.Config {
+	if len(codes) == 0 {
+		return cfg
+	}
+
+	v := cfg.Copy()
+	newRetryer := cfg.Retryer
+	v.Retryer = func() aws.Retryer {
+		var r aws.RetryerV2 = retry.NewStandard()
+		if newRetryer != nil {
+			if v, ok := newRetryer().(aws.RetryerV2); ok {
+				r = v
+			}
+		}
+
+		return AddIsErrorRetryables(r, retry.IsErrorRetryableFunc(func(err error) aws.Ternary {
+			if tfawserr.ErrCodeEquals(err, codes...) {
+				return aws.TrueTernary
+			}
+
+			return aws.UnknownTernary // Delegate to configured Retryer.
+		}))
+	}
+
+	return &v
+}
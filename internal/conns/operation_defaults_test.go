@@ -0,0 +1,147 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	smithy "github.com/aws/smithy-go"
+)
+
+func TestOperationTimeouts(t *testing.T) {
+	t.Parallel()
+
+	defaults := []OperationDefaults{
+		{
+			ResourceTypes: []string{"aws_eks_node_group"},
+			Timeouts: OperationTimeouts{
+				Create: 120 * time.Minute,
+			},
+		},
+		{
+			ServicePackages: []string{"eks"},
+			Timeouts: OperationTimeouts{
+				Create: 60 * time.Minute,
+				Delete: 45 * time.Minute,
+			},
+		},
+		{
+			ResourceTypes: []string{"aws_cloudfront_*"},
+			Timeouts: OperationTimeouts{
+				Update: 90 * time.Minute,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		servicePackageName string
+		typeName           string
+		expected           OperationTimeouts
+	}{
+		"no match": {
+			servicePackageName: "ec2",
+			typeName:           "aws_vpc",
+		},
+		"service package": {
+			servicePackageName: "eks",
+			typeName:           "aws_eks_cluster",
+			expected: OperationTimeouts{
+				Create: 60 * time.Minute,
+				Delete: 45 * time.Minute,
+			},
+		},
+		"first match takes precedence": {
+			servicePackageName: "eks",
+			typeName:           "aws_eks_node_group",
+			expected: OperationTimeouts{
+				Create: 120 * time.Minute,
+				Delete: 45 * time.Minute,
+			},
+		},
+		"pattern": {
+			servicePackageName: "cloudfront",
+			typeName:           "aws_cloudfront_distribution",
+			expected: OperationTimeouts{
+				Update: 90 * time.Minute,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := operationTimeouts(defaults, testCase.servicePackageName, testCase.typeName), testCase.expected; got != want {
+				t.Errorf("operationTimeouts = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestWithRetryableErrorCodes(t *testing.T) {
+	t.Parallel()
+
+	defaults := []OperationDefaults{
+		{
+			ServicePackages:     []string{"opensearch"},
+			RetryableErrorCodes: []string{"ConflictException"},
+		},
+		{
+			ResourceTypes:       []string{"aws_opensearch_domain"},
+			ServicePackages:     []string{"opensearch", "eks"},
+			RetryableErrorCodes: []string{"ValidationException"},
+		},
+	}
+
+	if got, want := retryableErrorCodes(defaults, "opensearch"), []string{"ConflictException", "ValidationException"}; !slices.Equal(got, want) {
+		t.Errorf("retryableErrorCodes = %v, want %v", got, want)
+	}
+
+	if got := retryableErrorCodes(defaults, "ec2"); len(got) != 0 {
+		t.Errorf("retryableErrorCodes = %v, want none", got)
+	}
+
+	cfg := &aws.Config{
+		Retryer: func() aws.Retryer {
+			return retry.NewStandard()
+		},
+	}
+
+	if got := withRetryableErrorCodes(cfg, nil); got != cfg {
+		t.Errorf("withRetryableErrorCodes with no codes returned a copy")
+	}
+
+	retryer := withRetryableErrorCodes(cfg, retryableErrorCodes(defaults, "opensearch")).Retryer()
+
+	testCases := map[string]struct {
+		err      error
+		expected bool
+	}{
+		"retryable code": {
+			err:      &smithy.GenericAPIError{Code: "ConflictException"},
+			expected: true,
+		},
+		"non-retryable code": {
+			err: &smithy.GenericAPIError{Code: "AccessDeniedException"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := retryer.IsErrorRetryable(testCase.err), testCase.expected; got != want {
+				t.Errorf("IsErrorRetryable = %t, want %t", got, want)
+			}
+		})
+	}
+
+	if retryer := cfg.Retryer(); retryer.IsErrorRetryable(&smithy.GenericAPIError{Code: "ConflictException"}) {
+		t.Errorf("original Retryer modified")
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/account"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/account/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// verifyRegionAllowed returns an error if the specified Region is not allowed by the provider's
+// `allowed_regions` or `forbidden_regions` configuration.
+func verifyRegionAllowed(region string, allowedRegions, forbiddenRegions []string) error {
+	if slices.Contains(forbiddenRegions, region) {
+		return fmt.Errorf("AWS Region not allowed: %s", region)
+	}
+
+	if len(allowedRegions) > 0 && !slices.Contains(allowedRegions, region) {
+		return fmt.Errorf("AWS Region not allowed: %s", region)
+	}
+
+	return nil
+}
+
+// regionOptStatusGetter is the Account Management API operation used to retrieve a Region's opt-in status.
+type regionOptStatusGetter interface {
+	GetRegionOptStatus(context.Context, *account.GetRegionOptStatusInput, ...func(*account.Options)) (*account.GetRegionOptStatusOutput, error)
+}
+
+// verifyRegionEnabled returns an error if the specified Region is not enabled for the account whose credentials are in effect.
+// The Region's opt-in status is only checked where it is available. Failure to retrieve the status,
+// e.g. due to missing account:GetRegionOptStatus permission, is logged and ignored.
+func (c *AWSClient) verifyRegionEnabled(ctx context.Context, region string) error {
+	// AWS stand-ins don't generally implement the Account Management API.
+	if c.awsConfig == nil || c.awsConfig.BaseEndpoint != nil {
+		return nil
+	}
+
+	return verifyRegionOptStatus(ctx, c.AccountClient(ctx), &c.regionOptStatuses, c.AccountID(ctx), region)
+}
+
+// verifyRegionOptStatus returns an error if the specified Region's opt-in status for the specified account is disabled, or disabling.
+// Retrieved statuses are cached, by account ID and Region, in the specified map. Failures to retrieve a status are not cached.
+func verifyRegionOptStatus(ctx context.Context, conn regionOptStatusGetter, statuses *sync.Map, accountID, region string) error {
+	key := accountID + "|" + region
+	v, ok := statuses.Load(key)
+	if !ok {
+		input := account.GetRegionOptStatusInput{
+			RegionName: aws.String(region),
+		}
+		output, err := conn.GetRegionOptStatus(ctx, &input)
+
+		if err != nil {
+			tflog.Warn(ctx, "Unable to determine AWS Region opt-in status", map[string]any{
+				"tf_aws.region": region,
+				"error":         err.Error(),
+			})
+
+			return nil
+		}
+
+		v, _ = statuses.LoadOrStore(key, output.RegionOptStatus)
+	}
+
+	switch status := v.(awstypes.RegionOptStatus); status {
+	case awstypes.RegionOptStatusDisabled, awstypes.RegionOptStatusDisabling:
+		return fmt.Errorf("AWS Region not enabled: %s (opt-in status %s)", region, status)
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/account"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/account/types"
+)
+
+func TestVerifyRegionAllowed(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		region           string
+		allowedRegions   []string
+		forbiddenRegions []string
+		expectError      bool
+	}{
+		"no configuration": {
+			region: "us-west-2", //lintignore:AWSAT003
+		},
+		"allowed": {
+			region:         "us-west-2",                        //lintignore:AWSAT003
+			allowedRegions: []string{"us-east-1", "us-west-2"}, //lintignore:AWSAT003
+		},
+		"not allowed": {
+			region:         "eu-west-1",                        //lintignore:AWSAT003
+			allowedRegions: []string{"us-east-1", "us-west-2"}, //lintignore:AWSAT003
+			expectError:    true,
+		},
+		"forbidden": {
+			region:           "ap-east-1",           //lintignore:AWSAT003
+			forbiddenRegions: []string{"ap-east-1"}, //lintignore:AWSAT003
+			expectError:      true,
+		},
+		"not forbidden": {
+			region:           "us-west-2",           //lintignore:AWSAT003
+			forbiddenRegions: []string{"ap-east-1"}, //lintignore:AWSAT003
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := verifyRegionAllowed(testCase.region, testCase.allowedRegions, testCase.forbiddenRegions)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("verifyRegionAllowed(%q) error = %v, want error %t", testCase.region, err, want)
+			}
+		})
+	}
+}
+
+type mockRegionOptStatusGetter struct {
+	status awstypes.RegionOptStatus
+	err    error
+	calls  int
+}
+
+func (m *mockRegionOptStatusGetter) GetRegionOptStatus(context.Context, *account.GetRegionOptStatusInput, ...func(*account.Options)) (*account.GetRegionOptStatusOutput, error) {
+	m.calls++
+
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	return &account.GetRegionOptStatusOutput{RegionOptStatus: m.status}, nil
+}
+
+func TestVerifyRegionOptStatus(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		status      awstypes.RegionOptStatus
+		err         error
+		expectError bool
+		expectCalls int
+	}{
+		"enabled": {
+			status:      awstypes.RegionOptStatusEnabled,
+			expectCalls: 1,
+		},
+		"enabled by default": {
+			status:      awstypes.RegionOptStatusEnabledByDefault,
+			expectCalls: 1,
+		},
+		"enabling": {
+			status:      awstypes.RegionOptStatusEnabling,
+			expectCalls: 1,
+		},
+		"disabled": {
+			status:      awstypes.RegionOptStatusDisabled,
+			expectError: true,
+			expectCalls: 1,
+		},
+		"disabling": {
+			status:      awstypes.RegionOptStatusDisabling,
+			expectError: true,
+			expectCalls: 1,
+		},
+		"error not cached": {
+			err:         errors.New("AccessDeniedException"),
+			expectCalls: 2,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			conn := &mockRegionOptStatusGetter{
+				status: testCase.status,
+				err:    testCase.err,
+			}
+			var statuses sync.Map
+
+			// Verify twice to exercise the cache.
+			for range 2 {
+				err := verifyRegionOptStatus(ctx, conn, &statuses, "123456789012", "ap-east-1") //lintignore:AWSAT003
+
+				if got, want := err != nil, testCase.expectError; got != want {
+					t.Errorf("verifyRegionOptStatus() error = %v, want error %t", err, want)
+				}
+			}
+
+			if got, want := conn.calls, testCase.expectCalls; got != want {
+				t.Errorf("GetRegionOptStatus calls = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestVerifyRegionOptStatus_perAccount(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	conn := &mockRegionOptStatusGetter{
+		status: awstypes.RegionOptStatusEnabled,
+	}
+	var statuses sync.Map
+
+	for _, accountID := range []string{"123456789012", "210987654321", "123456789012"} {
+		if err := verifyRegionOptStatus(ctx, conn, &statuses, accountID, "ap-east-1"); err != nil { //lintignore:AWSAT003
+			t.Fatalf("verifyRegionOptStatus(%q) unexpected error: %s", accountID, err)
+		}
+	}
+
+	if got, want := conn.calls, 2; got != want {
+		t.Errorf("GetRegionOptStatus calls = %d, want %d", got, want)
+	}
+}
+
+func TestAWSClientVerifyRegionEnabled_noConfig(t *testing.T) {
+	t.Parallel()
+
+	var client AWSClient
+
+	if err := client.verifyRegionEnabled(t.Context(), "ap-east-1"); err != nil { //lintignore:AWSAT003
+		t.Errorf("verifyRegionEnabled() unexpected error: %s", err)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package conns_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/aws-sdk-go-base/v2/servicemocks"
+	terraformsdk "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2"
+)
+
+func TestConfigureProvider_s3BaseEndpointFromEnv(t *testing.T) {
+	testCases := map[string]struct {
+		envBaseEndpoint     string
+		s3UsePathStyle      *bool
+		expectUsePathStyle  bool
+		expectClientRegion  string
+		expectClientBaseURL string
+	}{
+		"no base endpoint": {
+			expectUsePathStyle: false,
+			expectClientRegion: "aws-global",
+		},
+		"base endpoint": {
+			envBaseEndpoint:     "http://localhost:4566",
+			expectUsePathStyle:  true,
+			expectClientRegion:  "us-east-1",
+			expectClientBaseURL: "http://localhost:4566",
+		},
+		"base endpoint path style disabled": {
+			envBaseEndpoint:     "http://localhost:4566",
+			s3UsePathStyle:      aws.Bool(false),
+			expectUsePathStyle:  false,
+			expectClientRegion:  "us-east-1",
+			expectClientBaseURL: "http://localhost:4566",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			ctx := t.Context()
+
+			// Isolate from any shared configuration and endpoint environment variables.
+			configFile := filepath.Join(t.TempDir(), "config")
+			if err := os.WriteFile(configFile, nil, 0o600); err != nil {
+				t.Fatal(err)
+			}
+			t.Setenv("AWS_CONFIG_FILE", configFile)
+			t.Setenv("AWS_SHARED_CREDENTIALS_FILE", configFile)
+			t.Setenv("AWS_ENDPOINT_URL_S3", "")
+			t.Setenv("AWS_IGNORE_CONFIGURED_ENDPOINT_URLS", "")
+			t.Setenv("AWS_S3_US_EAST_1_REGIONAL_ENDPOINT", "")
+			t.Setenv("AWS_ENDPOINT_URL", tc.envBaseEndpoint)
+
+			config := map[string]any{
+				"access_key":                  "StaticAccessKey",
+				"secret_key":                  servicemocks.MockStaticSecretKey,
+				"region":                      "us-east-1",
+				"skip_credentials_validation": true,
+				"skip_requesting_account_id":  true,
+			}
+			if tc.s3UsePathStyle != nil {
+				config["s3_use_path_style"] = *tc.s3UsePathStyle
+			}
+
+			p, err := sdkv2.NewProvider(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			p.TerraformVersion = "1.0.0"
+
+			diags := p.Configure(ctx, terraformsdk.NewResourceConfigRaw(config))
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			meta := p.Meta().(*conns.AWSClient)
+
+			if got, want := meta.S3UsePathStyle(ctx), tc.expectUsePathStyle; got != want {
+				t.Errorf("S3UsePathStyle: got %t, want %t", got, want)
+			}
+
+			options := meta.S3Client(ctx).Options()
+
+			if got, want := options.UsePathStyle, tc.expectUsePathStyle; got != want {
+				t.Errorf("S3 client UsePathStyle: got %t, want %t", got, want)
+			}
+			if got, want := options.Region, tc.expectClientRegion; got != want {
+				t.Errorf("S3 client Region: got %q, want %q", got, want)
+			}
+			if got, want := aws.ToString(options.BaseEndpoint), tc.expectClientBaseURL; got != want {
+				t.Errorf("S3 client BaseEndpoint: got %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -108,16 +108,21 @@ func NewNameGenerator(optFns ...NameGeneratorOptionsFunc) *nameGenerator {
 }
 
 // Generate generates a new name.
+// Fully generated names conform to any provider-level naming policy in the context.
 func (g *nameGenerator) Generate(ctx context.Context) string {
 	if g.configuredName != "" {
 		return g.configuredName
 	}
 
-	prefix := g.defaultPrefix
 	if g.configuredPrefix != "" {
-		prefix = g.configuredPrefix
+		return prefixedUniqueId(ctx, g.configuredPrefix) + g.suffix
 	}
-	return prefixedUniqueId(ctx, prefix) + g.suffix
+
+	name := prefixedUniqueId(ctx, g.defaultPrefix)
+	if policy, ok := namingPolicyFromContext(ctx); ok {
+		return policy.apply(name, g.suffix)
+	}
+	return name + g.suffix
 }
 
 // prefixedUniqueId generates a unique ID with the given prefix
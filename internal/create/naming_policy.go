@@ -0,0 +1,187 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package create
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+)
+
+const (
+	NamingPolicyCasingLower = "lower"
+	NamingPolicyCasingUpper = "upper"
+)
+
+// NamingPolicySummary is the summary of diagnostics reporting naming policy violations.
+const NamingPolicySummary = "Naming Policy Violation"
+
+// namingPolicyHashLength is the number of hexadecimal digits of the hash appended to truncated names.
+const namingPolicyHashLength = 8
+
+// NamingPolicy is the provider-level resource naming convention.
+// Fully generated names, i.e. those generated when neither a name nor a name prefix is configured, conform to the policy.
+// Configured names and name prefixes are not modified but are validated against the policy at plan time.
+type NamingPolicy struct {
+	// Casing is the casing of names, "lower" or "upper". Empty preserves the casing of the name.
+	Casing string
+	// MaxLength is the maximum length of names. Longer generated names are truncated and a hash appended. 0 means unlimited.
+	MaxLength int
+	Prefix    string
+	Separator string
+	Suffix    string
+}
+
+// MinLength returns the minimum length of a generated name.
+func (p *NamingPolicy) MinLength() int {
+	return len(p.prefix()) + len(p.suffix()) + namingPolicyHashLength
+}
+
+// NameValidator validates a name against a resource's own constraints, e.g. the validators of its name attribute.
+type NameValidator func(string) error
+
+// ValidateName returns an error if the specified configured name does not conform to the policy.
+// validate is the resource's own validation of names, if any. The policy's casing is only enforced if a name in that casing is valid for the resource.
+func (p *NamingPolicy) ValidateName(name string, validate NameValidator) error {
+	if p == nil {
+		return nil
+	}
+
+	var errs []error
+
+	if v := p.prefix(); v != "" && !strings.HasPrefix(name, v) {
+		errs = append(errs, fmt.Errorf("name (%s) does not start with %q", name, v))
+	}
+	if v := p.suffix(); v != "" && !strings.HasSuffix(name, v) {
+		errs = append(errs, fmt.Errorf("name (%s) does not end with %q", name, v))
+	}
+	if err := p.validateCasing("name", name, validate); err != nil {
+		errs = append(errs, err)
+	}
+	if p.MaxLength > 0 && len(name) > p.MaxLength {
+		errs = append(errs, fmt.Errorf("name (%s) is longer than %d characters", name, p.MaxLength))
+	}
+
+	return errors.Join(errs...)
+}
+
+// ValidateNamePrefix returns an error if the specified configured name prefix does not conform to the policy.
+// validate is the resource's own validation of name prefixes, if any. The policy's casing is only enforced if a name prefix in that casing is valid for the resource.
+func (p *NamingPolicy) ValidateNamePrefix(namePrefix string, validate NameValidator) error {
+	if p == nil {
+		return nil
+	}
+
+	var errs []error
+
+	if v := p.prefix(); v != "" && !strings.HasPrefix(namePrefix, v) {
+		errs = append(errs, fmt.Errorf("name prefix (%s) does not start with %q", namePrefix, v))
+	}
+	if err := p.validateCasing("name prefix", namePrefix, validate); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// ValidateGeneratedName returns an error if names generated to conform to the policy are not valid for a resource.
+// validate is the resource's own validation of names. A representative generated name is validated.
+func (p *NamingPolicy) ValidateGeneratedName(validate NameValidator) error {
+	if p == nil || validate == nil {
+		return nil
+	}
+
+	name := p.apply(id.UniqueIdPrefix+strings.Repeat("0", id.UniqueIDSuffixLength), "")
+	if err := validate(name); err != nil {
+		return fmt.Errorf("generated name (%s) is not valid: %w", name, err)
+	}
+
+	return nil
+}
+
+// apply returns the specified generated name conforming to the policy.
+// suffix is any generator-specified suffix, e.g. ".fifo", which always terminates the name.
+func (p *NamingPolicy) apply(name, suffix string) string {
+	prefix, policySuffix := p.prefix(), p.suffix()
+
+	if p.MaxLength > 0 {
+		if n := p.MaxLength - len(prefix) - len(policySuffix) - len(suffix); len(name) > n {
+			name = truncateWithHash(name, n)
+		}
+	}
+
+	return p.withCasing(prefix+name+policySuffix) + suffix
+}
+
+func (p *NamingPolicy) prefix() string {
+	if p.Prefix == "" {
+		return ""
+	}
+	return p.Prefix + p.Separator
+}
+
+func (p *NamingPolicy) suffix() string {
+	if p.Suffix == "" {
+		return ""
+	}
+	return p.Separator + p.Suffix
+}
+
+// withCasing returns the specified string in the policy's casing.
+func (p *NamingPolicy) withCasing(s string) string {
+	switch p.Casing {
+	case NamingPolicyCasingLower:
+		return strings.ToLower(s)
+	case NamingPolicyCasingUpper:
+		return strings.ToUpper(s)
+	}
+
+	return s
+}
+
+func (p *NamingPolicy) validateCasing(label, s string, validate NameValidator) error {
+	v := p.withCasing(s)
+	if s == v {
+		return nil
+	}
+
+	// The casing cannot be enforced if the resource does not accept names in that casing, e.g. upper case S3 bucket names.
+	if validate != nil && validate(v) != nil {
+		return nil
+	}
+
+	return fmt.Errorf("%s (%s) is not %s case", label, s, p.Casing)
+}
+
+// truncateWithHash truncates the specified string to n characters, the last of which are a hash of the whole string.
+func truncateWithHash(s string, n int) string {
+	sum := sha256.Sum256([]byte(s))
+	hash := hex.EncodeToString(sum[:])[:namingPolicyHashLength]
+
+	if n <= namingPolicyHashLength {
+		return hash[:max(n, 0)]
+	}
+
+	return s[:n-namingPolicyHashLength] + hash
+}
+
+type namingPolicyContextKeyType int
+
+var namingPolicyContextKey namingPolicyContextKeyType
+
+// NewNamingPolicyContext returns a new context with the provider-level naming policy stored.
+func NewNamingPolicyContext(ctx context.Context, policy *NamingPolicy) context.Context {
+	return context.WithValue(ctx, namingPolicyContextKey, policy)
+}
+
+// namingPolicyFromContext extracts the provider-level naming policy from the context, if present.
+func namingPolicyFromContext(ctx context.Context) (*NamingPolicy, bool) {
+	policy, ok := ctx.Value(namingPolicyContextKey).(*NamingPolicy)
+	return policy, ok && policy != nil
+}
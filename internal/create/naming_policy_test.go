@@ -0,0 +1,291 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package create
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+)
+
+// lowerCaseNameValidator is a NameValidator modeled on S3 general purpose bucket names.
+func lowerCaseNameValidator(name string) error {
+	if !regexache.MustCompile(`^[0-9a-z.-]{3,63}$`).MatchString(name) {
+		return fmt.Errorf("invalid name: %s", name)
+	}
+	return nil
+}
+
+func TestNameWithNamingPolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName         string
+		policy           *NamingPolicy
+		configuredName   string
+		configuredPrefix string
+		suffix           string
+		expectedRegexp   *regexp.Regexp
+	}{
+		{
+			testName:       "no policy",
+			expectedRegexp: regexache.MustCompile(fmt.Sprintf("^terraform-[[:xdigit:]]{%d}$", id.UniqueIDSuffixLength)),
+		},
+		{
+			testName:       "prefix and suffix",
+			policy:         &NamingPolicy{Prefix: "acme", Separator: "-", Suffix: "prod"},
+			expectedRegexp: regexache.MustCompile(fmt.Sprintf("^acme-terraform-[[:xdigit:]]{%d}-prod$", id.UniqueIDSuffixLength)),
+		},
+		{
+			testName:       "generator suffix",
+			policy:         &NamingPolicy{Prefix: "acme", Separator: "_", Suffix: "prod"},
+			suffix:         ".fifo",
+			expectedRegexp: regexache.MustCompile(fmt.Sprintf("^acme_terraform-[[:xdigit:]]{%d}_prod\\.fifo$", id.UniqueIDSuffixLength)),
+		},
+		{
+			testName:       "upper case",
+			policy:         &NamingPolicy{Casing: NamingPolicyCasingUpper, Prefix: "acme", Separator: "-"},
+			expectedRegexp: regexache.MustCompile(fmt.Sprintf("^ACME-TERRAFORM-[[:xdigit:]]{%d}$", id.UniqueIDSuffixLength)),
+		},
+		{
+			testName:       "truncated",
+			policy:         &NamingPolicy{MaxLength: 32, Prefix: "acme", Separator: "-", Suffix: "prod"},
+			expectedRegexp: regexache.MustCompile(`^acme-terraform-[[:xdigit:]]{4}[[:xdigit:]]{8}-prod$`),
+		},
+		{
+			testName:       "truncated with generator suffix",
+			policy:         &NamingPolicy{Casing: NamingPolicyCasingUpper, MaxLength: 48, Prefix: "acme", Separator: "_", Suffix: "prod"},
+			suffix:         ".fifo",
+			expectedRegexp: regexache.MustCompile(`^ACME_TERRAFORM-[[:xdigit:]]{15}[[:xdigit:]]{8}_PROD\.fifo$`),
+		},
+		{
+			testName:         "configured prefix",
+			policy:           &NamingPolicy{Casing: NamingPolicyCasingUpper, MaxLength: 16, Prefix: "acme", Separator: "-", Suffix: "prod"},
+			configuredPrefix: "pfx-",
+			expectedRegexp:   regexache.MustCompile(fmt.Sprintf("^pfx-[[:xdigit:]]{%d}$", id.UniqueIDSuffixLength)),
+		},
+		{
+			testName:       "configured name",
+			policy:         &NamingPolicy{Casing: NamingPolicyCasingUpper, MaxLength: 16, Prefix: "acme", Separator: "-", Suffix: "prod"},
+			configuredName: "testing",
+			expectedRegexp: regexache.MustCompile(`^testing$`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := NewNamingPolicyContext(context.Background(), testCase.policy)
+			got := NewNameGenerator(
+				WithConfiguredName(testCase.configuredName),
+				WithConfiguredPrefix(testCase.configuredPrefix),
+				WithSuffix(testCase.suffix),
+			).Generate(ctx)
+
+			if !testCase.expectedRegexp.MatchString(got) {
+				t.Errorf("Name(%q, %q) = %v, does not match %s", testCase.configuredName, testCase.configuredPrefix, got, testCase.expectedRegexp)
+			}
+
+			if policy := testCase.policy; policy != nil && policy.MaxLength > 0 && testCase.configuredName == "" && testCase.configuredPrefix == "" && len(got) > policy.MaxLength {
+				t.Errorf("Name(%q, %q) = %v, longer than %d characters", testCase.configuredName, testCase.configuredPrefix, got, policy.MaxLength)
+			}
+		})
+	}
+}
+
+func TestNamingPolicyValidateGeneratedName(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		testName    string
+		policy      *NamingPolicy
+		validate    NameValidator
+		expectError bool
+	}{
+		{
+			testName: "no policy",
+			validate: lowerCaseNameValidator,
+		},
+		{
+			testName: "no validation",
+			policy:   &NamingPolicy{Casing: NamingPolicyCasingUpper},
+		},
+		{
+			testName: "casing valid for resource",
+			policy:   &NamingPolicy{Casing: NamingPolicyCasingLower, Prefix: "ACME", Separator: "-"},
+			validate: lowerCaseNameValidator,
+		},
+		{
+			testName:    "casing not valid for resource",
+			policy:      &NamingPolicy{Casing: NamingPolicyCasingUpper, Prefix: "acme", Separator: "-"},
+			validate:    lowerCaseNameValidator,
+			expectError: true,
+		},
+		{
+			testName:    "separator not valid for resource",
+			policy:      &NamingPolicy{Prefix: "acme", Separator: "_"},
+			validate:    lowerCaseNameValidator,
+			expectError: true,
+		},
+		{
+			testName:    "too long for resource",
+			policy:      &NamingPolicy{MaxLength: 100, Prefix: strings.Repeat("x", 40), Separator: "-"},
+			validate:    lowerCaseNameValidator,
+			expectError: true,
+		},
+		{
+			testName: "truncated for resource",
+			policy:   &NamingPolicy{MaxLength: 63, Prefix: strings.Repeat("x", 40), Separator: "-"},
+			validate: lowerCaseNameValidator,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.policy.ValidateGeneratedName(testCase.validate)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("ValidateGeneratedName() = %v, want error %t", err, want)
+			}
+		})
+	}
+}
+
+func TestNamingPolicyValidateName(t *testing.T) {
+	t.Parallel()
+
+	policy := &NamingPolicy{
+		Casing:    NamingPolicyCasingLower,
+		MaxLength: 24,
+		Prefix:    "acme",
+		Separator: "-",
+		Suffix:    "prod",
+	}
+
+	testCases := []struct {
+		testName    string
+		policy      *NamingPolicy
+		name        string
+		validate    NameValidator
+		expectError bool
+	}{
+		{
+			testName: "no policy",
+			name:     "Testing",
+		},
+		{
+			testName: "valid",
+			policy:   policy,
+			name:     "acme-testing-prod",
+		},
+		{
+			testName:    "missing prefix",
+			policy:      policy,
+			name:        "testing-prod",
+			expectError: true,
+		},
+		{
+			testName:    "missing separator",
+			policy:      policy,
+			name:        "acmetesting-prod",
+			expectError: true,
+		},
+		{
+			testName:    "missing suffix",
+			policy:      policy,
+			name:        "acme-testing",
+			expectError: true,
+		},
+		{
+			testName:    "wrong casing",
+			policy:      policy,
+			name:        "acme-Testing-prod",
+			expectError: true,
+		},
+		{
+			testName:    "too long",
+			policy:      policy,
+			name:        "acme-testing-testing-prod",
+			expectError: true,
+		},
+		{
+			testName:    "wrong casing valid for resource",
+			policy:      &NamingPolicy{Casing: NamingPolicyCasingUpper},
+			name:        "acme-testing-prod",
+			validate:    func(string) error { return nil },
+			expectError: true,
+		},
+		{
+			testName: "casing not valid for resource",
+			policy:   &NamingPolicy{Casing: NamingPolicyCasingUpper},
+			name:     "acme-testing-prod",
+			validate: lowerCaseNameValidator,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.policy.ValidateName(testCase.name, testCase.validate)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("ValidateName(%q) = %v, want error %t", testCase.name, err, want)
+			}
+		})
+	}
+}
+
+func TestNamingPolicyValidateNamePrefix(t *testing.T) {
+	t.Parallel()
+
+	policy := &NamingPolicy{
+		Casing:    NamingPolicyCasingLower,
+		MaxLength: 8,
+		Prefix:    "acme",
+		Separator: "-",
+		Suffix:    "prod",
+	}
+
+	testCases := []struct {
+		testName    string
+		namePrefix  string
+		expectError bool
+	}{
+		{
+			testName:   "valid",
+			namePrefix: "acme-testing-",
+		},
+		{
+			testName:    "missing prefix",
+			namePrefix:  "testing-",
+			expectError: true,
+		},
+		{
+			testName:    "wrong casing",
+			namePrefix:  "acme-Testing-",
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			err := policy.ValidateNamePrefix(testCase.namePrefix, nil)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("ValidateNamePrefix(%q) = %v, want error %t", testCase.namePrefix, err, want)
+			}
+		})
+	}
+}
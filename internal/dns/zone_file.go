@@ -0,0 +1,732 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+// RecordSet is a set of resource records with the same name and type.
+// Name and Values use the Route 53 presentation format, i.e. fully qualified names with octal escape codes
+// and character strings enclosed in double quotation marks.
+//
+// Ref:
+// - https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DomainNameFormat.html.
+// - https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/ResourceRecordTypes.html.
+type RecordSet struct {
+	Name   string
+	Type   string
+	TTL    int64
+	Values []string
+	// Comment is written after each of the record set's records by FormatZoneFile.
+	// A record set without values, e.g. an alias record, is written as a comment.
+	Comment string
+}
+
+type zoneFileRecordType struct {
+	// minFields is the minimum number of RDATA fields.
+	minFields int
+	// domainNames are the indexes of RDATA fields containing domain names that may be relative to the origin.
+	domainNames []int
+	// characterStrings are the indexes of RDATA fields containing character strings, -1 for all fields.
+	characterStrings []int
+}
+
+// zoneFileRecordTypes are the record types supported by Route 53.
+var zoneFileRecordTypes = map[string]zoneFileRecordType{
+	"A":     {minFields: 1},
+	"AAAA":  {minFields: 1},
+	"CAA":   {minFields: 3, characterStrings: []int{2}},
+	"CNAME": {minFields: 1, domainNames: []int{0}},
+	"DS":    {minFields: 4},
+	"HTTPS": {minFields: 2, domainNames: []int{1}},
+	"MX":    {minFields: 2, domainNames: []int{1}},
+	"NAPTR": {minFields: 6, domainNames: []int{5}, characterStrings: []int{2, 3, 4}},
+	"NS":    {minFields: 1, domainNames: []int{0}},
+	"PTR":   {minFields: 1, domainNames: []int{0}},
+	"SOA":   {minFields: 7, domainNames: []int{0, 1}},
+	"SPF":   {minFields: 1, characterStrings: []int{-1}},
+	"SRV":   {minFields: 4, domainNames: []int{3}},
+	"SSHFP": {minFields: 3},
+	"SVCB":  {minFields: 2, domainNames: []int{1}},
+	"TLSA":  {minFields: 4},
+	"TXT":   {minFields: 1, characterStrings: []int{-1}},
+}
+
+// ParseZoneFile parses an RFC 1035 master file into record sets in Route 53 presentation format.
+// Relative names are qualified with origin, which the file's $ORIGIN directives may change.
+// Records without a TTL use the TTL from the last $TTL directive, or failing that the previous record's TTL, or failing that defaultTTL.
+// Records with the same name and type are merged into a single record set, in the order in which they first appear,
+// whose TTL is the lowest of the records' TTLs (RFC 2181 section 5.2).
+//
+// $INCLUDE and $GENERATE directives and classes other than IN are not supported.
+//
+// Ref:
+// - https://datatracker.ietf.org/doc/html/rfc1035#section-5.
+func ParseZoneFile(content, origin string, defaultTTL int64) ([]RecordSet, error) {
+	entries, err := scanZoneFile(content)
+	if err != nil {
+		return nil, err
+	}
+
+	originLabels, _, err := parseZoneFileName(origin)
+	if err != nil {
+		return nil, fmt.Errorf("origin (%s): %w", origin, err)
+	}
+
+	var (
+		owner       []string
+		hasOwner    bool
+		ttl         int64
+		hasTTL      bool
+		previousTTL int64
+		recordSets  []RecordSet
+	)
+	if defaultTTL > 0 {
+		previousTTL = defaultTTL
+	}
+	index := make(map[string]int)
+
+	for _, entry := range entries {
+		tokens := entry.tokens
+
+		if v := tokens[0]; !v.quoted && !entry.indented && strings.HasPrefix(v.text, "$") {
+			switch directive := strings.ToUpper(v.text); directive {
+			case "$ORIGIN":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: $ORIGIN requires a single domain name", entry.line)
+				}
+
+				originLabels, err = qualifyZoneFileName(tokens[1].text, originLabels)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: $ORIGIN: %w", entry.line, err)
+				}
+			case "$TTL":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: $TTL requires a single TTL", entry.line)
+				}
+
+				v, ok := parseZoneFileTTL(tokens[1].text)
+				if !ok {
+					return nil, fmt.Errorf("line %d: $TTL: invalid TTL %q", entry.line, tokens[1].text)
+				}
+				ttl, hasTTL = v, true
+			default:
+				return nil, fmt.Errorf("line %d: unsupported directive %s", entry.line, v.text)
+			}
+
+			continue
+		}
+
+		if !entry.indented {
+			owner, err = qualifyZoneFileName(tokens[0].text, originLabels)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: owner name: %w", entry.line, err)
+			}
+			hasOwner = true
+			tokens = tokens[1:]
+		} else if !hasOwner {
+			return nil, fmt.Errorf("line %d: no owner name", entry.line)
+		}
+
+		var (
+			recordTTL    int64
+			hasRecordTTL bool
+			rrType       string
+		)
+		for len(tokens) > 0 && rrType == "" {
+			v := tokens[0].text
+			tokens = tokens[1:]
+
+			if !hasRecordTTL {
+				if n, ok := parseZoneFileTTL(v); ok {
+					recordTTL, hasRecordTTL = n, true
+					continue
+				}
+			}
+
+			switch v := strings.ToUpper(v); v {
+			case "IN":
+			case "CH", "CS", "HS":
+				return nil, fmt.Errorf("line %d: unsupported class %s", entry.line, v)
+			default:
+				rrType = v
+			}
+		}
+
+		if rrType == "" {
+			return nil, fmt.Errorf("line %d: no record type", entry.line)
+		}
+
+		recordType, ok := zoneFileRecordTypes[rrType]
+		if !ok {
+			return nil, fmt.Errorf("line %d: unsupported record type %s", entry.line, rrType)
+		}
+
+		if len(tokens) < recordType.minFields {
+			return nil, fmt.Errorf("line %d: %s record requires at least %d RDATA fields, got %d", entry.line, rrType, recordType.minFields, len(tokens))
+		}
+
+		switch {
+		case hasRecordTTL:
+		case hasTTL:
+			recordTTL = ttl
+		case previousTTL > 0:
+			recordTTL = previousTTL
+		default:
+			return nil, fmt.Errorf("line %d: no TTL", entry.line)
+		}
+		previousTTL = recordTTL
+
+		value, err := formatZoneFileRDATA(tokens, recordType, originLabels)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s record: %w", entry.line, rrType, err)
+		}
+
+		name := formatRoute53Name(owner)
+		key := name + " " + rrType
+		if i, ok := index[key]; ok {
+			recordSets[i].TTL = min(recordSets[i].TTL, recordTTL)
+			if !slices.Contains(recordSets[i].Values, value) {
+				recordSets[i].Values = append(recordSets[i].Values, value)
+			}
+
+			continue
+		}
+
+		index[key] = len(recordSets)
+		recordSets = append(recordSets, RecordSet{
+			Name:   name,
+			Type:   rrType,
+			TTL:    recordTTL,
+			Values: []string{value},
+		})
+	}
+
+	return recordSets, nil
+}
+
+// FormatZoneFile renders record sets in Route 53 presentation format as an RFC 1035 master file.
+// Names within origin are written relative to it.
+func FormatZoneFile(origin string, recordSets []RecordSet) (string, error) {
+	originLabels, err := parseRoute53Name(origin)
+	if err != nil {
+		return "", fmt.Errorf("origin (%s): %w", origin, err)
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "$ORIGIN %s\n", formatZoneFileName(originLabels, nil))
+
+	w := tabwriter.NewWriter(&sb, 0, 8, 1, ' ', 0)
+	for _, recordSet := range recordSets {
+		labels, err := parseRoute53Name(recordSet.Name)
+		if err != nil {
+			return "", fmt.Errorf("record set (%s): %w", recordSet.Name, err)
+		}
+		name := formatZoneFileName(labels, originLabels)
+
+		if len(recordSet.Values) == 0 {
+			fmt.Fprintf(w, "; %s\t\tIN\t%s\t; %s\n", name, recordSet.Type, recordSet.Comment)
+			continue
+		}
+
+		for _, value := range recordSet.Values {
+			value, err := formatZoneFileValue(recordSet.Type, value)
+			if err != nil {
+				return "", fmt.Errorf("record set (%s %s): %w", recordSet.Name, recordSet.Type, err)
+			}
+
+			fmt.Fprintf(w, "%s\t%d\tIN\t%s\t%s", name, recordSet.TTL, recordSet.Type, value)
+			if recordSet.Comment != "" {
+				fmt.Fprintf(w, " ; %s", recordSet.Comment)
+			}
+			fmt.Fprintln(w)
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		return "", err
+	}
+
+	return sb.String(), nil
+}
+
+type zoneFileToken struct {
+	// text is the token's text with escape sequences intact. Quoted strings exclude the quotation marks.
+	text   string
+	quoted bool
+}
+
+type zoneFileEntry struct {
+	// line is the line number on which the entry starts.
+	line int
+	// indented is whether the entry starts with blank space, i.e. the owner name is omitted.
+	indented bool
+	tokens   []zoneFileToken
+}
+
+// scanZoneFile splits a master file into entries, removing comments and joining lines within parentheses.
+func scanZoneFile(content string) ([]zoneFileEntry, error) {
+	var (
+		entries      []zoneFileEntry
+		entry        zoneFileEntry
+		line         = 1
+		parens       int
+		startOfEntry = true
+	)
+
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+
+		if startOfEntry {
+			entry = zoneFileEntry{line: line, indented: c == ' ' || c == '\t'}
+			startOfEntry = false
+		}
+
+		switch c {
+		case '\n':
+			line++
+			if parens == 0 {
+				if len(entry.tokens) > 0 {
+					entries = append(entries, entry)
+				}
+				startOfEntry = true
+			}
+		case ' ', '\t', '\r':
+		case ';':
+			for i+1 < len(content) && content[i+1] != '\n' {
+				i++
+			}
+		case '(':
+			parens++
+		case ')':
+			if parens == 0 {
+				return nil, fmt.Errorf("line %d: unbalanced parentheses", line)
+			}
+			parens--
+		case '"':
+			start := i + 1
+			for i++; i < len(content) && content[i] != '"'; i++ {
+				switch content[i] {
+				case '\\':
+					i++
+				case '\n':
+					return nil, fmt.Errorf("line %d: unterminated quoted string", line)
+				}
+			}
+			if i >= len(content) {
+				return nil, fmt.Errorf("line %d: unterminated quoted string", line)
+			}
+			entry.tokens = append(entry.tokens, zoneFileToken{text: content[start:i], quoted: true})
+		default:
+			start := i
+			for ; i < len(content) && !strings.ContainsRune(" \t\r\n;()\"", rune(content[i])); i++ {
+				if content[i] == '\\' {
+					i++
+				}
+			}
+			i = min(i, len(content))
+			entry.tokens = append(entry.tokens, zoneFileToken{text: content[start:i]})
+			i--
+		}
+	}
+
+	if parens > 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", entry.line)
+	}
+
+	if !startOfEntry && len(entry.tokens) > 0 {
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// zoneFileTTLUnits are the number of seconds in each of BIND's TTL units.
+var zoneFileTTLUnits = map[rune]int64{
+	's': 1,
+	'm': 60,
+	'h': 60 * 60,
+	'd': 24 * 60 * 60,
+	'w': 7 * 24 * 60 * 60,
+}
+
+// parseZoneFileTTL parses a TTL in seconds, optionally using BIND's unit suffixes, e.g. "1h30m".
+func parseZoneFileTTL(s string) (int64, bool) {
+	if s == "" {
+		return 0, false
+	}
+
+	if n, err := strconv.ParseInt(s, 10, 32); err == nil {
+		return n, n >= 0
+	}
+
+	var ttl, n int64
+	var hasDigits bool
+	for _, c := range strings.ToLower(s) {
+		switch {
+		case c >= '0' && c <= '9':
+			n = n*10 + int64(c-'0')
+			hasDigits = true
+		case hasDigits && zoneFileTTLUnits[c] > 0:
+			ttl += n * zoneFileTTLUnits[c]
+			n, hasDigits = 0, false
+		default:
+			return 0, false
+		}
+	}
+
+	if hasDigits {
+		return 0, false
+	}
+
+	return ttl, true
+}
+
+// unescapeZoneFileText decodes "\DDD" (decimal) and "\X" escape sequences.
+func unescapeZoneFileText(s string) (string, error) {
+	var sb strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' {
+			sb.WriteByte(s[i])
+			continue
+		}
+
+		if i+1 >= len(s) {
+			return "", fmt.Errorf("%q: incomplete escape sequence", s)
+		}
+
+		if i+3 < len(s) && isDigits(s[i+1:i+4]) {
+			n, _ := strconv.Atoi(s[i+1 : i+4])
+			if n > 255 {
+				return "", fmt.Errorf("%q: invalid escape sequence \\%s", s, s[i+1:i+4])
+			}
+			sb.WriteByte(byte(n))
+			i += 3
+			continue
+		}
+
+		sb.WriteByte(s[i+1])
+		i++
+	}
+
+	return sb.String(), nil
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// parseZoneFileName splits a domain name in master file format into its decoded labels.
+func parseZoneFileName(s string) ([]string, bool, error) {
+	if s == "." {
+		return nil, true, nil
+	}
+
+	var (
+		labels []string
+		label  strings.Builder
+	)
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\\':
+			j := i + 2
+			if i+3 < len(s) && isDigits(s[i+1:i+4]) {
+				j = i + 4
+			}
+			if j > len(s) {
+				return nil, false, fmt.Errorf("%q: incomplete escape sequence", s)
+			}
+			v, err := unescapeZoneFileText(s[i:j])
+			if err != nil {
+				return nil, false, err
+			}
+			label.WriteString(v)
+			i = j - 1
+		case '.':
+			if label.Len() == 0 {
+				return nil, false, fmt.Errorf("%q: empty label", s)
+			}
+			labels = append(labels, label.String())
+			label.Reset()
+		default:
+			label.WriteByte(c)
+		}
+	}
+
+	if label.Len() > 0 {
+		labels = append(labels, label.String())
+		return labels, false, nil
+	}
+
+	return labels, true, nil
+}
+
+// qualifyZoneFileName returns the labels of a domain name in master file format, qualified with the origin if relative.
+func qualifyZoneFileName(s string, origin []string) ([]string, error) {
+	if s == "@" {
+		return origin, nil
+	}
+
+	labels, absolute, err := parseZoneFileName(s)
+	if err != nil {
+		return nil, err
+	}
+
+	if !absolute {
+		labels = append(labels, origin...)
+	}
+
+	return labels, nil
+}
+
+// formatRoute53Name returns the fully qualified domain name in Route 53 format.
+// Route 53 accepts the `*` of wildcard names literally, as written in configuration.
+func formatRoute53Name(labels []string) string {
+	if len(labels) == 0 {
+		return "."
+	}
+
+	var sb strings.Builder
+	for _, label := range labels {
+		for i := 0; i < len(label); i++ {
+			switch c := label[i]; {
+			case c >= 'A' && c <= 'Z':
+				sb.WriteByte(c + ('a' - 'A'))
+			case c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c == '-' || c == '_' || c == '*':
+				sb.WriteByte(c)
+			default:
+				fmt.Fprintf(&sb, "\\%03o", c)
+			}
+		}
+		sb.WriteByte('.')
+	}
+
+	return sb.String()
+}
+
+// formatRoute53CharacterString returns the character string enclosed in double quotation marks, in Route 53 format.
+func formatRoute53CharacterString(s string) string {
+	var sb strings.Builder
+
+	sb.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '"' || c == '\\':
+			sb.WriteByte('\\')
+			sb.WriteByte(c)
+		case c >= ' ' && c <= '~':
+			sb.WriteByte(c)
+		default:
+			fmt.Fprintf(&sb, "\\%03o", c)
+		}
+	}
+	sb.WriteByte('"')
+
+	return sb.String()
+}
+
+// formatZoneFileRDATA returns a record's RDATA fields as a value in Route 53 format.
+func formatZoneFileRDATA(tokens []zoneFileToken, recordType zoneFileRecordType, origin []string) (string, error) {
+	fields := make([]string, 0, len(tokens))
+
+	for i, token := range tokens {
+		switch {
+		case slices.Contains(recordType.domainNames, i) && token.text != ".":
+			labels, err := qualifyZoneFileName(token.text, origin)
+			if err != nil {
+				return "", err
+			}
+			fields = append(fields, formatRoute53Name(labels))
+		case slices.Contains(recordType.characterStrings, i) || slices.Contains(recordType.characterStrings, -1) || token.quoted:
+			v, err := unescapeZoneFileText(token.text)
+			if err != nil {
+				return "", err
+			}
+			if len(v) > 255 {
+				return "", fmt.Errorf("character string longer than 255 characters")
+			}
+			fields = append(fields, formatRoute53CharacterString(v))
+		default:
+			fields = append(fields, token.text)
+		}
+	}
+
+	return strings.Join(fields, " "), nil
+}
+
+// parseRoute53Name splits a domain name in Route 53 format into its decoded labels.
+func parseRoute53Name(s string) ([]string, error) {
+	s = strings.TrimSuffix(s, ".")
+	if s == "" {
+		return nil, nil
+	}
+
+	labels := strings.Split(s, ".")
+	for i, label := range labels {
+		v, err := unescapeRoute53Text(label)
+		if err != nil {
+			return nil, err
+		}
+		labels[i] = v
+	}
+
+	return labels, nil
+}
+
+// unescapeRoute53Text decodes "\ooo" (octal) and "\X" escape sequences.
+func unescapeRoute53Text(s string) (string, error) {
+	var sb strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' {
+			sb.WriteByte(s[i])
+			continue
+		}
+
+		if i+1 >= len(s) {
+			return "", fmt.Errorf("%q: incomplete escape sequence", s)
+		}
+
+		if i+3 < len(s) {
+			if n, err := strconv.ParseUint(s[i+1:i+4], 8, 8); err == nil {
+				sb.WriteByte(byte(n))
+				i += 3
+				continue
+			}
+		}
+
+		sb.WriteByte(s[i+1])
+		i++
+	}
+
+	return sb.String(), nil
+}
+
+// formatZoneFileName returns the domain name in master file format, relative to the origin if within it.
+func formatZoneFileName(labels, origin []string) string {
+	if origin != nil && len(labels) >= len(origin) && slices.EqualFunc(labels[len(labels)-len(origin):], origin, strings.EqualFold) {
+		if len(labels) == len(origin) {
+			return "@"
+		}
+
+		return strings.Join(escapeZoneFileLabels(labels[:len(labels)-len(origin)]), ".")
+	}
+
+	if len(labels) == 0 {
+		return "."
+	}
+
+	return strings.Join(escapeZoneFileLabels(labels), ".") + "."
+}
+
+func escapeZoneFileLabels(labels []string) []string {
+	escaped := make([]string, len(labels))
+
+	for i, label := range labels {
+		var sb strings.Builder
+		for j := 0; j < len(label); j++ {
+			switch c := label[j]; {
+			case c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '-' || c == '_' || c == '*':
+				sb.WriteByte(c)
+			default:
+				fmt.Fprintf(&sb, "\\%03d", c)
+			}
+		}
+		escaped[i] = sb.String()
+	}
+
+	return escaped
+}
+
+// formatZoneFileValue converts a record value in Route 53 format to master file format.
+// Domain name fields are fully qualified, as Route 53 treats all domain names as fully qualified.
+// Character strings are re-encoded with decimal escape codes. Other fields are unchanged.
+func formatZoneFileValue(rrType, s string) (string, error) {
+	fields, err := splitRoute53Value(s)
+	if err != nil {
+		return "", err
+	}
+
+	recordType := zoneFileRecordTypes[rrType]
+	for i, field := range fields {
+		switch {
+		case strings.HasPrefix(field, `"`):
+			v, err := unescapeRoute53Text(field[1 : len(field)-1])
+			if err != nil {
+				return "", err
+			}
+
+			var sb strings.Builder
+			sb.WriteByte('"')
+			for j := 0; j < len(v); j++ {
+				switch c := v[j]; {
+				case c == '"' || c == '\\':
+					sb.WriteByte('\\')
+					sb.WriteByte(c)
+				case c >= ' ' && c <= '~':
+					sb.WriteByte(c)
+				default:
+					fmt.Fprintf(&sb, "\\%03d", c)
+				}
+			}
+			sb.WriteByte('"')
+			fields[i] = sb.String()
+		case slices.Contains(recordType.domainNames, i):
+			labels, err := parseRoute53Name(field)
+			if err != nil {
+				return "", err
+			}
+			fields[i] = formatZoneFileName(labels, nil)
+		}
+	}
+
+	return strings.Join(fields, " "), nil
+}
+
+// splitRoute53Value splits a record value in Route 53 format into its fields.
+// Character strings are returned with their enclosing double quotation marks.
+func splitRoute53Value(s string) ([]string, error) {
+	var fields []string
+
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case ' ', '\t':
+		case '"':
+			start := i
+			for i++; i < len(s) && s[i] != '"'; i++ {
+				if s[i] == '\\' {
+					i++
+				}
+			}
+			if i >= len(s) {
+				return nil, fmt.Errorf("%q: unterminated quoted string", s)
+			}
+			fields = append(fields, s[start:i+1])
+		default:
+			start := i
+			for ; i < len(s) && s[i] != ' ' && s[i] != '\t' && s[i] != '"'; i++ {
+				if s[i] == '\\' {
+					i++
+				}
+			}
+			i = min(i, len(s))
+			fields = append(fields, s[start:i])
+			i--
+		}
+	}
+
+	return fields, nil
+}
@@ -0,0 +1,302 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dns
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseZoneFile(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		content       string
+		origin        string
+		defaultTTL    int64
+		expected      []RecordSet
+		expectedError string
+	}
+	tests := map[string]testCase{
+		"directives and relative names": {
+			content: `$ORIGIN example.com.
+$TTL 1h
+@	IN	SOA	ns1 hostmaster (
+			2024010101 ; serial
+			7200       ; refresh
+			900        ; retry
+			1209600    ; expire
+			86400 )    ; minimum
+	IN	NS	ns1
+	IN	NS	ns2.example.net.
+www	300	IN	A	192.0.2.1
+	IN 300	A	192.0.2.2
+WWW		A	192.0.2.1 ; duplicate
+*.dev	CNAME	www
+$ORIGIN sub
+api	MX	10 mail
+`,
+			origin: "example.org",
+			expected: []RecordSet{
+				{Name: "example.com.", Type: "SOA", TTL: 3600, Values: []string{"ns1.example.com. hostmaster.example.com. 2024010101 7200 900 1209600 86400"}},
+				{Name: "example.com.", Type: "NS", TTL: 3600, Values: []string{"ns1.example.com.", "ns2.example.net."}},
+				{Name: "www.example.com.", Type: "A", TTL: 300, Values: []string{"192.0.2.1", "192.0.2.2"}},
+				{Name: "*.dev.example.com.", Type: "CNAME", TTL: 3600, Values: []string{"www.example.com."}},
+				{Name: "api.sub.example.com.", Type: "MX", TTL: 3600, Values: []string{"10 mail.sub.example.com."}},
+			},
+		},
+		"TXT strings and escapes": {
+			content: `@ TXT "v=spf1 include:example.net" "-all"
+long TXT ( "part one"
+           "part two" )
+esc TXT "say \"hi\"\\" \009tab unquoted
+a\.b TXT "\195\169"
+`,
+			origin:     "example.com.",
+			defaultTTL: 60,
+			expected: []RecordSet{
+				{Name: "example.com.", Type: "TXT", TTL: 60, Values: []string{`"v=spf1 include:example.net" "-all"`}},
+				{Name: "long.example.com.", Type: "TXT", TTL: 60, Values: []string{`"part one" "part two"`}},
+				{Name: "esc.example.com.", Type: "TXT", TTL: 60, Values: []string{`"say \"hi\"\\" "\011tab" "unquoted"`}},
+				{Name: "a\\056b.example.com.", Type: "TXT", TTL: 60, Values: []string{`"\303\251"`}},
+			},
+		},
+		"other types": {
+			content: `@ 1d CAA 0 issue "letsencrypt.org"
+_sip._tcp 1w2d SRV 10 60 5060 sip
+@ 60 NAPTR 100 10 "u" "E2U+sip" "!^.*$!sip:info@example.com!" .
+@ 60 HTTPS 1 . alpn=h2
+`,
+			origin: "example.com.",
+			expected: []RecordSet{
+				{Name: "example.com.", Type: "CAA", TTL: 86400, Values: []string{`0 issue "letsencrypt.org"`}},
+				{Name: "_sip._tcp.example.com.", Type: "SRV", TTL: 777600, Values: []string{"10 60 5060 sip.example.com."}},
+				{Name: "example.com.", Type: "NAPTR", TTL: 60, Values: []string{`100 10 "u" "E2U+sip" "!^.*$!sip:info@example.com!" .`}},
+				{Name: "example.com.", Type: "HTTPS", TTL: 60, Values: []string{"1 . alpn=h2"}},
+			},
+		},
+		"lowest TTL": {
+			content: `www 300 A 192.0.2.1
+www 60 A 192.0.2.2
+`,
+			origin: "example.com.",
+			expected: []RecordSet{
+				{Name: "www.example.com.", Type: "A", TTL: 60, Values: []string{"192.0.2.1", "192.0.2.2"}},
+			},
+		},
+		"no TTL": {
+			content:       "www A 192.0.2.1\n",
+			origin:        "example.com.",
+			expectedError: "line 1: no TTL",
+		},
+		"no owner": {
+			content:       "  300 A 192.0.2.1\n",
+			origin:        "example.com.",
+			expectedError: "line 1: no owner name",
+		},
+		"unsupported type": {
+			content:       "www 300 HINFO PC Linux\n",
+			origin:        "example.com.",
+			expectedError: "line 1: unsupported record type HINFO",
+		},
+		"unsupported class": {
+			content:       "www 300 CH A 192.0.2.1\n",
+			origin:        "example.com.",
+			expectedError: "line 1: unsupported class CH",
+		},
+		"unsupported directive": {
+			content:       "$INCLUDE other.zone\n",
+			origin:        "example.com.",
+			expectedError: "line 1: unsupported directive $INCLUDE",
+		},
+		"too few fields": {
+			content:       "\n\nmail 300 MX 10\n",
+			origin:        "example.com.",
+			expectedError: "line 3: MX record requires at least 2 RDATA fields, got 1",
+		},
+		"unbalanced parentheses": {
+			content:       "@ 300 SOA ns1 hostmaster ( 1 2 3 4 5\n",
+			origin:        "example.com.",
+			expectedError: "line 1: unbalanced parentheses",
+		},
+		"unterminated quoted string": {
+			content:       "@ 300 TXT \"abc\n",
+			origin:        "example.com.",
+			expectedError: "line 1: unterminated quoted string",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseZoneFile(test.content, test.origin, test.defaultTTL)
+
+			if test.expectedError != "" {
+				if err == nil || err.Error() != test.expectedError {
+					t.Fatalf("expected error %q, got %v", test.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFormatZoneFile(t *testing.T) {
+	t.Parallel()
+
+	recordSets := []RecordSet{
+		{Name: "example.com.", Type: "NS", TTL: 172800, Values: []string{"ns-1.awsdns-01.org.", "ns-2.awsdns-02.com."}},
+		{Name: "\\052.example.com.", Type: "A", TTL: 300, Values: []string{"192.0.2.1"}},
+		{Name: "a\\056b.example.com.", Type: "TXT", TTL: 60, Values: []string{`"say \"hi\"" "\303\251"`}},
+		{Name: "www.example.com.", Type: "A", TTL: 60, Values: []string{"192.0.2.2"}, Comment: "set_identifier=blue weight=10"},
+		{Name: "api.example.com.", Type: "A", Comment: "alias to d111111abcdef8.cloudfront.net. (Z2FDTNDATAQYW2)"},
+		{Name: "other.example.net.", Type: "CNAME", TTL: 300, Values: []string{"example.com."}},
+	}
+
+	got, err := FormatZoneFile("example.com", recordSets)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `$ORIGIN example.com.
+@                  172800 IN NS    ns-1.awsdns-01.org.
+@                  172800 IN NS    ns-2.awsdns-02.com.
+*                  300    IN A     192.0.2.1
+a\046b             60     IN TXT   "say \"hi\"" "\195\169"
+www                60     IN A     192.0.2.2 ; set_identifier=blue weight=10
+; api                     IN A     ; alias to d111111abcdef8.cloudfront.net. (Z2FDTNDATAQYW2)
+other.example.net. 300    IN CNAME example.com.
+`
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	// The output parses back to the record sets with values.
+	parsed, err := ParseZoneFile(got, "example.org.", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var expected []RecordSet
+	for _, recordSet := range recordSets {
+		if len(recordSet.Values) > 0 {
+			recordSet.Name = strings.ReplaceAll(recordSet.Name, `\052`, "*")
+			recordSet.Comment = ""
+			expected = append(expected, recordSet)
+		}
+	}
+	if diff := cmp.Diff(parsed, expected); diff != "" {
+		t.Errorf("unexpected round trip diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestFormatZoneFileValue(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		rrType        string
+		value         string
+		expected      string
+		expectedError string
+	}
+	tests := map[string]testCase{
+		"A": {
+			rrType:   "A",
+			value:    "192.0.2.1",
+			expected: "192.0.2.1",
+		},
+		"CNAME fully qualified": {
+			rrType:   "CNAME",
+			value:    "www.example.com.",
+			expected: "www.example.com.",
+		},
+		"CNAME not fully qualified": {
+			rrType:   "CNAME",
+			value:    "www.example.com",
+			expected: "www.example.com.",
+		},
+		"CNAME wildcard": {
+			rrType:   "CNAME",
+			value:    "\\052.example.com",
+			expected: "*.example.com.",
+		},
+		"MX": {
+			rrType:   "MX",
+			value:    "10 mail.example.com",
+			expected: "10 mail.example.com.",
+		},
+		"NS": {
+			rrType:   "NS",
+			value:    "ns-1.awsdns-01.org",
+			expected: "ns-1.awsdns-01.org.",
+		},
+		"SRV": {
+			rrType:   "SRV",
+			value:    "10 60 5060 sip.example.com",
+			expected: "10 60 5060 sip.example.com.",
+		},
+		"SRV root target": {
+			rrType:   "SRV",
+			value:    "0 0 0 .",
+			expected: "0 0 0 .",
+		},
+		"PTR": {
+			rrType:   "PTR",
+			value:    "host.example.com",
+			expected: "host.example.com.",
+		},
+		"SOA": {
+			rrType:   "SOA",
+			value:    "ns-1.awsdns-01.org awsdns-hostmaster.amazon.com 1 7200 900 1209600 86400",
+			expected: "ns-1.awsdns-01.org. awsdns-hostmaster.amazon.com. 1 7200 900 1209600 86400",
+		},
+		"TXT": {
+			rrType:   "TXT",
+			value:    `"say \"hi\""  "\303\251"`,
+			expected: `"say \"hi\"" "\195\169"`,
+		},
+		"NAPTR": {
+			rrType:   "NAPTR",
+			value:    `100 10 "u" "E2U+sip" "!^.*$!sip:info@example.com!" sip.example.com`,
+			expected: `100 10 "u" "E2U+sip" "!^.*$!sip:info@example.com!" sip.example.com.`,
+		},
+		"unterminated quoted string": {
+			rrType:        "TXT",
+			value:         `"abc`,
+			expectedError: `"\"abc": unterminated quoted string`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := formatZoneFileValue(test.rrType, test.value)
+
+			if test.expectedError != "" {
+				if err == nil || err.Error() != test.expectedError {
+					t.Fatalf("expected error %q, got %v", test.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != test.expected {
+				t.Errorf("got %q, want %q", got, test.expected)
+			}
+		})
+	}
+}
@@ -201,6 +201,34 @@ func diagConvertingTargetIsNotPointer(targetType reflect.Type) diag.ErrorDiagnos
 	)
 }
 
+// diagStrictMode returns a diagnostic with the strict mode severity configured in opts.
+func diagStrictMode(opts AutoFlexOptions, summary, detail string) diag.Diagnostic {
+	if opts.strictModeSeverity == diag.SeverityError {
+		return diag.NewErrorDiagnostic(summary, detail)
+	}
+	return diag.NewWarningDiagnostic(summary, detail)
+}
+
+func diagUnmappedField(opts AutoFlexOptions, sourcePath path.Path, sourceType, targetType reflect.Type) diag.Diagnostic {
+	return diagStrictMode(opts,
+		"Unmapped Field",
+		"A source field has no corresponding target field and was not converted. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Source field %q of type %q has no corresponding field in type %q", sourcePath, fullTypeName(sourceType), fullTypeName(targetType)),
+	)
+}
+
+func diagLossyConversion(opts AutoFlexOptions, sourcePath path.Path, sourceType, targetType reflect.Type, reason string) diag.Diagnostic {
+	return diagStrictMode(opts,
+		"Lossy Conversion",
+		"A value could not be converted without loss. "+
+			"This is always an error in the provider. "+
+			"Please report the following to the provider developer:\n\n"+
+			fmt.Sprintf("Converting %q from type %q to type %q: %s", sourcePath, fullTypeName(sourceType), fullTypeName(targetType), reason),
+	)
+}
+
 func valueType(v reflect.Value) reflect.Type {
 	if v.Kind() == reflect.Invalid {
 		return nil
@@ -85,6 +85,35 @@ func (expander autoExpander) getOptions() AutoFlexOptions {
 	return expander.Options
 }
 
+// checkInt reports, in strict mode, an integer value that overflows the target type.
+func (expander autoExpander) checkInt(sourcePath path.Path, sourceType reflect.Type, from int64, targetType reflect.Type) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if opts := expander.getOptions(); opts.isStrictMode() && reflect.Zero(targetType).OverflowInt(from) {
+		diags.Append(diagLossyConversion(opts, sourcePath, sourceType, targetType, fmt.Sprintf("value %d overflows %s", from, targetType)))
+	}
+
+	return diags
+}
+
+// checkFloat reports, in strict mode, a floating point value that overflows or loses precision in the target type.
+func (expander autoExpander) checkFloat(sourcePath path.Path, sourceType reflect.Type, from float64, targetType reflect.Type) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	opts := expander.getOptions()
+	if !opts.isStrictMode() || targetType.Kind() != reflect.Float32 {
+		return diags
+	}
+
+	if reflect.Zero(targetType).OverflowFloat(from) {
+		diags.Append(diagLossyConversion(opts, sourcePath, sourceType, targetType, fmt.Sprintf("value %g overflows %s", from, targetType)))
+	} else if float64(float32(from)) != from {
+		diags.Append(diagLossyConversion(opts, sourcePath, sourceType, targetType, fmt.Sprintf("value %g loses precision as %s", from, targetType)))
+	}
+
+	return diags
+}
+
 // getCachedField returns a cached field lookup or performs and caches the lookup
 func (expander *autoExpander) getCachedField(t reflect.Type, fieldName string) (reflect.StructField, bool) {
 	if expander.fieldCache == nil {
@@ -241,7 +270,7 @@ func (expander autoExpander) convert(ctx context.Context, sourcePath path.Path,
 		return diags
 
 	case basetypes.Float64Valuable:
-		diags.Append(expander.float64(ctx, vFrom, vTo, fieldOpts)...)
+		diags.Append(expander.float64(ctx, sourcePath, vFrom, vTo, fieldOpts)...)
 		return diags
 
 	case basetypes.Float32Valuable:
@@ -249,7 +278,7 @@ func (expander autoExpander) convert(ctx context.Context, sourcePath path.Path,
 		return diags
 
 	case basetypes.Int64Valuable:
-		diags.Append(expander.int64(ctx, vFrom, vTo, fieldOpts)...)
+		diags.Append(expander.int64(ctx, sourcePath, vFrom, vTo, fieldOpts)...)
 		return diags
 
 	case basetypes.Int32Valuable:
@@ -330,7 +359,7 @@ func (expander autoExpander) bool(ctx context.Context, vFrom basetypes.BoolValua
 }
 
 // float64 copies a Plugin Framework Float64(ish) value to a compatible AWS API value.
-func (expander autoExpander) float64(ctx context.Context, vFrom basetypes.Float64Valuable, vTo reflect.Value, fieldOpts fieldOpts) diag.Diagnostics {
+func (expander autoExpander) float64(ctx context.Context, sourcePath path.Path, vFrom basetypes.Float64Valuable, vTo reflect.Value, fieldOpts fieldOpts) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	v, d := vFrom.ToFloat64Value(ctx)
@@ -344,6 +373,7 @@ func (expander autoExpander) float64(ctx context.Context, vFrom basetypes.Float6
 		//
 		// types.Float32/types.Float64 -> float32/float64.
 		//
+		diags.Append(expander.checkFloat(sourcePath, reflect.TypeOf(vFrom), v.ValueFloat64(), tTo)...)
 		vTo.SetFloat(v.ValueFloat64())
 		return diags
 
@@ -353,6 +383,7 @@ func (expander autoExpander) float64(ctx context.Context, vFrom basetypes.Float6
 			//
 			// types.Float32/types.Float64 -> *float32.
 			//
+			diags.Append(expander.checkFloat(sourcePath, reflect.TypeOf(vFrom), v.ValueFloat64(), tElem)...)
 			to := float32(v.ValueFloat64())
 			if fieldOpts.legacy {
 				tflog.SubsystemDebug(ctx, subsystemName, "Using legacy expander")
@@ -427,7 +458,7 @@ func (expander autoExpander) float32(ctx context.Context, vFrom basetypes.Float3
 }
 
 // int64 copies a Plugin Framework Int64(ish) value to a compatible AWS API value.
-func (expander autoExpander) int64(ctx context.Context, vFrom basetypes.Int64Valuable, vTo reflect.Value, fieldOpts fieldOpts) diag.Diagnostics {
+func (expander autoExpander) int64(ctx context.Context, sourcePath path.Path, vFrom basetypes.Int64Valuable, vTo reflect.Value, fieldOpts fieldOpts) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	v, d := vFrom.ToInt64Value(ctx)
@@ -441,6 +472,7 @@ func (expander autoExpander) int64(ctx context.Context, vFrom basetypes.Int64Val
 		//
 		// types.Int32/types.Int64 -> int32/int64.
 		//
+		diags.Append(expander.checkInt(sourcePath, reflect.TypeOf(vFrom), v.ValueInt64(), tTo)...)
 		vTo.SetInt(v.ValueInt64())
 		return diags
 
@@ -450,6 +482,7 @@ func (expander autoExpander) int64(ctx context.Context, vFrom basetypes.Int64Val
 			//
 			// types.Int32/types.Int64 -> *int32.
 			//
+			diags.Append(expander.checkInt(sourcePath, reflect.TypeOf(vFrom), v.ValueInt64(), tElem)...)
 			to := int32(v.ValueInt64())
 			if fieldOpts.legacy {
 				tflog.SubsystemDebug(ctx, subsystemName, "Using legacy expander")
@@ -679,7 +712,7 @@ func (expander autoExpander) list(ctx context.Context, sourcePath path.Path, vFr
 
 	switch v.ElementType(ctx).(type) {
 	case basetypes.Int64Typable:
-		diags.Append(expander.listOrSetOfInt64(ctx, v, vTo, fieldOpts)...)
+		diags.Append(expander.listOrSetOfInt64(ctx, sourcePath, v, vTo, fieldOpts)...)
 		return diags
 
 	case basetypes.StringTypable:
@@ -702,7 +735,7 @@ func (expander autoExpander) list(ctx context.Context, sourcePath path.Path, vFr
 }
 
 // listOrSetOfInt64 copies a Plugin Framework ListOfInt64(ish) or SetOfInt64(ish) value to a compatible AWS API value.
-func (expander autoExpander) listOrSetOfInt64(ctx context.Context, vFrom valueWithElementsAs, vTo reflect.Value, fieldOpts fieldOpts) diag.Diagnostics {
+func (expander autoExpander) listOrSetOfInt64(ctx context.Context, sourcePath path.Path, vFrom valueWithElementsAs, vTo reflect.Value, fieldOpts fieldOpts) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	switch vTo.Kind() {
@@ -730,6 +763,7 @@ func (expander autoExpander) listOrSetOfInt64(ctx context.Context, vFrom valueWi
 
 			vals := reflect.MakeSlice(vTo.Type(), len(to), len(to))
 			for i := range to {
+				diags.Append(expander.checkInt(sourcePath, reflect.TypeOf(vFrom), to[i], tSliceElem)...)
 				vals.Index(i).SetInt(to[i])
 			}
 			vTo.Set(vals)
@@ -1088,7 +1122,7 @@ func (expander autoExpander) set(ctx context.Context, sourcePath path.Path, vFro
 
 	switch v.ElementType(ctx).(type) {
 	case basetypes.Int64Typable:
-		diags.Append(expander.listOrSetOfInt64(ctx, v, vTo, fieldOpts)...)
+		diags.Append(expander.listOrSetOfInt64(ctx, sourcePath, v, vTo, fieldOpts)...)
 		return diags
 
 	case basetypes.Int32Typable:
@@ -1409,6 +1443,7 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 		return diags
 	}
 
+	var unmappedDiags diag.Diagnostics
 	for fromField := range expandSourceFields(ctx, typeFrom, flexer.getOptions()) {
 		fromFieldName := fromField.Name
 		_, fromFieldOpts := autoflexTags(fromField)
@@ -1441,6 +1476,9 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 			tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
 				logAttrKeySourceFieldname: fromFieldName,
 			})
+			if opts := flexer.getOptions(); opts.isStrictMode() {
+				unmappedDiags.Append(diagUnmappedField(opts, sourcePath.AtName(fromFieldName), typeFrom, typeTo))
+			}
 			continue
 		}
 		toFieldName := toField.Name
@@ -1472,6 +1510,9 @@ func expandStruct(ctx context.Context, sourcePath path.Path, from any, targetPat
 		}
 	}
 
+	// Unmapped fields are reported after all matched fields have been converted.
+	diags.Append(unmappedDiags...)
+
 	return diags
 }
 
@@ -78,6 +78,17 @@ func (flattener autoFlattener) getOptions() AutoFlexOptions {
 	return flattener.Options
 }
 
+// checkTime reports, in strict mode, a timestamp whose sub-second precision is lost when formatted as RFC 3339.
+func (flattener autoFlattener) checkTime(sourcePath path.Path, sourceType reflect.Type, from time.Time, targetType reflect.Type) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if opts := flattener.getOptions(); opts.isStrictMode() && from.Nanosecond() != 0 {
+		diags.Append(diagLossyConversion(opts, sourcePath, sourceType, targetType, fmt.Sprintf("value %s loses sub-second precision", from.Format(time.RFC3339Nano))))
+	}
+
+	return diags
+}
+
 // autoFlattenConvert converts `from` to `to` using the specified auto-flexer.
 func autoFlattenConvert(ctx context.Context, from, to any, flexer autoFlexer) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -559,7 +570,7 @@ func (flattener autoFlattener) string(ctx context.Context, vFrom reflect.Value,
 	return diags
 }
 
-func (flattener autoFlattener) time(ctx context.Context, vFrom reflect.Value, isNullFrom bool, vTo reflect.Value) diag.Diagnostics {
+func (flattener autoFlattener) time(ctx context.Context, sourcePath path.Path, vFrom reflect.Value, isNullFrom bool, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	if isNullFrom {
@@ -574,6 +585,7 @@ func (flattener autoFlattener) time(ctx context.Context, vFrom reflect.Value, is
 
 	// time.Time --> timetypes.RFC3339
 	if from, ok := vFrom.Interface().(time.Time); ok {
+		diags.Append(flattener.checkTime(sourcePath, vFrom.Type(), from, vTo.Type())...)
 		vTo.Set(reflect.ValueOf(timetypes.NewRFC3339TimeValue(from)))
 		return diags
 	}
@@ -585,6 +597,7 @@ func (flattener autoFlattener) time(ctx context.Context, vFrom reflect.Value, is
 
 	// *time.Time --> timetypes.RFC3339
 	if from, ok := vFrom.Elem().Interface().(time.Time); ok {
+		diags.Append(flattener.checkTime(sourcePath, vFrom.Type(), from, vTo.Type())...)
 		vTo.Set(reflect.ValueOf(timetypes.NewRFC3339TimeValue(from)))
 		return diags
 	}
@@ -709,7 +722,7 @@ func (flattener autoFlattener) struct_(ctx context.Context, sourcePath path.Path
 
 	switch iTo.(type) {
 	case timetypes.RFC3339:
-		diags.Append(flattener.time(ctx, vFrom, isNilFrom, vTo)...)
+		diags.Append(flattener.time(ctx, sourcePath, vFrom, isNilFrom, vTo)...)
 		return diags
 	}
 
@@ -2232,6 +2245,7 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 		return diags
 	}
 
+	var unmappedDiags diag.Diagnostics
 	for fromField := range flattenSourceFields(ctx, typeFrom, flexer.getOptions()) {
 		fromFieldName := fromField.Name
 
@@ -2249,6 +2263,9 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 			tflog.SubsystemDebug(ctx, subsystemName, "No corresponding field", map[string]any{
 				logAttrKeySourceFieldname: fromFieldName,
 			})
+			if opts := flexer.getOptions(); opts.isStrictMode() {
+				unmappedDiags.Append(diagUnmappedField(opts, sourcePath.AtName(fromFieldName), typeFrom, typeTo))
+			}
 			continue
 		}
 		toFieldName := toField.Name
@@ -2602,6 +2619,9 @@ func flattenStruct(ctx context.Context, sourcePath path.Path, from any, targetPa
 		}
 	}
 
+	// Unmapped fields are reported after all matched fields have been converted.
+	diags.Append(unmappedDiags...)
+
 	return diags
 }
 
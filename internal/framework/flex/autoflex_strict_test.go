@@ -0,0 +1,224 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package flex
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+type tfStrictModeFields struct {
+	Field1 types.String `tfsdk:"field1"`
+	Field2 types.String `tfsdk:"field2"`
+}
+
+type awsStrictModeFields struct {
+	Field1 *string
+	Field3 *string
+}
+
+type tfStrictModeListOfInt64 struct {
+	Field1 fwtypes.ListValueOf[types.Int64] `tfsdk:"field1"`
+}
+
+type awsStrictModeSliceOfInt32 struct {
+	Field1 []int32
+}
+
+func TestExpandStrictMode(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+
+	testCases := autoFlexTestCases{
+		"unmapped field ignored by default": {
+			Source: &tfStrictModeFields{
+				Field1: types.StringValue("a"),
+				Field2: types.StringValue("b"),
+			},
+			Target:     &awsStrictModeFields{},
+			WantTarget: &awsStrictModeFields{Field1: aws.String("a")},
+		},
+		"unmapped field": {
+			Options: []AutoFlexOptionsFunc{WithStrictMode()},
+			Source: &tfStrictModeFields{
+				Field1: types.StringValue("a"),
+				Field2: types.StringValue("b"),
+			},
+			Target: &awsStrictModeFields{},
+			ExpectedDiags: diag.Diagnostics{
+				diagUnmappedField(AutoFlexOptions{strictModeSeverity: diag.SeverityError}, path.Root("Field2"), reflect.TypeFor[tfStrictModeFields](), reflect.TypeFor[awsStrictModeFields]()),
+			},
+		},
+		"unmapped field warning": {
+			Options: []AutoFlexOptionsFunc{WithStrictModeWarnings()},
+			Source: &tfStrictModeFields{
+				Field1: types.StringValue("a"),
+				Field2: types.StringValue("b"),
+			},
+			Target: &awsStrictModeFields{},
+			ExpectedDiags: diag.Diagnostics{
+				diagUnmappedField(AutoFlexOptions{strictModeSeverity: diag.SeverityWarning}, path.Root("Field2"), reflect.TypeFor[tfStrictModeFields](), reflect.TypeFor[awsStrictModeFields]()),
+			},
+			WantTarget: &awsStrictModeFields{Field1: aws.String("a")},
+		},
+		"unmapped field ignored": {
+			Options: []AutoFlexOptionsFunc{WithStrictMode(), WithIgnoredFieldNamesAppend("Field2")},
+			Source: &tfStrictModeFields{
+				Field1: types.StringValue("a"),
+				Field2: types.StringValue("b"),
+			},
+			Target:     &awsStrictModeFields{},
+			WantTarget: &awsStrictModeFields{Field1: aws.String("a")},
+		},
+		"Int64 to int32 in range": {
+			Options:    []AutoFlexOptionsFunc{WithStrictMode()},
+			Source:     &tfSingleInt64Field{Field1: types.Int64Value(math.MaxInt32)},
+			Target:     &awsSingleInt32Value{},
+			WantTarget: &awsSingleInt32Value{Field1: math.MaxInt32},
+		},
+		"Int64 to int32 overflow": {
+			Options: []AutoFlexOptionsFunc{WithStrictMode()},
+			Source:  &tfSingleInt64Field{Field1: types.Int64Value(math.MaxInt32 + 1)},
+			Target:  &awsSingleInt32Value{},
+			ExpectedDiags: diag.Diagnostics{
+				diagLossyConversion(AutoFlexOptions{strictModeSeverity: diag.SeverityError}, path.Root("Field1"), reflect.TypeFor[types.Int64](), reflect.TypeFor[int32](), "value 2147483648 overflows int32"),
+			},
+		},
+		"Int64 to *int32 overflow": {
+			Options: []AutoFlexOptionsFunc{WithStrictModeWarnings()},
+			Source:  &tfSingleInt64Field{Field1: types.Int64Value(math.MinInt32 - 1)},
+			Target:  &awsSingleInt32Pointer{},
+			ExpectedDiags: diag.Diagnostics{
+				diagLossyConversion(AutoFlexOptions{strictModeSeverity: diag.SeverityWarning}, path.Root("Field1"), reflect.TypeFor[types.Int64](), reflect.TypeFor[int32](), "value -2147483649 overflows int32"),
+			},
+			WantTarget: &awsSingleInt32Pointer{Field1: aws.Int32(math.MaxInt32)},
+		},
+		"Int64 to int32 overflow without strict mode": {
+			Source:     &tfSingleInt64Field{Field1: types.Int64Value(math.MaxInt32 + 1)},
+			Target:     &awsSingleInt32Value{},
+			WantTarget: &awsSingleInt32Value{Field1: math.MinInt32},
+		},
+		"List of Int64 to []int32 overflow": {
+			Options: []AutoFlexOptionsFunc{WithStrictMode()},
+			Source: &tfStrictModeListOfInt64{
+				Field1: fwtypes.NewListValueOfMust[types.Int64](ctx, []attr.Value{
+					types.Int64Value(1),
+					types.Int64Value(math.MaxInt64),
+				}),
+			},
+			Target: &awsStrictModeSliceOfInt32{},
+			ExpectedDiags: diag.Diagnostics{
+				diagLossyConversion(AutoFlexOptions{strictModeSeverity: diag.SeverityError}, path.Root("Field1"), reflect.TypeFor[types.List](), reflect.TypeFor[int32](), "value 9223372036854775807 overflows int32"),
+			},
+		},
+		"Float64 to float32 exact": {
+			Options:    []AutoFlexOptionsFunc{WithStrictMode()},
+			Source:     &tfSingleFloat64Field{Field1: types.Float64Value(0.5)},
+			Target:     &awsSingleFloat32Value{},
+			WantTarget: &awsSingleFloat32Value{Field1: 0.5},
+		},
+		"Float64 to float32 precision": {
+			Options: []AutoFlexOptionsFunc{WithStrictMode()},
+			Source:  &tfSingleFloat64Field{Field1: types.Float64Value(0.1)},
+			Target:  &awsSingleFloat32Value{},
+			ExpectedDiags: diag.Diagnostics{
+				diagLossyConversion(AutoFlexOptions{strictModeSeverity: diag.SeverityError}, path.Root("Field1"), reflect.TypeFor[types.Float64](), reflect.TypeFor[float32](), "value 0.1 loses precision as float32"),
+			},
+		},
+		"Float64 to *float32 overflow": {
+			Options: []AutoFlexOptionsFunc{WithStrictMode()},
+			Source:  &tfSingleFloat64Field{Field1: types.Float64Value(math.MaxFloat64)},
+			Target:  &awsSingleFloat32Pointer{},
+			ExpectedDiags: diag.Diagnostics{
+				diagLossyConversion(AutoFlexOptions{strictModeSeverity: diag.SeverityError}, path.Root("Field1"), reflect.TypeFor[types.Float64](), reflect.TypeFor[float32](), "value 1.7976931348623157e+308 overflows float32"),
+			},
+		},
+		"Float64 to float64": {
+			Options:    []AutoFlexOptionsFunc{WithStrictMode()},
+			Source:     &tfSingleFloat64Field{Field1: types.Float64Value(0.1)},
+			Target:     &awsSingleFloat64Value{},
+			WantTarget: &awsSingleFloat64Value{Field1: 0.1},
+		},
+	}
+
+	runAutoExpandTestCases(t, testCases, runChecks{CompareDiags: true, CompareTarget: true})
+}
+
+func TestFlattenStrictMode(t *testing.T) {
+	t.Parallel()
+
+	whole := time.Date(2026, time.March, 1, 12, 30, 0, 0, time.UTC)
+	fractional := time.Date(2026, time.March, 1, 12, 30, 0, 500, time.UTC)
+
+	testCases := autoFlexTestCases{
+		"unmapped field": {
+			Options: []AutoFlexOptionsFunc{WithStrictMode()},
+			Source: &awsStrictModeFields{
+				Field1: aws.String("a"),
+				Field3: aws.String("c"),
+			},
+			Target: &tfStrictModeFields{},
+			ExpectedDiags: diag.Diagnostics{
+				diagUnmappedField(AutoFlexOptions{strictModeSeverity: diag.SeverityError}, path.Root("Field3"), reflect.TypeFor[awsStrictModeFields](), reflect.TypeFor[tfStrictModeFields]()),
+			},
+		},
+		"unmapped field ignored by default": {
+			Source: &awsStrictModeFields{
+				Field1: aws.String("a"),
+				Field3: aws.String("c"),
+			},
+			Target: &tfStrictModeFields{},
+			WantTarget: &tfStrictModeFields{
+				Field1: types.StringValue("a"),
+				Field2: types.StringNull(),
+			},
+		},
+		"time whole seconds": {
+			Options: []AutoFlexOptionsFunc{WithStrictMode()},
+			Source: &awsRFC3339TimePointer{
+				CreationDateTime: &whole,
+			},
+			Target: &tfRFC3339Time{},
+			WantTarget: &tfRFC3339Time{
+				CreationDateTime: timetypes.NewRFC3339TimeValue(whole),
+			},
+		},
+		"time sub-second precision": {
+			Options: []AutoFlexOptionsFunc{WithStrictModeWarnings()},
+			Source: &awsRFC3339TimePointer{
+				CreationDateTime: &fractional,
+			},
+			Target: &tfRFC3339Time{},
+			ExpectedDiags: diag.Diagnostics{
+				diagLossyConversion(AutoFlexOptions{strictModeSeverity: diag.SeverityWarning}, path.Root("CreationDateTime"), reflect.TypeFor[time.Time](), reflect.TypeFor[timetypes.RFC3339](), "value 2026-03-01T12:30:00.0000005Z loses sub-second precision"),
+			},
+			WantTarget: &tfRFC3339Time{
+				CreationDateTime: timetypes.NewRFC3339TimeValue(whole),
+			},
+		},
+		"time value sub-second precision": {
+			Options: []AutoFlexOptionsFunc{WithStrictMode()},
+			Source: &awsRFC3339TimeValue{
+				CreationDateTime: fractional,
+			},
+			Target: &tfRFC3339Time{},
+			ExpectedDiags: diag.Diagnostics{
+				diagLossyConversion(AutoFlexOptions{strictModeSeverity: diag.SeverityError}, path.Root("CreationDateTime"), reflect.TypeFor[time.Time](), reflect.TypeFor[timetypes.RFC3339](), "value 2026-03-01T12:30:00.0000005Z loses sub-second precision"),
+			},
+		},
+	}
+
+	runAutoFlattenTestCases(t, testCases, runChecks{CompareDiags: true, CompareTarget: true})
+}
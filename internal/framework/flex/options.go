@@ -3,7 +3,11 @@
 
 package flex
 
-import "slices"
+import (
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
 
 var (
 	DefaultIgnoredFieldNames = []string{
@@ -27,6 +31,12 @@ type AutoFlexOptions struct {
 	// ignoredFieldNames stores names which expanders and flatteners will
 	// not read from or write to
 	ignoredFieldNames []string
+
+	// strictModeSeverity is the severity of the diagnostics reported for
+	// source fields with no corresponding target field and for lossy
+	// value conversions. Strict mode is disabled if this is
+	// diag.SeverityInvalid
+	strictModeSeverity diag.Severity
 }
 
 // WithFieldNamePrefix specifies a prefix to be accounted for when
@@ -77,7 +87,34 @@ func WithNoIgnoredFieldNames() AutoFlexOptionsFunc {
 	}
 }
 
+// WithStrictMode enables strict mode, reporting an error for each source
+// field with no corresponding target field and for each lossy value
+// conversion (numeric overflow, floating point precision loss or
+// timestamp truncation)
+//
+// Use this option to catch field naming mismatches during development.
+// Source fields which are intentionally not mapped should be excluded
+// via WithIgnoredFieldNamesAppend or an `autoflex:"-"` tag.
+func WithStrictMode() AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		o.strictModeSeverity = diag.SeverityError
+	}
+}
+
+// WithStrictModeWarnings enables strict mode, reporting a warning instead
+// of an error for each unmapped source field and lossy value conversion
+func WithStrictModeWarnings() AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		o.strictModeSeverity = diag.SeverityWarning
+	}
+}
+
 // isIgnoredField returns true if s is in the list of ignored field names
 func (o *AutoFlexOptions) isIgnoredField(s string) bool {
 	return slices.Contains(o.ignoredFieldNames, s)
 }
+
+// isStrictMode returns true if unmapped fields and lossy conversions are reported
+func (o *AutoFlexOptions) isStrictMode() bool {
+	return o.strictModeSeverity != diag.SeverityInvalid
+}
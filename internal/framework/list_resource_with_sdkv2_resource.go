@@ -58,6 +58,32 @@ func (l *ListResourceWithSDKv2Resource) SetRegionSpec(regionSpec unique.Handle[i
 			}
 		}
 	}
+
+	// The per-resource IAM role override is available whether or not the resource supports the per-resource Region override.
+	if _, ok := l.resourceSchema.SchemaMap()[names.TopLevelAssumeRoleAttribute]; !ok {
+		// TODO: Use standard shared `assume_role` attribute
+		l.resourceSchema.SchemaMap()[names.TopLevelAssumeRoleAttribute] = &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrExternalID: {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					names.AttrRoleARN: {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					names.TopLevelAssumeRoleSessionNameAttribute: {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			},
+		}
+	}
 }
 
 func (l *ListResourceWithSDKv2Resource) SetIdentitySpec(identitySpec inttypes.Identity) {
@@ -0,0 +1,232 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package planmodifiers
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Modifier is a plan modifier that can be used with attributes of any primitive, collection or object type.
+type Modifier interface {
+	planmodifier.Bool
+	planmodifier.Float64
+	planmodifier.Int32
+	planmodifier.Int64
+	planmodifier.List
+	planmodifier.Map
+	planmodifier.Object
+	planmodifier.Set
+	planmodifier.String
+}
+
+// NestedObjectModifier is a plan modifier that can be used with nested attributes and blocks.
+type NestedObjectModifier interface {
+	planmodifier.List
+	planmodifier.Object
+	planmodifier.Set
+}
+
+// request holds the type-independent fields of a plan modification request.
+type request struct {
+	configValue    attr.Value
+	pathExpression path.Expression
+	plan           tfsdk.Plan
+	planValue      attr.Value
+	state          tfsdk.State
+	stateValue     attr.Value
+}
+
+// objectElements returns the elements of a list or set of objects.
+func objectElements(ctx context.Context, elements []attr.Value) ([]basetypes.ObjectValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	objects := make([]basetypes.ObjectValue, 0, len(elements))
+	for _, element := range elements {
+		v, ok := element.(basetypes.ObjectValuable)
+		if !ok {
+			diags.AddError("Invalid element type", "Elements must be objects")
+			return nil, diags
+		}
+
+		object, d := v.ToObjectValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		objects = append(objects, object)
+	}
+
+	return objects, diags
+}
+
+// objectsEquivalent returns whether a planned object is equivalent to a prior state object.
+// Only the specified attributes are compared, or all attributes if none are specified.
+func objectsEquivalent(ctx context.Context, plan, state basetypes.ObjectValue, attributeNames []string) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if plan.IsUnknown() {
+		return true, diags
+	}
+
+	if plan.IsNull() || state.IsNull() || state.IsUnknown() {
+		return plan.IsNull() == state.IsNull(), diags
+	}
+
+	planAttributes, stateAttributes := plan.Attributes(), state.Attributes()
+
+	if len(attributeNames) == 0 {
+		for name := range planAttributes {
+			attributeNames = append(attributeNames, name)
+		}
+	}
+
+	for _, name := range attributeNames {
+		planValue, stateValue := planAttributes[name], stateAttributes[name]
+		if planValue == nil || stateValue == nil {
+			if planValue != stateValue {
+				return false, diags
+			}
+			continue
+		}
+
+		equivalent, d := valuesEquivalent(ctx, planValue, stateValue)
+		diags.Append(d...)
+		if diags.HasError() {
+			return false, diags
+		}
+
+		if !equivalent {
+			return false, diags
+		}
+	}
+
+	return true, diags
+}
+
+// valuesEquivalent returns whether a planned value is equivalent to a prior state value.
+// Unknown planned values are determined during apply and are considered equivalent to any value.
+func valuesEquivalent(ctx context.Context, plan, state attr.Value) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if plan.IsUnknown() || plan.Equal(state) {
+		return true, diags
+	}
+
+	if plan, ok := plan.(basetypes.StringValuableWithSemanticEquals); ok {
+		if state, ok := state.(basetypes.StringValuable); ok && !plan.IsNull() && !state.IsNull() {
+			equal, d := plan.StringSemanticEquals(ctx, state)
+			diags.Append(d...)
+			return equal, diags
+		}
+	}
+
+	return false, diags
+}
+
+func requestFromBool(r planmodifier.BoolRequest) request {
+	return request{
+		configValue:    r.ConfigValue,
+		pathExpression: r.PathExpression,
+		plan:           r.Plan,
+		planValue:      r.PlanValue,
+		state:          r.State,
+		stateValue:     r.StateValue,
+	}
+}
+
+func requestFromFloat64(r planmodifier.Float64Request) request {
+	return request{
+		configValue:    r.ConfigValue,
+		pathExpression: r.PathExpression,
+		plan:           r.Plan,
+		planValue:      r.PlanValue,
+		state:          r.State,
+		stateValue:     r.StateValue,
+	}
+}
+
+func requestFromInt32(r planmodifier.Int32Request) request {
+	return request{
+		configValue:    r.ConfigValue,
+		pathExpression: r.PathExpression,
+		plan:           r.Plan,
+		planValue:      r.PlanValue,
+		state:          r.State,
+		stateValue:     r.StateValue,
+	}
+}
+
+func requestFromInt64(r planmodifier.Int64Request) request {
+	return request{
+		configValue:    r.ConfigValue,
+		pathExpression: r.PathExpression,
+		plan:           r.Plan,
+		planValue:      r.PlanValue,
+		state:          r.State,
+		stateValue:     r.StateValue,
+	}
+}
+
+func requestFromList(r planmodifier.ListRequest) request {
+	return request{
+		configValue:    r.ConfigValue,
+		pathExpression: r.PathExpression,
+		plan:           r.Plan,
+		planValue:      r.PlanValue,
+		state:          r.State,
+		stateValue:     r.StateValue,
+	}
+}
+
+func requestFromMap(r planmodifier.MapRequest) request {
+	return request{
+		configValue:    r.ConfigValue,
+		pathExpression: r.PathExpression,
+		plan:           r.Plan,
+		planValue:      r.PlanValue,
+		state:          r.State,
+		stateValue:     r.StateValue,
+	}
+}
+
+func requestFromObject(r planmodifier.ObjectRequest) request {
+	return request{
+		configValue:    r.ConfigValue,
+		pathExpression: r.PathExpression,
+		plan:           r.Plan,
+		planValue:      r.PlanValue,
+		state:          r.State,
+		stateValue:     r.StateValue,
+	}
+}
+
+func requestFromSet(r planmodifier.SetRequest) request {
+	return request{
+		configValue:    r.ConfigValue,
+		pathExpression: r.PathExpression,
+		plan:           r.Plan,
+		planValue:      r.PlanValue,
+		state:          r.State,
+		stateValue:     r.StateValue,
+	}
+}
+
+func requestFromString(r planmodifier.StringRequest) request {
+	return request{
+		configValue:    r.ConfigValue,
+		pathExpression: r.PathExpression,
+		plan:           r.Plan,
+		planValue:      r.PlanValue,
+		state:          r.State,
+		stateValue:     r.StateValue,
+	}
+}
@@ -0,0 +1,257 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package planmodifiers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwplanmodifiers "github.com/hashicorp/terraform-provider-aws/internal/framework/planmodifiers"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var ruleAttrTypes = map[string]attr.Type{
+	names.AttrARN:   types.StringType,
+	names.AttrKey:   types.StringType,
+	names.AttrValue: types.StringType,
+}
+
+var ruleSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"rule": schema.SetNestedAttribute{
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					names.AttrARN: schema.StringAttribute{
+						Computed: true,
+					},
+					names.AttrKey: schema.StringAttribute{
+						Required: true,
+					},
+					names.AttrValue: schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+		},
+	},
+}
+
+func rule(arn types.String, key, value string) attr.Value {
+	return types.ObjectValueMust(ruleAttrTypes, map[string]attr.Value{
+		names.AttrARN:   arn,
+		names.AttrKey:   types.StringValue(key),
+		names.AttrValue: types.StringValue(value),
+	})
+}
+
+func ruleSet(elements ...attr.Value) types.Set {
+	return types.SetValueMust(types.ObjectType{AttrTypes: ruleAttrTypes}, elements)
+}
+
+func ruleList(elements ...attr.Value) types.List {
+	return types.ListValueMust(types.ObjectType{AttrTypes: ruleAttrTypes}, elements)
+}
+
+func TestRequiresReplaceIfAnyChildChangesSet(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		plan           types.Set
+		state          types.Set
+		attributeNames []string
+		expected       bool
+	}
+
+	tests := map[string]testCase{
+		"unchanged": {
+			plan:     ruleSet(rule(types.StringValue("arn1"), "a", "1"), rule(types.StringValue("arn2"), "b", "2")),
+			state:    ruleSet(rule(types.StringValue("arn2"), "b", "2"), rule(types.StringValue("arn1"), "a", "1")),
+			expected: false,
+		},
+		"unknown computed child": {
+			plan:     ruleSet(rule(types.StringUnknown(), "a", "1"), rule(types.StringUnknown(), "b", "2")),
+			state:    ruleSet(rule(types.StringValue("arn1"), "a", "1"), rule(types.StringValue("arn2"), "b", "2")),
+			expected: false,
+		},
+		"child changed": {
+			plan:     ruleSet(rule(types.StringUnknown(), "a", "1"), rule(types.StringUnknown(), "b", "3")),
+			state:    ruleSet(rule(types.StringValue("arn1"), "a", "1"), rule(types.StringValue("arn2"), "b", "2")),
+			expected: true,
+		},
+		"unselected child changed": {
+			plan:           ruleSet(rule(types.StringUnknown(), "a", "1"), rule(types.StringUnknown(), "b", "3")),
+			state:          ruleSet(rule(types.StringValue("arn1"), "a", "1"), rule(types.StringValue("arn2"), "b", "2")),
+			attributeNames: []string{names.AttrKey},
+			expected:       false,
+		},
+		"element added": {
+			plan:     ruleSet(rule(types.StringUnknown(), "a", "1"), rule(types.StringUnknown(), "b", "2")),
+			state:    ruleSet(rule(types.StringValue("arn1"), "a", "1")),
+			expected: true,
+		},
+		"unknown plan": {
+			plan:     types.SetUnknown(types.ObjectType{AttrTypes: ruleAttrTypes}),
+			state:    ruleSet(rule(types.StringValue("arn1"), "a", "1")),
+			expected: false,
+		},
+		"block removed": {
+			plan:     types.SetNull(types.ObjectType{AttrTypes: ruleAttrTypes}),
+			state:    ruleSet(rule(types.StringValue("arn1"), "a", "1")),
+			expected: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			request := planmodifier.SetRequest{
+				Path:           path.Root("rule"),
+				PathExpression: path.MatchRoot("rule"),
+				Plan:           tfsdk.Plan{Schema: ruleSchema, Raw: rawValue(ctx, ruleSchema, map[string]attr.Value{"rule": test.plan})},
+				PlanValue:      test.plan,
+				State:          tfsdk.State{Schema: ruleSchema, Raw: rawValue(ctx, ruleSchema, map[string]attr.Value{"rule": test.state})},
+				StateValue:     test.state,
+			}
+			response := planmodifier.SetResponse{
+				PlanValue: request.PlanValue,
+			}
+			fwplanmodifiers.RequiresReplaceIfAnyChildChanges(test.attributeNames...).PlanModifySet(ctx, request, &response)
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %s", response.Diagnostics)
+			}
+
+			if got, want := response.RequiresReplace, test.expected; got != want {
+				t.Errorf("RequiresReplace = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestRequiresReplaceIfAnyChildChangesList(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		plan     types.List
+		state    types.List
+		expected bool
+	}
+
+	tests := map[string]testCase{
+		"unknown computed child": {
+			plan:     ruleList(rule(types.StringUnknown(), "a", "1"), rule(types.StringUnknown(), "b", "2")),
+			state:    ruleList(rule(types.StringValue("arn1"), "a", "1"), rule(types.StringValue("arn2"), "b", "2")),
+			expected: false,
+		},
+		"reordered": {
+			plan:     ruleList(rule(types.StringUnknown(), "b", "2"), rule(types.StringUnknown(), "a", "1")),
+			state:    ruleList(rule(types.StringValue("arn1"), "a", "1"), rule(types.StringValue("arn2"), "b", "2")),
+			expected: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			request := planmodifier.ListRequest{
+				Path:           path.Root("rule"),
+				PathExpression: path.MatchRoot("rule"),
+				Plan:           tfsdk.Plan{Schema: ruleSchema, Raw: rawValue(ctx, ruleSchema, map[string]attr.Value{"rule": types.SetNull(types.ObjectType{AttrTypes: ruleAttrTypes})})},
+				PlanValue:      test.plan,
+				State:          tfsdk.State{Schema: ruleSchema, Raw: rawValue(ctx, ruleSchema, map[string]attr.Value{"rule": types.SetNull(types.ObjectType{AttrTypes: ruleAttrTypes})})},
+				StateValue:     test.state,
+			}
+			response := planmodifier.ListResponse{
+				PlanValue: request.PlanValue,
+			}
+			fwplanmodifiers.RequiresReplaceIfAnyChildChanges().PlanModifyList(ctx, request, &response)
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %s", response.Diagnostics)
+			}
+
+			if got, want := response.RequiresReplace, test.expected; got != want {
+				t.Errorf("RequiresReplace = %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestSetSemanticEquality(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		plan     types.Set
+		state    types.Set
+		expected types.Set
+	}
+
+	tests := map[string]testCase{
+		"null state": {
+			plan:     ruleSet(rule(types.StringUnknown(), "a", "1")),
+			state:    types.SetNull(types.ObjectType{AttrTypes: ruleAttrTypes}),
+			expected: ruleSet(rule(types.StringUnknown(), "a", "1")),
+		},
+		"equal by key": {
+			plan:     ruleSet(rule(types.StringUnknown(), "a", "1"), rule(types.StringUnknown(), "b", "2")),
+			state:    ruleSet(rule(types.StringValue("arn2"), "b", "2"), rule(types.StringValue("arn1"), "a", "1")),
+			expected: ruleSet(rule(types.StringValue("arn2"), "b", "2"), rule(types.StringValue("arn1"), "a", "1")),
+		},
+		"value changed": {
+			plan:     ruleSet(rule(types.StringUnknown(), "a", "1"), rule(types.StringUnknown(), "b", "3")),
+			state:    ruleSet(rule(types.StringValue("arn1"), "a", "1"), rule(types.StringValue("arn2"), "b", "2")),
+			expected: ruleSet(rule(types.StringUnknown(), "a", "1"), rule(types.StringUnknown(), "b", "3")),
+		},
+		"key changed": {
+			plan:     ruleSet(rule(types.StringUnknown(), "a", "1"), rule(types.StringUnknown(), "c", "2")),
+			state:    ruleSet(rule(types.StringValue("arn1"), "a", "1"), rule(types.StringValue("arn2"), "b", "2")),
+			expected: ruleSet(rule(types.StringUnknown(), "a", "1"), rule(types.StringUnknown(), "c", "2")),
+		},
+		"element removed": {
+			plan:     ruleSet(rule(types.StringUnknown(), "a", "1")),
+			state:    ruleSet(rule(types.StringValue("arn1"), "a", "1"), rule(types.StringValue("arn2"), "b", "2")),
+			expected: ruleSet(rule(types.StringUnknown(), "a", "1")),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			request := planmodifier.SetRequest{
+				Path:           path.Root("rule"),
+				PathExpression: path.MatchRoot("rule"),
+				PlanValue:      test.plan,
+				StateValue:     test.state,
+			}
+			response := planmodifier.SetResponse{
+				PlanValue: request.PlanValue,
+			}
+			fwplanmodifiers.SetSemanticEquality(names.AttrKey).PlanModifySet(ctx, request, &response)
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %s", response.Diagnostics)
+			}
+
+			if diff := cmp.Diff(response.PlanValue, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package planmodifiers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// RequiresReplaceIfAnyChildChanges returns a plan modifier for a nested attribute or block that
+// requires resource replacement if any of the specified child attributes (or any child attribute
+// if none are specified) of any nested object changes.
+//
+// Unlike `RequiresReplace`, child attributes which are unknown in the plan (e.g. Computed values
+// determined during apply) are not considered changes, nor are semantically equal string values.
+// List elements are compared by index and set elements are matched regardless of order.
+// Nothing is done if the planned value itself is unknown.
+func RequiresReplaceIfAnyChildChanges(attributeNames ...string) NestedObjectModifier {
+	return requiresReplaceIfAnyChildChangesModifier{
+		attributeNames: attributeNames,
+	}
+}
+
+type requiresReplaceIfAnyChildChangesModifier struct {
+	attributeNames []string
+}
+
+func (m requiresReplaceIfAnyChildChangesModifier) Description(ctx context.Context) string {
+	return m.MarkdownDescription(ctx)
+}
+
+func (m requiresReplaceIfAnyChildChangesModifier) MarkdownDescription(context.Context) string {
+	if len(m.attributeNames) == 0 {
+		return "If the value of any nested attribute changes, Terraform will destroy and recreate the resource."
+	}
+	return fmt.Sprintf("If the value of any of the nested attributes %q changes, Terraform will destroy and recreate the resource.", m.attributeNames)
+}
+
+// skip returns whether replacement is never required for the request.
+func (m requiresReplaceIfAnyChildChangesModifier) skip(request request) bool {
+	// Do not replace on resource creation or destruction.
+	if request.state.Raw.IsNull() || request.plan.Raw.IsNull() {
+		return true
+	}
+
+	// Do nothing if the planned value is determined during apply.
+	return request.planValue.IsUnknown()
+}
+
+func (m requiresReplaceIfAnyChildChangesModifier) elementsChanged(ctx context.Context, plan, state []basetypes.ObjectValue, ordered bool) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if len(plan) != len(state) {
+		return true, diags
+	}
+
+	if ordered {
+		for i := range plan {
+			equivalent, d := objectsEquivalent(ctx, plan[i], state[i], m.attributeNames)
+			diags.Append(d...)
+			if diags.HasError() || !equivalent {
+				return true, diags
+			}
+		}
+
+		return false, diags
+	}
+
+	matched := make([]bool, len(state))
+	for _, planObject := range plan {
+		found := false
+		for i, stateObject := range state {
+			if matched[i] {
+				continue
+			}
+
+			equivalent, d := objectsEquivalent(ctx, planObject, stateObject, m.attributeNames)
+			diags.Append(d...)
+			if diags.HasError() {
+				return true, diags
+			}
+
+			if equivalent {
+				matched[i], found = true, true
+				break
+			}
+		}
+
+		if !found {
+			return true, diags
+		}
+	}
+
+	return false, diags
+}
+
+func (m requiresReplaceIfAnyChildChangesModifier) PlanModifyList(ctx context.Context, request planmodifier.ListRequest, response *planmodifier.ListResponse) {
+	if m.skip(requestFromList(request)) {
+		return
+	}
+
+	plan, diags := objectElements(ctx, request.PlanValue.Elements())
+	response.Diagnostics.Append(diags...)
+	state, diags := objectElements(ctx, request.StateValue.Elements())
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	changed, diags := m.elementsChanged(ctx, plan, state, true)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.RequiresReplace = changed
+}
+
+func (m requiresReplaceIfAnyChildChangesModifier) PlanModifyObject(ctx context.Context, request planmodifier.ObjectRequest, response *planmodifier.ObjectResponse) {
+	if m.skip(requestFromObject(request)) {
+		return
+	}
+
+	equivalent, diags := objectsEquivalent(ctx, request.PlanValue, request.StateValue, m.attributeNames)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.RequiresReplace = !equivalent
+}
+
+func (m requiresReplaceIfAnyChildChangesModifier) PlanModifySet(ctx context.Context, request planmodifier.SetRequest, response *planmodifier.SetResponse) {
+	if m.skip(requestFromSet(request)) {
+		return
+	}
+
+	plan, diags := objectElements(ctx, request.PlanValue.Elements())
+	response.Diagnostics.Append(diags...)
+	state, diags := objectElements(ctx, request.StateValue.Elements())
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	changed, diags := m.elementsChanged(ctx, plan, state, false)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.RequiresReplace = changed
+}
@@ -0,0 +1,114 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package planmodifiers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// SetSemanticEquality returns a plan modifier for a set of nested objects that copies the prior state
+// value into the planned value if the two sets are semantically equal.
+// Elements are matched by the value of the specified key attribute; matched elements are equal if each
+// of their known planned attribute values is equal (or semantically equal) to the prior state value.
+//
+// Use this to suppress spurious differences caused by Computed nested attributes,
+// which are unknown in the plan and so prevent set elements from matching.
+func SetSemanticEquality(keyAttributeName string) planmodifier.Set {
+	return setSemanticEqualityModifier{
+		keyAttributeName: keyAttributeName,
+	}
+}
+
+type setSemanticEqualityModifier struct {
+	keyAttributeName string
+}
+
+func (m setSemanticEqualityModifier) Description(ctx context.Context) string {
+	return m.MarkdownDescription(ctx)
+}
+
+func (m setSemanticEqualityModifier) MarkdownDescription(context.Context) string {
+	return fmt.Sprintf("Set elements are identified by %q and the value in state is used if all elements are unchanged.", m.keyAttributeName)
+}
+
+func (m setSemanticEqualityModifier) PlanModifySet(ctx context.Context, request planmodifier.SetRequest, response *planmodifier.SetResponse) {
+	if request.StateValue.IsNull() || request.StateValue.IsUnknown() || request.PlanValue.IsNull() || request.PlanValue.IsUnknown() {
+		return
+	}
+
+	// Do nothing if the sets are already equal.
+	if request.PlanValue.Equal(request.StateValue) {
+		return
+	}
+
+	plan, diags := objectElements(ctx, request.PlanValue.Elements())
+	response.Diagnostics.Append(diags...)
+	state, diags := objectElements(ctx, request.StateValue.Elements())
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if len(plan) != len(state) {
+		return
+	}
+
+	stateByKey := make(map[string]basetypes.ObjectValue, len(state))
+	for _, object := range state {
+		key, ok := m.key(object)
+		if !ok {
+			return
+		}
+
+		if _, ok := stateByKey[key]; ok {
+			return
+		}
+		stateByKey[key] = object
+	}
+
+	for _, planObject := range plan {
+		key, ok := m.key(planObject)
+		if !ok {
+			return
+		}
+
+		stateObject, ok := stateByKey[key]
+		if !ok {
+			return
+		}
+		// Each state element can match only one plan element.
+		delete(stateByKey, key)
+
+		equivalent, diags := objectsEquivalent(ctx, planObject, stateObject, nil)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if !equivalent {
+			return
+		}
+	}
+
+	response.PlanValue = request.StateValue
+}
+
+// key returns the string representation of an object's key attribute value.
+// Unknown and null keys cannot be matched.
+func (m setSemanticEqualityModifier) key(object basetypes.ObjectValue) (string, bool) {
+	if object.IsNull() || object.IsUnknown() {
+		return "", false
+	}
+
+	v, ok := object.Attributes()[m.keyAttributeName]
+	if !ok || v.IsNull() || v.IsUnknown() {
+		return "", false
+	}
+
+	return v.String(), true
+}
@@ -0,0 +1,153 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package planmodifiers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// UseStateForUnknownIfUnchanged returns a plan modifier that copies a known prior state value into the
+// planned value if the values at all of the specified paths are unchanged between prior state and plan.
+// Relative path expressions are resolved from the attribute the plan modifier is applied to.
+//
+// Use this for Computed attributes whose value changes only when another attribute changes,
+// e.g. a nested `id` that is regenerated only when its parent object's `name` changes.
+func UseStateForUnknownIfUnchanged(expressions ...path.Expression) Modifier {
+	return useStateForUnknownIfUnchangedModifier{
+		expressions: expressions,
+	}
+}
+
+type useStateForUnknownIfUnchangedModifier struct {
+	expressions path.Expressions
+}
+
+func (m useStateForUnknownIfUnchangedModifier) Description(ctx context.Context) string {
+	return m.MarkdownDescription(ctx)
+}
+
+func (m useStateForUnknownIfUnchangedModifier) MarkdownDescription(context.Context) string {
+	return fmt.Sprintf("Once set, the value of this attribute in state will not change as long as the values of %q do not change.", m.expressions)
+}
+
+func (m useStateForUnknownIfUnchangedModifier) useState(ctx context.Context, request request) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Do nothing if there is no state (resource is being created).
+	if request.stateValue.IsNull() {
+		return false, diags
+	}
+
+	// Do nothing if there is a known planned value.
+	if !request.planValue.IsUnknown() {
+		return false, diags
+	}
+
+	// Do nothing if there is an unknown configuration value, otherwise interpolation gets messed up.
+	if request.configValue.IsUnknown() {
+		return false, diags
+	}
+
+	for _, expression := range request.pathExpression.MergeExpressions(m.expressions...) {
+		matchedPaths, d := request.plan.PathMatches(ctx, expression)
+		diags.Append(d...)
+		if diags.HasError() {
+			return false, diags
+		}
+
+		for _, matchedPath := range matchedPaths {
+			var planValue, stateValue attr.Value
+
+			diags.Append(request.plan.GetAttribute(ctx, matchedPath, &planValue)...)
+			diags.Append(request.state.GetAttribute(ctx, matchedPath, &stateValue)...)
+			if diags.HasError() {
+				return false, diags
+			}
+
+			if !planValue.Equal(stateValue) {
+				return false, diags
+			}
+		}
+	}
+
+	return true, diags
+}
+
+func (m useStateForUnknownIfUnchangedModifier) PlanModifyBool(ctx context.Context, request planmodifier.BoolRequest, response *planmodifier.BoolResponse) {
+	useState, diags := m.useState(ctx, requestFromBool(request))
+	response.Diagnostics.Append(diags...)
+	if useState {
+		response.PlanValue = request.StateValue
+	}
+}
+
+func (m useStateForUnknownIfUnchangedModifier) PlanModifyFloat64(ctx context.Context, request planmodifier.Float64Request, response *planmodifier.Float64Response) {
+	useState, diags := m.useState(ctx, requestFromFloat64(request))
+	response.Diagnostics.Append(diags...)
+	if useState {
+		response.PlanValue = request.StateValue
+	}
+}
+
+func (m useStateForUnknownIfUnchangedModifier) PlanModifyInt32(ctx context.Context, request planmodifier.Int32Request, response *planmodifier.Int32Response) {
+	useState, diags := m.useState(ctx, requestFromInt32(request))
+	response.Diagnostics.Append(diags...)
+	if useState {
+		response.PlanValue = request.StateValue
+	}
+}
+
+func (m useStateForUnknownIfUnchangedModifier) PlanModifyInt64(ctx context.Context, request planmodifier.Int64Request, response *planmodifier.Int64Response) {
+	useState, diags := m.useState(ctx, requestFromInt64(request))
+	response.Diagnostics.Append(diags...)
+	if useState {
+		response.PlanValue = request.StateValue
+	}
+}
+
+func (m useStateForUnknownIfUnchangedModifier) PlanModifyList(ctx context.Context, request planmodifier.ListRequest, response *planmodifier.ListResponse) {
+	useState, diags := m.useState(ctx, requestFromList(request))
+	response.Diagnostics.Append(diags...)
+	if useState {
+		response.PlanValue = request.StateValue
+	}
+}
+
+func (m useStateForUnknownIfUnchangedModifier) PlanModifyMap(ctx context.Context, request planmodifier.MapRequest, response *planmodifier.MapResponse) {
+	useState, diags := m.useState(ctx, requestFromMap(request))
+	response.Diagnostics.Append(diags...)
+	if useState {
+		response.PlanValue = request.StateValue
+	}
+}
+
+func (m useStateForUnknownIfUnchangedModifier) PlanModifyObject(ctx context.Context, request planmodifier.ObjectRequest, response *planmodifier.ObjectResponse) {
+	useState, diags := m.useState(ctx, requestFromObject(request))
+	response.Diagnostics.Append(diags...)
+	if useState {
+		response.PlanValue = request.StateValue
+	}
+}
+
+func (m useStateForUnknownIfUnchangedModifier) PlanModifySet(ctx context.Context, request planmodifier.SetRequest, response *planmodifier.SetResponse) {
+	useState, diags := m.useState(ctx, requestFromSet(request))
+	response.Diagnostics.Append(diags...)
+	if useState {
+		response.PlanValue = request.StateValue
+	}
+}
+
+func (m useStateForUnknownIfUnchangedModifier) PlanModifyString(ctx context.Context, request planmodifier.StringRequest, response *planmodifier.StringResponse) {
+	useState, diags := m.useState(ctx, requestFromString(request))
+	response.Diagnostics.Append(diags...)
+	if useState {
+		response.PlanValue = request.StateValue
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package planmodifiers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	fwplanmodifiers "github.com/hashicorp/terraform-provider-aws/internal/framework/planmodifiers"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestUseStateForUnknownIfUnchanged(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		plan        map[string]attr.Value
+		state       map[string]attr.Value
+		expressions path.Expressions
+		expected    types.String
+	}
+
+	testSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+
+	tests := map[string]testCase{
+		"create": {
+			plan: map[string]attr.Value{
+				names.AttrID:          types.StringUnknown(),
+				names.AttrName:        types.StringValue("test"),
+				names.AttrDescription: types.StringNull(),
+			},
+			expressions: path.Expressions{path.MatchRoot(names.AttrName)},
+			expected:    types.StringUnknown(),
+		},
+		"unchanged": {
+			plan: map[string]attr.Value{
+				names.AttrID:          types.StringUnknown(),
+				names.AttrName:        types.StringValue("test"),
+				names.AttrDescription: types.StringValue("new"),
+			},
+			state: map[string]attr.Value{
+				names.AttrID:          types.StringValue("id-1"),
+				names.AttrName:        types.StringValue("test"),
+				names.AttrDescription: types.StringValue("old"),
+			},
+			expressions: path.Expressions{path.MatchRoot(names.AttrName)},
+			expected:    types.StringValue("id-1"),
+		},
+		"unchanged relative": {
+			plan: map[string]attr.Value{
+				names.AttrID:          types.StringUnknown(),
+				names.AttrName:        types.StringValue("test"),
+				names.AttrDescription: types.StringValue("new"),
+			},
+			state: map[string]attr.Value{
+				names.AttrID:          types.StringValue("id-1"),
+				names.AttrName:        types.StringValue("test"),
+				names.AttrDescription: types.StringValue("old"),
+			},
+			expressions: path.Expressions{path.MatchRelative().AtParent().AtName(names.AttrName)},
+			expected:    types.StringValue("id-1"),
+		},
+		"changed": {
+			plan: map[string]attr.Value{
+				names.AttrID:          types.StringUnknown(),
+				names.AttrName:        types.StringValue("test2"),
+				names.AttrDescription: types.StringValue("old"),
+			},
+			state: map[string]attr.Value{
+				names.AttrID:          types.StringValue("id-1"),
+				names.AttrName:        types.StringValue("test"),
+				names.AttrDescription: types.StringValue("old"),
+			},
+			expressions: path.Expressions{path.MatchRoot(names.AttrName)},
+			expected:    types.StringUnknown(),
+		},
+		"one of several changed": {
+			plan: map[string]attr.Value{
+				names.AttrID:          types.StringUnknown(),
+				names.AttrName:        types.StringValue("test"),
+				names.AttrDescription: types.StringValue("new"),
+			},
+			state: map[string]attr.Value{
+				names.AttrID:          types.StringValue("id-1"),
+				names.AttrName:        types.StringValue("test"),
+				names.AttrDescription: types.StringValue("old"),
+			},
+			expressions: path.Expressions{path.MatchRoot(names.AttrName), path.MatchRoot(names.AttrDescription)},
+			expected:    types.StringUnknown(),
+		},
+		"known plan": {
+			plan: map[string]attr.Value{
+				names.AttrID:          types.StringValue("id-2"),
+				names.AttrName:        types.StringValue("test"),
+				names.AttrDescription: types.StringNull(),
+			},
+			state: map[string]attr.Value{
+				names.AttrID:          types.StringValue("id-1"),
+				names.AttrName:        types.StringValue("test"),
+				names.AttrDescription: types.StringNull(),
+			},
+			expressions: path.Expressions{path.MatchRoot(names.AttrName)},
+			expected:    types.StringValue("id-2"),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			plan := tfsdk.Plan{Schema: testSchema, Raw: rawValue(ctx, testSchema, test.plan)}
+			state := tfsdk.State{Schema: testSchema, Raw: rawValue(ctx, testSchema, test.state)}
+			stateValue := types.StringNull()
+			if test.state != nil {
+				stateValue = test.state[names.AttrID].(types.String)
+			}
+
+			request := planmodifier.StringRequest{
+				Path:           path.Root(names.AttrID),
+				PathExpression: path.MatchRoot(names.AttrID),
+				ConfigValue:    types.StringNull(),
+				Plan:           plan,
+				PlanValue:      test.plan[names.AttrID].(types.String),
+				State:          state,
+				StateValue:     stateValue,
+			}
+			response := planmodifier.StringResponse{
+				PlanValue: request.PlanValue,
+			}
+			fwplanmodifiers.UseStateForUnknownIfUnchanged(test.expressions...).PlanModifyString(ctx, request, &response)
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %s", response.Diagnostics)
+			}
+
+			if diff := cmp.Diff(response.PlanValue, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+// rawValue returns the Terraform value of an object with the specified attribute values,
+// or a null value if values is nil.
+func rawValue(ctx context.Context, s schema.Schema, values map[string]attr.Value) tftypes.Value {
+	typ := s.Type().TerraformType(ctx)
+
+	if values == nil {
+		return tftypes.NewValue(typ, nil)
+	}
+
+	raw := make(map[string]tftypes.Value, len(values))
+	for name, value := range values {
+		v, err := value.ToTerraformValue(ctx)
+		if err != nil {
+			panic("ToTerraformValue error: " + err.Error())
+		}
+		raw[name] = v
+	}
+
+	return tftypes.NewValue(typ, raw)
+}
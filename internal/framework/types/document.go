@@ -0,0 +1,464 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	tfyaml "github.com/hashicorp/terraform-provider-aws/internal/yaml"
+)
+
+// DocumentNormalizationOption configures how JSON and YAML document values are normalized
+// before they are compared for semantic equality.
+type DocumentNormalizationOption func(*documentNormalizationOptions)
+
+type documentNormalizationOptions struct {
+	exactNumbers      bool
+	orderedKeys       bool
+	removeEmptyFields bool
+}
+
+// WithExactNumbers compares numbers by their textual representation.
+// By default numbers are compared by value, e.g. `1`, `1.0` and `1e0` are equal.
+func WithExactNumbers() DocumentNormalizationOption {
+	return func(o *documentNormalizationOptions) {
+		o.exactNumbers = true
+	}
+}
+
+// WithOrderedKeys makes the order of object (mapping) keys significant.
+// By default key order is ignored.
+func WithOrderedKeys() DocumentNormalizationOption {
+	return func(o *documentNormalizationOptions) {
+		o.orderedKeys = true
+	}
+}
+
+// WithRemoveEmptyFields ignores fields with `null`, empty array or empty object values.
+// See json.RemoveEmptyFields.
+func WithRemoveEmptyFields() DocumentNormalizationOption {
+	return func(o *documentNormalizationOptions) {
+		o.removeEmptyFields = true
+	}
+}
+
+func newDocumentNormalizationOptions(options ...DocumentNormalizationOption) documentNormalizationOptions {
+	var opts documentNormalizationOptions
+
+	for _, opt := range options {
+		opt(&opts)
+	}
+
+	return opts
+}
+
+// merge returns the combination of two sets of options.
+// Each option is enabled if it's enabled in either set.
+func (o documentNormalizationOptions) merge(other documentNormalizationOptions) documentNormalizationOptions {
+	return documentNormalizationOptions{
+		exactNumbers:      o.exactNumbers || other.exactNumbers,
+		orderedKeys:       o.orderedKeys || other.orderedKeys,
+		removeEmptyFields: o.removeEmptyFields || other.removeEmptyFields,
+	}
+}
+
+// documentFormat is the serialization format of a document value.
+type documentFormat interface {
+	comparable
+
+	// name returns the format's human readable name.
+	name() string
+	// toJSON returns the document as JSON, or an error if the document is not valid.
+	toJSON([]byte) ([]byte, error)
+}
+
+type jsonFormat struct{}
+
+func (jsonFormat) name() string {
+	return "JSON"
+}
+
+func (jsonFormat) toJSON(b []byte) ([]byte, error) {
+	if !json.Valid(b) {
+		return nil, errors.New("invalid JSON")
+	}
+
+	return b, nil
+}
+
+type yamlFormat struct{}
+
+func (yamlFormat) name() string {
+	return "YAML"
+}
+
+func (yamlFormat) toJSON(b []byte) ([]byte, error) {
+	return tfyaml.ToJSON(b)
+}
+
+type jsonOrYAMLFormat struct{}
+
+func (jsonOrYAMLFormat) name() string {
+	return "JSON or YAML"
+}
+
+func (jsonOrYAMLFormat) toJSON(b []byte) ([]byte, error) {
+	// Same heuristic as verify.NormalizeJSONOrYAMLString.
+	if trimmed := bytes.TrimLeft(b, " \t\r\n"); len(trimmed) > 0 && trimmed[0] == '{' {
+		return jsonFormat{}.toJSON(b)
+	}
+
+	return yamlFormat{}.toJSON(b)
+}
+
+var (
+	_ basetypes.StringTypable = (*DocumentType[jsonFormat])(nil)
+)
+
+// DocumentType is the attribute type of a JSON, YAML or JSON-or-YAML document.
+// Values are semantically equal if their normalized forms are equal.
+type DocumentType[T documentFormat] struct {
+	basetypes.StringType
+	options documentNormalizationOptions
+}
+
+type (
+	JSONDocumentType       = DocumentType[jsonFormat]
+	YAMLDocumentType       = DocumentType[yamlFormat]
+	JSONOrYAMLDocumentType = DocumentType[jsonOrYAMLFormat]
+)
+
+// NewJSONDocumentType returns the attribute type of a JSON document with the specified normalization.
+func NewJSONDocumentType(options ...DocumentNormalizationOption) JSONDocumentType {
+	return JSONDocumentType{options: newDocumentNormalizationOptions(options...)}
+}
+
+// NewYAMLDocumentType returns the attribute type of a YAML document with the specified normalization.
+func NewYAMLDocumentType(options ...DocumentNormalizationOption) YAMLDocumentType {
+	return YAMLDocumentType{options: newDocumentNormalizationOptions(options...)}
+}
+
+// NewJSONOrYAMLDocumentType returns the attribute type of a document in either JSON or YAML format with the specified normalization.
+// Documents in different formats can be semantically equal.
+func NewJSONOrYAMLDocumentType(options ...DocumentNormalizationOption) JSONOrYAMLDocumentType {
+	return JSONOrYAMLDocumentType{options: newDocumentNormalizationOptions(options...)}
+}
+
+// Equal returns true if the given type is equivalent.
+// Normalization options are not considered.
+func (t DocumentType[T]) Equal(o attr.Type) bool {
+	other, ok := o.(DocumentType[T])
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t DocumentType[T]) String() string {
+	var zero T
+	return fmt.Sprintf("DocumentType[%s]", zero.name())
+}
+
+func (t DocumentType[T]) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	return Document[T]{StringValue: in, options: t.options}, diags
+}
+
+func (t DocumentType[T]) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t DocumentType[T]) ValueType(context.Context) attr.Value {
+	return Document[T]{options: t.options}
+}
+
+var (
+	_ basetypes.StringValuable                   = (*Document[jsonFormat])(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*Document[jsonFormat])(nil)
+	_ xattr.ValidateableAttribute                = (*Document[jsonFormat])(nil)
+)
+
+// Document is a JSON, YAML or JSON-or-YAML document value.
+type Document[T documentFormat] struct {
+	basetypes.StringValue
+	options documentNormalizationOptions
+}
+
+type (
+	JSONDocument       = Document[jsonFormat]
+	YAMLDocument       = Document[yamlFormat]
+	JSONOrYAMLDocument = Document[jsonOrYAMLFormat]
+)
+
+func JSONDocumentNull() JSONDocument {
+	return JSONDocument{StringValue: basetypes.NewStringNull()}
+}
+
+func JSONDocumentUnknown() JSONDocument {
+	return JSONDocument{StringValue: basetypes.NewStringUnknown()}
+}
+
+func JSONDocumentValue(value string) JSONDocument {
+	return JSONDocument{StringValue: basetypes.NewStringValue(value)}
+}
+
+func YAMLDocumentNull() YAMLDocument {
+	return YAMLDocument{StringValue: basetypes.NewStringNull()}
+}
+
+func YAMLDocumentUnknown() YAMLDocument {
+	return YAMLDocument{StringValue: basetypes.NewStringUnknown()}
+}
+
+func YAMLDocumentValue(value string) YAMLDocument {
+	return YAMLDocument{StringValue: basetypes.NewStringValue(value)}
+}
+
+func JSONOrYAMLDocumentNull() JSONOrYAMLDocument {
+	return JSONOrYAMLDocument{StringValue: basetypes.NewStringNull()}
+}
+
+func JSONOrYAMLDocumentUnknown() JSONOrYAMLDocument {
+	return JSONOrYAMLDocument{StringValue: basetypes.NewStringUnknown()}
+}
+
+func JSONOrYAMLDocumentValue(value string) JSONOrYAMLDocument {
+	return JSONOrYAMLDocument{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v Document[T]) Equal(o attr.Value) bool {
+	other, ok := o.(Document[T])
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v Document[T]) Type(context.Context) attr.Type {
+	return DocumentType[T]{options: v.options}
+}
+
+// StringSemanticEquals returns true if the two documents are equal after normalization.
+// The normalization options of both values are combined.
+func (v Document[T]) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(Document[T])
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	options := v.options.merge(newValue.options)
+
+	oldNormalized, err := normalizeDocument[T](v.ValueString(), options)
+	if err != nil {
+		// Invalid documents are reported by ValidateAttribute.
+		return false, diags
+	}
+
+	newNormalized, err := normalizeDocument[T](newValue.ValueString(), options)
+	if err != nil {
+		return false, diags
+	}
+
+	return oldNormalized == newNormalized, diags
+}
+
+func (v Document[T]) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	var zero T
+	if _, err := zero.toJSON([]byte(v.ValueString())); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			fmt.Sprintf("Invalid %s Document Value", zero.name()),
+			fmt.Sprintf("The provided value is not a valid %s document: %s\n\n", zero.name(), err)+
+				"Path: "+req.Path.String()+"\n"+
+				"Value: "+v.ValueString(),
+		)
+	}
+}
+
+// normalizeDocument returns the canonical JSON representation of a document.
+func normalizeDocument[T documentFormat](s string, options documentNormalizationOptions) (string, error) {
+	var zero T
+
+	b, err := zero.toJSON([]byte(strings.ReplaceAll(s, "\r\n", "\n")))
+	if err != nil {
+		return "", err
+	}
+
+	if options.removeEmptyFields {
+		b = tfjson.RemoveEmptyFields(b)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+
+	normalized, err := normalizeJSONValue(decoder, options)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := decoder.Token(); !errors.Is(err, io.EOF) {
+		return "", errors.New("unexpected data after top-level value")
+	}
+
+	return normalized, nil
+}
+
+// normalizeJSONValue returns the canonical JSON representation of the next value read from decoder.
+func normalizeJSONValue(decoder *json.Decoder, options documentNormalizationOptions) (string, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return "", err
+	}
+
+	switch token := token.(type) {
+	case json.Delim:
+		switch token {
+		case '{':
+			type member struct {
+				key, value string
+			}
+			var members []member
+
+			for decoder.More() {
+				token, err := decoder.Token()
+				if err != nil {
+					return "", err
+				}
+
+				key, err := json.Marshal(token)
+				if err != nil {
+					return "", err
+				}
+
+				value, err := normalizeJSONValue(decoder, options)
+				if err != nil {
+					return "", err
+				}
+
+				members = append(members, member{key: string(key), value: value})
+			}
+
+			if _, err := decoder.Token(); err != nil {
+				return "", err
+			}
+
+			if !options.orderedKeys {
+				slices.SortStableFunc(members, func(a, b member) int {
+					return strings.Compare(a.key, b.key)
+				})
+			}
+
+			var sb strings.Builder
+			sb.WriteByte('{')
+			for i, m := range members {
+				if i > 0 {
+					sb.WriteByte(',')
+				}
+				sb.WriteString(m.key)
+				sb.WriteByte(':')
+				sb.WriteString(m.value)
+			}
+			sb.WriteByte('}')
+
+			return sb.String(), nil
+
+		case '[':
+			var elements []string
+
+			for decoder.More() {
+				value, err := normalizeJSONValue(decoder, options)
+				if err != nil {
+					return "", err
+				}
+
+				elements = append(elements, value)
+			}
+
+			if _, err := decoder.Token(); err != nil {
+				return "", err
+			}
+
+			return "[" + strings.Join(elements, ",") + "]", nil
+		}
+
+	case json.Number:
+		if options.exactNumbers {
+			return token.String(), nil
+		}
+
+		r, ok := new(big.Rat).SetString(token.String())
+		if !ok {
+			return "", fmt.Errorf("invalid number: %s", token)
+		}
+
+		return r.RatString(), nil
+
+	case string:
+		b, err := json.Marshal(token)
+		if err != nil {
+			return "", err
+		}
+
+		return string(b), nil
+
+	case bool:
+		return strconv.FormatBool(token), nil
+
+	case nil:
+		return "null", nil
+	}
+
+	return "", fmt.Errorf("unexpected token: %v", token)
+}
@@ -0,0 +1,192 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func TestDocumentValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         xattr.ValidateableAttribute
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"JSON unknown": {
+			val: fwtypes.JSONDocumentUnknown(),
+		},
+		"JSON null": {
+			val: fwtypes.JSONDocumentNull(),
+		},
+		"JSON valid": {
+			val: fwtypes.JSONDocumentValue(`{"Key1": "Value", "Key2": [1, 2, 3]}`),
+		},
+		"JSON invalid": {
+			val:         fwtypes.JSONDocumentValue("Key1: Value"),
+			expectError: true,
+		},
+		"YAML unknown": {
+			val: fwtypes.YAMLDocumentUnknown(),
+		},
+		"YAML null": {
+			val: fwtypes.YAMLDocumentNull(),
+		},
+		"YAML valid": {
+			val: fwtypes.YAMLDocumentValue("Key1: Value\nKey2:\n  - 1\n  - 2\n"),
+		},
+		"YAML invalid": {
+			val:         fwtypes.YAMLDocumentValue("Key1: [Value"),
+			expectError: true,
+		},
+		"JSON or YAML JSON valid": {
+			val: fwtypes.JSONOrYAMLDocumentValue(`{"Key1": "Value"}`),
+		},
+		"JSON or YAML JSON invalid": {
+			val:         fwtypes.JSONOrYAMLDocumentValue(`{"Key1": "Value",}`),
+			expectError: true,
+		},
+		"JSON or YAML YAML valid": {
+			val: fwtypes.JSONOrYAMLDocumentValue("Key1: Value\n"),
+		},
+		"JSON or YAML YAML invalid": {
+			val:         fwtypes.JSONOrYAMLDocumentValue("Key1: [Value"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			req := xattr.ValidateAttributeRequest{}
+			resp := xattr.ValidateAttributeResponse{}
+
+			test.val.ValidateAttribute(ctx, req, &resp)
+			if resp.Diagnostics.HasError() != test.expectError {
+				t.Errorf("resp.Diagnostics.HasError() = %t, want = %t", resp.Diagnostics.HasError(), test.expectError)
+			}
+		})
+	}
+}
+
+func TestDocumentStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		typ        basetypes.StringTypable
+		val1, val2 string
+		equals     bool
+	}
+	tests := map[string]testCase{
+		"JSON whitespace": {
+			typ:    fwtypes.NewJSONDocumentType(),
+			val1:   `{"a":1,"b":[true,null]}`,
+			val2:   "{\r\n  \"a\": 1,\r\n  \"b\": [ true, null ]\r\n}",
+			equals: true,
+		},
+		"JSON key order": {
+			typ:    fwtypes.NewJSONDocumentType(),
+			val1:   `{"a":1,"b":2}`,
+			val2:   `{"b":2,"a":1}`,
+			equals: true,
+		},
+		"JSON key order ordered keys": {
+			typ:  fwtypes.NewJSONDocumentType(fwtypes.WithOrderedKeys()),
+			val1: `{"a":1,"b":2}`,
+			val2: `{"b":2,"a":1}`,
+		},
+		"JSON array order": {
+			typ:  fwtypes.NewJSONDocumentType(),
+			val1: `[1,2]`,
+			val2: `[2,1]`,
+		},
+		"JSON numbers": {
+			typ:    fwtypes.NewJSONDocumentType(),
+			val1:   `{"a":1,"b":0.5}`,
+			val2:   `{"a":1.0,"b":5e-1}`,
+			equals: true,
+		},
+		"JSON numbers exact numbers": {
+			typ:  fwtypes.NewJSONDocumentType(fwtypes.WithExactNumbers()),
+			val1: `{"a":1}`,
+			val2: `{"a":1.0}`,
+		},
+		"JSON large numbers": {
+			typ:  fwtypes.NewJSONDocumentType(),
+			val1: `{"a":12345678901234567890}`,
+			val2: `{"a":12345678901234567891}`,
+		},
+		"JSON string escapes": {
+			typ:    fwtypes.NewJSONDocumentType(),
+			val1:   `{"a":"\u0041"}`,
+			val2:   `{"a":"A"}`,
+			equals: true,
+		},
+		"JSON empty fields": {
+			typ:  fwtypes.NewJSONDocumentType(),
+			val1: `{"a":1,"b":null,"c":[],"d":{}}`,
+			val2: `{"a":1}`,
+		},
+		"JSON empty fields remove empty fields": {
+			typ:    fwtypes.NewJSONDocumentType(fwtypes.WithRemoveEmptyFields()),
+			val1:   `{"a":1,"b":null,"c":[],"d":{}}`,
+			val2:   `{"a":1}`,
+			equals: true,
+		},
+		"JSON invalid": {
+			typ:  fwtypes.NewJSONDocumentType(),
+			val1: `{"a":1}`,
+			val2: `{"a":1`,
+		},
+		"YAML style": {
+			typ:    fwtypes.NewYAMLDocumentType(),
+			val1:   "a: 1\nb:\n  - x\n  - true\n",
+			val2:   "{b: [\"x\", true], a: 1.0}",
+			equals: true,
+		},
+		"YAML different": {
+			typ:  fwtypes.NewYAMLDocumentType(),
+			val1: "a: 1\n",
+			val2: "a: \"1\"\n",
+		},
+		"JSON or YAML mixed formats": {
+			typ:    fwtypes.NewJSONOrYAMLDocumentType(),
+			val1:   `{"a": 1, "b": ["x", true]}`,
+			val2:   "b:\n  - x\n  - true\na: 1\n",
+			equals: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			val1, diags := test.typ.ValueFromString(ctx, basetypes.NewStringValue(test.val1))
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			val2, diags := test.typ.ValueFromString(ctx, basetypes.NewStringValue(test.val2))
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			equals, _ := val1.(basetypes.StringValuableWithSemanticEquals).StringSemanticEquals(ctx, val2)
+
+			if got, want := equals, test.equals; got != want {
+				t.Errorf("StringSemanticEquals(%q, %q) = %v, want %v", test.val1, test.val2, got, want)
+			}
+		})
+	}
+}
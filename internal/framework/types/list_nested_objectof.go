@@ -194,6 +194,15 @@ func (v ListNestedObjectValueOf[T]) Type(ctx context.Context) attr.Type {
 	return NewListNestedObjectTypeOf[T](ctx)
 }
 
+func (v ListNestedObjectValueOf[T]) ToTerraformValue(ctx context.Context) (tftypes.Value, error) {
+	// The zero value carries no element type; treat it as a typed null.
+	if v.ListValue.ElementType(ctx) == nil {
+		return tftypes.NewValue(v.Type(ctx).TerraformType(ctx), nil), nil
+	}
+
+	return v.ListValue.ToTerraformValue(ctx)
+}
+
 func (v ListNestedObjectValueOf[T]) ToObjectPtr(ctx context.Context) (any, diag.Diagnostics) {
 	return v.ToPtr(ctx)
 }
@@ -174,6 +174,50 @@ func TestListNestedObjectValueOfEqual(t *testing.T) {
 	}
 }
 
+func TestListNestedObjectValueOfToTerraformValue(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testCases := map[string]struct {
+		value fwtypes.ListNestedObjectValueOf[ObjectA]
+		want  tftypes.Value
+	}{
+		"zero value": {
+			value: fwtypes.ListNestedObjectValueOf[ObjectA]{},
+			want:  tftypes.NewValue(fwtypes.NewListNestedObjectTypeOf[ObjectA](ctx).TerraformType(ctx), nil),
+		},
+		"null value": {
+			value: fwtypes.NewListNestedObjectValueOfNull[ObjectA](ctx),
+			want:  tftypes.NewValue(fwtypes.NewListNestedObjectTypeOf[ObjectA](ctx).TerraformType(ctx), nil),
+		},
+		"valid value": {
+			value: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &ObjectA{Name: types.StringValue("test")}),
+			want: tftypes.NewValue(fwtypes.NewListNestedObjectTypeOf[ObjectA](ctx).TerraformType(ctx), []tftypes.Value{
+				tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+					"name": tftypes.String,
+				}}, map[string]tftypes.Value{
+					"name": tftypes.NewValue(tftypes.String, "test"),
+				}),
+			}),
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.value.ToTerraformValue(ctx)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !got.Equal(testCase.want) {
+				t.Errorf("got = %s, want = %s", got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestListNestedObjectValueOfListSemanticEquals(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,229 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// NestedElementsValidator validates the elements of a list or set of nested objects.
+type NestedElementsValidator interface {
+	validator.List
+	validator.Set
+}
+
+// ElementAttributesAllOrNone returns a validator which ensures that each of the specified
+// attributes of a list or set of nested objects is either set in every element or in none.
+//
+// Use this for attributes whose meaning depends on the other elements,
+// e.g. a `priority` or `weight` which is ignored unless every element has one.
+func ElementAttributesAllOrNone(attributeNames ...string) NestedElementsValidator {
+	return elementAttributesAllOrNoneValidator{
+		attributeNames: attributeNames,
+	}
+}
+
+type elementAttributesAllOrNoneValidator struct {
+	attributeNames []string
+}
+
+func (v elementAttributesAllOrNoneValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v elementAttributesAllOrNoneValidator) MarkdownDescription(context.Context) string {
+	return fmt.Sprintf("each of %q must be set in all elements or in none", v.attributeNames)
+}
+
+func (v elementAttributesAllOrNoneValidator) validate(ctx context.Context, path path.Path, elements []attr.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	objects, ok, d := knownObjectElements(ctx, elements)
+	diags.Append(d...)
+	if !ok || diags.HasError() {
+		return diags
+	}
+
+	for _, name := range v.attributeNames {
+		set, unknown := 0, false
+		for _, object := range objects {
+			value, ok := object.Attributes()[name]
+			if !ok {
+				diags.Append(validatordiag.InvalidValidatorUsageDiagnostic(path, "ElementAttributesAllOrNone", fmt.Sprintf("element has no attribute %q", name)))
+				return diags
+			}
+
+			if value.IsUnknown() {
+				unknown = true
+				break
+			}
+
+			if !value.IsNull() {
+				set++
+			}
+		}
+
+		// Delay validation until all values are known.
+		if unknown {
+			continue
+		}
+
+		if set > 0 && set < len(objects) {
+			diags.Append(validatordiag.InvalidAttributeCombinationDiagnostic(
+				path,
+				fmt.Sprintf("Attribute %q must be set in all elements or in none, got %d of %d elements", name, set, len(objects)),
+			))
+		}
+	}
+
+	return diags
+}
+
+func (v elementAttributesAllOrNoneValidator) ValidateList(ctx context.Context, request validator.ListRequest, response *validator.ListResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	response.Diagnostics.Append(v.validate(ctx, request.Path, request.ConfigValue.Elements())...)
+}
+
+func (v elementAttributesAllOrNoneValidator) ValidateSet(ctx context.Context, request validator.SetRequest, response *validator.SetResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	response.Diagnostics.Append(v.validate(ctx, request.Path, request.ConfigValue.Elements())...)
+}
+
+// UniqueElementAttributes returns a validator which ensures that the combination of the specified
+// attribute values is unique across the elements of a list or set of nested objects.
+// Elements in which any of the attributes are null are not checked.
+func UniqueElementAttributes(attributeNames ...string) NestedElementsValidator {
+	return uniqueElementAttributesValidator{
+		attributeNames: attributeNames,
+	}
+}
+
+type uniqueElementAttributesValidator struct {
+	attributeNames []string
+}
+
+func (v uniqueElementAttributesValidator) Description(ctx context.Context) string {
+	return v.MarkdownDescription(ctx)
+}
+
+func (v uniqueElementAttributesValidator) MarkdownDescription(context.Context) string {
+	return fmt.Sprintf("the combination of %q must be unique across all elements", v.attributeNames)
+}
+
+func (v uniqueElementAttributesValidator) validate(ctx context.Context, path path.Path, elements []attr.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	objects, ok, d := knownObjectElements(ctx, elements)
+	diags.Append(d...)
+	if !ok || diags.HasError() {
+		return diags
+	}
+
+	seen := make(map[string]struct{}, len(objects))
+	for _, object := range objects {
+		attributes := object.Attributes()
+		values := make([]string, 0, len(v.attributeNames))
+		skip := false
+
+		for _, name := range v.attributeNames {
+			value, ok := attributes[name]
+			if !ok {
+				diags.Append(validatordiag.InvalidValidatorUsageDiagnostic(path, "UniqueElementAttributes", fmt.Sprintf("element has no attribute %q", name)))
+				return diags
+			}
+
+			// Delay validation until all values are known.
+			if value.IsUnknown() {
+				return diags
+			}
+
+			if value.IsNull() {
+				skip = true
+				break
+			}
+
+			values = append(values, fmt.Sprintf("%s=%s", name, value))
+		}
+
+		if skip {
+			continue
+		}
+
+		key := strings.Join(values, ", ")
+		if _, ok := seen[key]; ok {
+			diags.Append(validatordiag.InvalidAttributeCombinationDiagnostic(
+				path,
+				fmt.Sprintf("Duplicate element with %s, values of %q must be unique", key, v.attributeNames),
+			))
+			continue
+		}
+		seen[key] = struct{}{}
+	}
+
+	return diags
+}
+
+func (v uniqueElementAttributesValidator) ValidateList(ctx context.Context, request validator.ListRequest, response *validator.ListResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	response.Diagnostics.Append(v.validate(ctx, request.Path, request.ConfigValue.Elements())...)
+}
+
+func (v uniqueElementAttributesValidator) ValidateSet(ctx context.Context, request validator.SetRequest, response *validator.SetResponse) {
+	if request.ConfigValue.IsNull() || request.ConfigValue.IsUnknown() {
+		return
+	}
+
+	response.Diagnostics.Append(v.validate(ctx, request.Path, request.ConfigValue.Elements())...)
+}
+
+// knownObjectElements returns the elements of a list or set of nested objects.
+// It returns false if any element is unknown, as validation is delayed until all elements are known.
+func knownObjectElements(ctx context.Context, elements []attr.Value) ([]basetypes.ObjectValue, bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	objects := make([]basetypes.ObjectValue, 0, len(elements))
+	for _, element := range elements {
+		if element.IsUnknown() {
+			return nil, false, diags
+		}
+
+		if element.IsNull() {
+			continue
+		}
+
+		v, ok := element.(basetypes.ObjectValuable)
+		if !ok {
+			diags.AddError("Invalid element type", fmt.Sprintf("Elements must be objects, got %T", element))
+			return nil, false, diags
+		}
+
+		object, d := v.ToObjectValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, false, diags
+		}
+
+		objects = append(objects, object)
+	}
+
+	return objects, true, diags
+}
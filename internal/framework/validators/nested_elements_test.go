@@ -0,0 +1,183 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validators_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+)
+
+var nestedElementAttrTypes = map[string]attr.Type{
+	"name":     types.StringType,
+	"port":     types.Int64Type,
+	"priority": types.Int64Type,
+}
+
+func nestedElement(name string, port, priority types.Int64) attr.Value {
+	return types.ObjectValueMust(nestedElementAttrTypes, map[string]attr.Value{
+		"name":     types.StringValue(name),
+		"port":     port,
+		"priority": priority,
+	})
+}
+
+func nestedElementList(elements ...attr.Value) types.List {
+	return types.ListValueMust(types.ObjectType{AttrTypes: nestedElementAttrTypes}, elements)
+}
+
+func nestedElementSet(elements ...attr.Value) types.Set {
+	return types.SetValueMust(types.ObjectType{AttrTypes: nestedElementAttrTypes}, elements)
+}
+
+func TestElementAttributesAllOrNone(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val                 types.List
+		expectedDiagnostics diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"unknown List": {
+			val: types.ListUnknown(types.ObjectType{AttrTypes: nestedElementAttrTypes}),
+		},
+		"null List": {
+			val: types.ListNull(types.ObjectType{AttrTypes: nestedElementAttrTypes}),
+		},
+		"none set": {
+			val: nestedElementList(
+				nestedElement("a", types.Int64Value(80), types.Int64Null()),
+				nestedElement("b", types.Int64Value(443), types.Int64Null()),
+			),
+		},
+		"all set": {
+			val: nestedElementList(
+				nestedElement("a", types.Int64Value(80), types.Int64Value(1)),
+				nestedElement("b", types.Int64Value(443), types.Int64Value(2)),
+			),
+		},
+		"some set": {
+			val: nestedElementList(
+				nestedElement("a", types.Int64Value(80), types.Int64Value(1)),
+				nestedElement("b", types.Int64Value(443), types.Int64Null()),
+				nestedElement("c", types.Int64Value(8080), types.Int64Null()),
+			),
+			expectedDiagnostics: diag.Diagnostics{
+				validatordiag.InvalidAttributeCombinationDiagnostic(
+					path.Root("test"),
+					`Attribute "priority" must be set in all elements or in none, got 1 of 3 elements`,
+				),
+			},
+		},
+		"some unknown": {
+			val: nestedElementList(
+				nestedElement("a", types.Int64Value(80), types.Int64Value(1)),
+				nestedElement("b", types.Int64Value(443), types.Int64Unknown()),
+				nestedElement("c", types.Int64Value(8080), types.Int64Null()),
+			),
+		},
+		"unknown element": {
+			val: nestedElementList(
+				nestedElement("a", types.Int64Value(80), types.Int64Value(1)),
+				types.ObjectUnknown(nestedElementAttrTypes),
+			),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			request := validator.ListRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.ListResponse{}
+			fwvalidators.ElementAttributesAllOrNone("priority").ValidateList(ctx, request, &response)
+
+			if diff := cmp.Diff(response.Diagnostics, test.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestUniqueElementAttributes(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val                 types.Set
+		expectedDiagnostics diag.Diagnostics
+	}
+	tests := map[string]testCase{
+		"unknown Set": {
+			val: types.SetUnknown(types.ObjectType{AttrTypes: nestedElementAttrTypes}),
+		},
+		"null Set": {
+			val: types.SetNull(types.ObjectType{AttrTypes: nestedElementAttrTypes}),
+		},
+		"unique": {
+			val: nestedElementSet(
+				nestedElement("a", types.Int64Value(80), types.Int64Value(1)),
+				nestedElement("a", types.Int64Value(443), types.Int64Value(1)),
+				nestedElement("b", types.Int64Value(80), types.Int64Value(1)),
+			),
+		},
+		"duplicate": {
+			val: nestedElementSet(
+				nestedElement("a", types.Int64Value(80), types.Int64Value(1)),
+				nestedElement("a", types.Int64Value(80), types.Int64Value(2)),
+			),
+			expectedDiagnostics: diag.Diagnostics{
+				validatordiag.InvalidAttributeCombinationDiagnostic(
+					path.Root("test"),
+					`Duplicate element with name="a", port=80, values of ["name" "port"] must be unique`,
+				),
+			},
+		},
+		"null not checked": {
+			val: nestedElementSet(
+				nestedElement("a", types.Int64Null(), types.Int64Value(1)),
+				nestedElement("a", types.Int64Null(), types.Int64Value(2)),
+			),
+		},
+		"unknown": {
+			val: nestedElementSet(
+				nestedElement("a", types.Int64Value(80), types.Int64Value(1)),
+				nestedElement("a", types.Int64Unknown(), types.Int64Value(2)),
+			),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			request := validator.SetRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.SetResponse{}
+			fwvalidators.UniqueElementAttributes("name", "port").ValidateSet(ctx, request, &response)
+
+			if diff := cmp.Diff(response.Diagnostics, test.expectedDiagnostics); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
@@ -5,8 +5,25 @@ package framework
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 )
 
+// WithRegionModel is embedded in the models of resources, data sources, ephemeral resources, actions and list resources
+// that support the per-resource Region and IAM role overrides injected by the provider.
 type WithRegionModel struct {
+	WithAssumeRoleModel
 	Region types.String `tfsdk:"region"`
 }
+
+// WithAssumeRoleModel is embedded in the models of resources, data sources, ephemeral resources, actions and list resources
+// that support only the per-resource IAM role override injected by the provider, i.e. those that do not support the per-resource Region override.
+type WithAssumeRoleModel struct {
+	AssumeRole fwtypes.ListNestedObjectValueOf[AssumeRoleModel] `tfsdk:"assume_role" autoflex:"-"`
+}
+
+// AssumeRoleModel is the model of the per-resource `assume_role` block injected by the provider.
+type AssumeRoleModel struct {
+	ExternalID  types.String `tfsdk:"external_id"`
+	RoleARN     types.String `tfsdk:"role_arn"`
+	SessionName types.String `tfsdk:"session_name"`
+}
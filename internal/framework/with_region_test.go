@@ -0,0 +1,81 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework_test
+
+import (
+	"context"
+	"testing"
+
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/datasourceattribute"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/resourceattribute"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type withRegionTestModel struct {
+	framework.WithRegionModel
+	Name types.String `tfsdk:"name"`
+}
+
+func TestWithRegionModelZeroValueResourceState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schema := rschema.Schema{
+		Attributes: map[string]rschema.Attribute{
+			names.AttrName:   rschema.StringAttribute{Optional: true},
+			names.AttrRegion: resourceattribute.Region(),
+		},
+		Blocks: map[string]rschema.Block{
+			names.TopLevelAssumeRoleAttribute: resourceattribute.AssumeRole(),
+		},
+	}
+	state := tfsdk.State{
+		Raw:    tftypes.NewValue(schema.Type().TerraformType(ctx), nil),
+		Schema: schema,
+	}
+
+	var data withRegionTestModel
+	if diags := state.Set(ctx, &data); diags.HasError() {
+		t.Fatalf("unexpected error setting zero-value model: %v", diags)
+	}
+
+	var got withRegionTestModel
+	if diags := state.Get(ctx, &got); diags.HasError() {
+		t.Fatalf("unexpected error getting model: %v", diags)
+	}
+
+	if !got.AssumeRole.IsNull() {
+		t.Errorf("expected null assume_role, got %s", got.AssumeRole)
+	}
+}
+
+func TestWithRegionModelZeroValueDataSourceState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schema := dsschema.Schema{
+		Attributes: map[string]dsschema.Attribute{
+			names.AttrName:   dsschema.StringAttribute{Optional: true},
+			names.AttrRegion: datasourceattribute.Region(),
+		},
+		Blocks: map[string]dsschema.Block{
+			names.TopLevelAssumeRoleAttribute: datasourceattribute.AssumeRole(),
+		},
+	}
+	state := tfsdk.State{
+		Raw:    tftypes.NewValue(schema.Type().TerraformType(ctx), nil),
+		Schema: schema,
+	}
+
+	var data withRegionTestModel
+	if diags := state.Set(ctx, &data); diags.HasError() {
+		t.Fatalf("unexpected error setting zero-value model: %v", diags)
+	}
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
 
 // WithTimeouts is intended to be embedded in resources which use the special "timeouts" nested block.
@@ -38,8 +39,10 @@ func (w *WithTimeouts) SetDefaultDeleteTimeout(timeout time.Duration) {
 }
 
 // CreateTimeout returns any configured Create timeout value or the default value.
+// Any provider-level default takes precedence over the resource's default.
 func (w *WithTimeouts) CreateTimeout(ctx context.Context, timeouts timeouts.Value) time.Duration {
-	timeout, diags := timeouts.Create(ctx, w.defaultCreateTimeout)
+	defaultTimeout := defaultTimeout(ctx, w.defaultCreateTimeout, func(v conns.OperationTimeouts) time.Duration { return v.Create })
+	timeout, diags := timeouts.Create(ctx, defaultTimeout)
 
 	if errors := diags.Errors(); len(errors) > 0 {
 		tflog.Warn(ctx, "reading configured Create timeout", map[string]any{
@@ -47,15 +50,17 @@ func (w *WithTimeouts) CreateTimeout(ctx context.Context, timeouts timeouts.Valu
 			"detail":  errors[0].Detail(),
 		})
 
-		return w.defaultCreateTimeout
+		return defaultTimeout
 	}
 
 	return timeout
 }
 
 // ReadTimeout returns any configured Read timeout value or the default value.
+// Any provider-level default takes precedence over the resource's default.
 func (w *WithTimeouts) ReadTimeout(ctx context.Context, timeouts timeouts.Value) time.Duration {
-	timeout, diags := timeouts.Read(ctx, w.defaultReadTimeout)
+	defaultTimeout := defaultTimeout(ctx, w.defaultReadTimeout, func(v conns.OperationTimeouts) time.Duration { return v.Read })
+	timeout, diags := timeouts.Read(ctx, defaultTimeout)
 
 	if errors := diags.Errors(); len(errors) > 0 {
 		tflog.Warn(ctx, "reading configured Read timeout", map[string]any{
@@ -63,15 +68,17 @@ func (w *WithTimeouts) ReadTimeout(ctx context.Context, timeouts timeouts.Value)
 			"detail":  errors[0].Detail(),
 		})
 
-		return w.defaultReadTimeout
+		return defaultTimeout
 	}
 
 	return timeout
 }
 
 // UpdateTimeout returns any configured Update timeout value or the default value.
+// Any provider-level default takes precedence over the resource's default.
 func (w *WithTimeouts) UpdateTimeout(ctx context.Context, timeouts timeouts.Value) time.Duration {
-	timeout, diags := timeouts.Update(ctx, w.defaultUpdateTimeout)
+	defaultTimeout := defaultTimeout(ctx, w.defaultUpdateTimeout, func(v conns.OperationTimeouts) time.Duration { return v.Update })
+	timeout, diags := timeouts.Update(ctx, defaultTimeout)
 
 	if errors := diags.Errors(); len(errors) > 0 {
 		tflog.Warn(ctx, "reading configured Update timeout", map[string]any{
@@ -79,15 +86,17 @@ func (w *WithTimeouts) UpdateTimeout(ctx context.Context, timeouts timeouts.Valu
 			"detail":  errors[0].Detail(),
 		})
 
-		return w.defaultUpdateTimeout
+		return defaultTimeout
 	}
 
 	return timeout
 }
 
 // DeleteTimeout returns any configured Delete timeout value or the default value.
+// Any provider-level default takes precedence over the resource's default.
 func (w *WithTimeouts) DeleteTimeout(ctx context.Context, timeouts timeouts.Value) time.Duration {
-	timeout, diags := timeouts.Delete(ctx, w.defaultDeleteTimeout)
+	defaultTimeout := defaultTimeout(ctx, w.defaultDeleteTimeout, func(v conns.OperationTimeouts) time.Duration { return v.Delete })
+	timeout, diags := timeouts.Delete(ctx, defaultTimeout)
 
 	if errors := diags.Errors(); len(errors) > 0 {
 		tflog.Warn(ctx, "reading configured Delete timeout", map[string]any{
@@ -95,7 +104,23 @@ func (w *WithTimeouts) DeleteTimeout(ctx context.Context, timeouts timeouts.Valu
 			"detail":  errors[0].Detail(),
 		})
 
-		return w.defaultDeleteTimeout
+		return defaultTimeout
+	}
+
+	return timeout
+}
+
+// defaultTimeout returns any provider-level default operation timeout or the resource's default value.
+// Provider-level defaults only apply to operations for which the resource has a default timeout.
+func defaultTimeout(ctx context.Context, timeout time.Duration, f func(conns.OperationTimeouts) time.Duration) time.Duration {
+	if timeout == 0 {
+		return timeout
+	}
+
+	if inContext, ok := conns.FromContext(ctx); ok {
+		if v := f(inContext.OperationTimeouts()); v != 0 {
+			return v
+		}
 	}
 
 	return timeout
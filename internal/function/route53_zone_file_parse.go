@@ -0,0 +1,99 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/dns"
+)
+
+var route53ZoneFileParseResultAttrTypes = map[string]attr.Type{
+	"name":    types.StringType,
+	"records": types.ListType{ElemType: types.StringType},
+	"ttl":     types.Int64Type,
+	"type":    types.StringType,
+}
+
+var _ function.Function = route53ZoneFileParseFunction{}
+
+func NewRoute53ZoneFileParseFunction() function.Function {
+	return &route53ZoneFileParseFunction{}
+}
+
+type route53ZoneFileParseFunction struct{}
+
+func (f route53ZoneFileParseFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "route53_zone_file_parse"
+}
+
+func (f route53ZoneFileParseFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "route53_zone_file_parse Function",
+		MarkdownDescription: "Parses a BIND zone file into Route 53 resource record sets",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "content",
+				MarkdownDescription: "Content of the zone file",
+			},
+			function.StringParameter{
+				Name:                "origin",
+				MarkdownDescription: "Domain name that relative names in the zone file are relative to",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{
+				AttrTypes: route53ZoneFileParseResultAttrTypes,
+			},
+		},
+	}
+}
+
+func (f route53ZoneFileParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var content, origin string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &content, &origin))
+	if resp.Error != nil {
+		return
+	}
+
+	recordSets, err := dns.ParseZoneFile(content, origin, 0)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.NewFuncError(err.Error()))
+		return
+	}
+
+	elems := make([]attr.Value, 0, len(recordSets))
+	for _, recordSet := range recordSets {
+		records := make([]attr.Value, 0, len(recordSet.Values))
+		for _, v := range recordSet.Values {
+			records = append(records, types.StringValue(v))
+		}
+
+		value := map[string]attr.Value{
+			"name":    types.StringValue(recordSet.Name),
+			"records": types.ListValueMust(types.StringType, records),
+			"ttl":     types.Int64Value(recordSet.TTL),
+			"type":    types.StringValue(recordSet.Type),
+		}
+
+		elem, d := types.ObjectValue(route53ZoneFileParseResultAttrTypes, value)
+		if d.HasError() {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+			return
+		}
+		elems = append(elems, elem)
+	}
+
+	result, d := types.ListValue(types.ObjectType{AttrTypes: route53ZoneFileParseResultAttrTypes}, elems)
+	if d.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, d))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
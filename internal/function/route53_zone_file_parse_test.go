@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestRoute53ZoneFileParseFunction_valid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testRoute53ZoneFileParseFunctionConfig(`$TTL 300
+www IN A 192.0.2.1
+www IN A 192.0.2.2
+*   IN CNAME www
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("count", "2"),
+					resource.TestCheckOutput(names.AttrName, "www.example.com."),
+					resource.TestCheckOutput("records", "192.0.2.1,192.0.2.2"),
+					resource.TestCheckOutput("ttl", "300"),
+					resource.TestCheckOutput(names.AttrType, "A"),
+					resource.TestCheckOutput("wildcard", "*.example.com. www.example.com."),
+				),
+			},
+		},
+	})
+}
+
+func TestRoute53ZoneFileParseFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testRoute53ZoneFileParseFunctionConfig("www 300 HINFO PC Linux\n"),
+				ExpectError: regexache.MustCompile(`unsupported[\s\n]*record[\s\n]*type[\s\n]*HINFO`),
+			},
+		},
+	})
+}
+
+func testRoute53ZoneFileParseFunctionConfig(content string) string {
+	return fmt.Sprintf(`
+locals {
+  record_sets = provider::aws::route53_zone_file_parse(%[1]q, "example.com.")
+}
+
+output "count" {
+  value = length(local.record_sets)
+}
+
+output "name" {
+  value = try(local.record_sets[0].name, "")
+}
+
+output "records" {
+  value = try(join(",", local.record_sets[0].records), "")
+}
+
+output "ttl" {
+  value = try(local.record_sets[0].ttl, 0)
+}
+
+output "type" {
+  value = try(local.record_sets[0].type, "")
+}
+
+output "wildcard" {
+  value = try("${local.record_sets[1].name} ${local.record_sets[1].records[0]}", "")
+}
+`, content)
+}
@@ -0,0 +1,7 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+//go:generate go run main.go
+// ONLY generate directives and package declaration! Do not add anything else to this file.
+
+package iampolicy
@@ -0,0 +1,116 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+
+package main
+
+import (
+	_ "embed"
+	"encoding/json"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+)
+
+//go:embed catalog.gtpl
+var tmpl string
+
+// serviceReference is the subset of a Service Authorization Reference service
+// document (https://servicereference.us-east-1.amazonaws.com/v1/<service>/<service>.json)
+// used to build the catalog.
+type serviceReference struct {
+	Name    string `json:"Name"`
+	Actions []struct {
+		Name string `json:"Name"`
+	} `json:"Actions"`
+	ConditionKeys []struct {
+		Name string `json:"Name"`
+	} `json:"ConditionKeys"`
+	Resources []struct {
+		Name       string   `json:"Name"`
+		ARNFormats []string `json:"ARNFormats"`
+	} `json:"Resources"`
+}
+
+type serviceDatum struct {
+	Prefix        string
+	Actions       []string
+	ConditionKeys []string
+	ARNFormats    []string
+}
+
+type TemplateData struct {
+	Services []serviceDatum
+}
+
+func main() {
+	const (
+		source   = `../../../internal/iampolicy/service-reference.json`
+		filename = `../../../internal/iampolicy/catalog_gen.go`
+	)
+	g := common.NewGenerator()
+
+	g.Infof("Generating %s", strings.TrimPrefix(filename, "../../../"))
+
+	b, err := os.ReadFile(source)
+	if err != nil {
+		g.Fatalf("reading %s: %s", source, err)
+	}
+
+	var references []serviceReference
+	if err := json.Unmarshal(b, &references); err != nil {
+		g.Fatalf("parsing %s: %s", source, err)
+	}
+
+	var td TemplateData
+	for _, reference := range references {
+		prefix := strings.ToLower(reference.Name)
+		datum := serviceDatum{
+			Prefix: prefix,
+		}
+
+		for _, v := range reference.Actions {
+			datum.Actions = append(datum.Actions, v.Name)
+		}
+		for _, v := range reference.ConditionKeys {
+			// Global condition keys are listed separately.
+			if strings.HasPrefix(strings.ToLower(v.Name), prefix+":") {
+				datum.ConditionKeys = append(datum.ConditionKeys, v.Name)
+			}
+		}
+		for _, v := range reference.Resources {
+			for _, format := range v.ARNFormats {
+				// Only ARNs owned by the service are indexed, e.g. sts lists IAM role ARNs.
+				if parts := strings.SplitN(format, ":", 4); len(parts) == 4 && parts[2] == prefix {
+					datum.ARNFormats = append(datum.ARNFormats, format)
+				}
+			}
+		}
+
+		slices.Sort(datum.Actions)
+		datum.Actions = slices.Compact(datum.Actions)
+		slices.Sort(datum.ConditionKeys)
+		datum.ConditionKeys = slices.Compact(datum.ConditionKeys)
+		slices.Sort(datum.ARNFormats)
+		datum.ARNFormats = slices.Compact(datum.ARNFormats)
+
+		td.Services = append(td.Services, datum)
+	}
+
+	slices.SortFunc(td.Services, func(a, b serviceDatum) int {
+		return strings.Compare(a.Prefix, b.Prefix)
+	})
+
+	d := g.NewGoFileDestination(filename)
+
+	if err := d.BufferTemplate("catalog", tmpl, td); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
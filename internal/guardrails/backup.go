@@ -0,0 +1,53 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package guardrails
+
+import (
+	"fmt"
+	"maps"
+	"time"
+)
+
+const (
+	// BackupBeforeDestroyDefaultTimeout is the default time to wait for a backup to complete.
+	BackupBeforeDestroyDefaultTimeout = 60 * time.Minute
+
+	BackupTagResourceID   = "terraform:resource-id"
+	BackupTagResourceType = "terraform:resource-type"
+)
+
+// BackupBeforeDestroyConfig is the provider-level configuration of backups taken before resources are destroyed or replaced.
+// A nil *BackupBeforeDestroyConfig takes no backups.
+type BackupBeforeDestroyConfig struct {
+	// Selector selects the resources that are backed up.
+	Selector
+	// BackupTags are the tags applied to backups, in addition to tags identifying the resource.
+	BackupTags map[string]string
+	// BackupVaultName is the name of the AWS Backup vault used by backups taken with AWS Backup.
+	BackupVaultName string
+	// IAMRoleARN is the ARN of the IAM role used by backups taken with AWS Backup.
+	IAMRoleARN string
+	// Timeout is the time to wait for a backup to complete.
+	Timeout time.Duration
+}
+
+// Tags returns the tags to apply to a backup of the resource of the specified type and ID.
+// Terraform does not make resource addresses available to providers, so the type and ID identify the resource.
+func (c *BackupBeforeDestroyConfig) Tags(typeName, id string) map[string]string {
+	tags := maps.Clone(c.BackupTags)
+	if tags == nil {
+		tags = make(map[string]string)
+	}
+
+	tags[BackupTagResourceType] = typeName
+	tags[BackupTagResourceID] = id
+
+	return tags
+}
+
+// Name returns a name for a backup of a resource with the specified name.
+// Backup names are unique per second.
+func (c *BackupBeforeDestroyConfig) Name(name string) string {
+	return fmt.Sprintf("%s-tf-destroy-%s", name, time.Now().UTC().Format("20060102150405"))
+}
@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package guardrails
+
+import (
+	"maps"
+	"strings"
+	"testing"
+)
+
+func TestBackupBeforeDestroyConfigTags(t *testing.T) {
+	t.Parallel()
+
+	config := &BackupBeforeDestroyConfig{
+		BackupTags: map[string]string{"Retention": "90d"},
+	}
+
+	got := config.Tags("aws_db_instance", "db-ABCDEFGHIJ")
+	want := map[string]string{
+		"Retention":           "90d",
+		BackupTagResourceType: "aws_db_instance",
+		BackupTagResourceID:   "db-ABCDEFGHIJ",
+	}
+
+	if !maps.Equal(got, want) {
+		t.Errorf("Tags = %v, want %v", got, want)
+	}
+
+	if len(config.BackupTags) != 1 {
+		t.Errorf("BackupTags modified: %v", config.BackupTags)
+	}
+
+	if got := (&BackupBeforeDestroyConfig{}).Tags("aws_ebs_volume", "vol-12345678"); len(got) != 2 {
+		t.Errorf("Tags = %v, want resource tags only", got)
+	}
+}
+
+func TestBackupBeforeDestroyConfigName(t *testing.T) {
+	t.Parallel()
+
+	config := &BackupBeforeDestroyConfig{}
+
+	if got := config.Name("main"); !strings.HasPrefix(got, "main-tf-destroy-") || len(got) != len("main-tf-destroy-20060102150405") {
+		t.Errorf("Name = %q", got)
+	}
+}
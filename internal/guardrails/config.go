@@ -0,0 +1,163 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package guardrails
+
+import (
+	"fmt"
+	"maps"
+	"path"
+	"slices"
+	"sync/atomic"
+)
+
+const (
+	RuleDestroyProtection      = "destroy_protection"
+	RuleForbiddenResourceTypes = "forbidden_resource_types"
+	RuleMaxDeletions           = "max_deletions"
+)
+
+// Config is the provider-level configuration of guardrail policies.
+// Guardrails are enforced by the Plugin SDK V2 and Plugin Framework interceptors.
+// A nil *Config enforces no guardrails.
+type Config struct {
+	// DestroyProtection are the rules matching resources that must not be destroyed or replaced.
+	DestroyProtection []DestroyProtectionRule
+	// ForbiddenResourceTypes are patterns, e.g. "aws_iam_*", matching the resource types that must not be created.
+	ForbiddenResourceTypes []string
+	// MaxDeletions is the maximum number of resources planned for deletion, or replacement, by the provider instance. 0 means unlimited.
+	MaxDeletions int
+
+	deletions atomic.Int64
+}
+
+// DestroyProtectionRule matches resources that must not be destroyed or replaced.
+type DestroyProtectionRule struct {
+	Selector
+	Name string
+}
+
+// Selector selects resources by type and tags.
+// A resource matches if its type matches any of the resource type patterns and it has all of the tags.
+// An empty set of patterns, or of tags, matches all resources.
+type Selector struct {
+	ResourceTypes []string
+	Tags          map[string]string
+}
+
+// Violation is a guardrail policy violation.
+type Violation struct {
+	// Rule identifies the guardrail rule that failed, e.g. `destroy_protection "production"`.
+	Rule   string
+	Detail string
+}
+
+func (v *Violation) Error() string {
+	return fmt.Sprintf("guardrail %s: %s", v.Rule, v.Detail)
+}
+
+// Summary is the summary of diagnostics reporting guardrail violations.
+const Summary = "Guardrail Policy Violation"
+
+// ValidatePattern returns an error if the specified resource type pattern is malformed.
+func ValidatePattern(pattern string) error {
+	_, err := path.Match(pattern, "")
+	return err
+}
+
+// CheckResourceType returns a violation if resources of the specified type must not be created.
+func (c *Config) CheckResourceType(typeName string) *Violation {
+	if c == nil {
+		return nil
+	}
+
+	if pattern, ok := matchAny(c.ForbiddenResourceTypes, typeName); ok {
+		return &Violation{
+			Rule:   RuleForbiddenResourceTypes,
+			Detail: fmt.Sprintf("resource type %s matches the forbidden pattern %q", typeName, pattern),
+		}
+	}
+
+	return nil
+}
+
+// CheckDestroy returns a violation if the resource of the specified type, with the specified tags, must not be destroyed or replaced.
+func (c *Config) CheckDestroy(typeName string, tags map[string]string) *Violation {
+	if c == nil {
+		return nil
+	}
+
+	for i, rule := range c.DestroyProtection {
+		if !rule.Matches(typeName, tags) {
+			continue
+		}
+
+		name := fmt.Sprintf("%s[%d]", RuleDestroyProtection, i)
+		if rule.Name != "" {
+			name = fmt.Sprintf("%s %q", RuleDestroyProtection, rule.Name)
+		}
+
+		return &Violation{
+			Rule:   name,
+			Detail: fmt.Sprintf("%s resources matching this rule must not be destroyed or replaced", typeName),
+		}
+	}
+
+	return nil
+}
+
+// CountDeletion records the planned deletion, or replacement, of a resource of the specified type.
+// It returns a violation, and does not record the deletion, if the maximum number of deletions would be exceeded.
+func (c *Config) CountDeletion(typeName string) *Violation {
+	if c == nil || c.MaxDeletions <= 0 {
+		return nil
+	}
+
+	if n := c.deletions.Add(1); n > int64(c.MaxDeletions) {
+		c.deletions.Add(-1)
+
+		return &Violation{
+			Rule:   RuleMaxDeletions,
+			Detail: fmt.Sprintf("deleting %s would exceed the maximum of %d deletions", typeName, c.MaxDeletions),
+		}
+	}
+
+	return nil
+}
+
+// Matches returns whether the resource of the specified type, with the specified tags, is selected.
+func (s Selector) Matches(typeName string, tags map[string]string) bool {
+	if !s.MatchesResourceType(typeName) {
+		return false
+	}
+
+	for _, k := range slices.Sorted(maps.Keys(s.Tags)) {
+		if v, ok := tags[k]; !ok || v != s.Tags[k] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// MatchesResourceType returns whether resources of the specified type can be selected.
+func (s Selector) MatchesResourceType(typeName string) bool {
+	if len(s.ResourceTypes) == 0 {
+		return true
+	}
+
+	_, ok := matchAny(s.ResourceTypes, typeName)
+
+	return ok
+}
+
+// matchAny returns the first of the patterns matching the resource type.
+func matchAny(patterns []string, typeName string) (string, bool) {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, typeName); ok {
+			return pattern, true
+		}
+	}
+
+	return "", false
+}
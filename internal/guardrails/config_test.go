@@ -0,0 +1,173 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package guardrails
+
+import (
+	"testing"
+)
+
+func TestCheckResourceType(t *testing.T) {
+	t.Parallel()
+
+	config := &Config{
+		ForbiddenResourceTypes: []string{"aws_iam_user*", "aws_default_vpc"},
+	}
+
+	testCases := map[string]struct {
+		config   *Config
+		typeName string
+		wantRule string
+	}{
+		"nil config": {
+			typeName: "aws_iam_user",
+		},
+		"no match": {
+			config:   config,
+			typeName: "aws_iam_role",
+		},
+		"prefix pattern": {
+			config:   config,
+			typeName: "aws_iam_user_policy",
+			wantRule: RuleForbiddenResourceTypes,
+		},
+		"exact pattern": {
+			config:   config,
+			typeName: "aws_default_vpc",
+			wantRule: RuleForbiddenResourceTypes,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := ruleOf(testCase.config.CheckResourceType(testCase.typeName)), testCase.wantRule; got != want {
+				t.Errorf("rule = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestCheckDestroy(t *testing.T) {
+	t.Parallel()
+
+	config := &Config{
+		DestroyProtection: []DestroyProtectionRule{
+			{
+				Selector: Selector{
+					ResourceTypes: []string{"aws_rds_cluster", "aws_db_*"},
+				},
+			},
+			{
+				Selector: Selector{
+					Tags: map[string]string{"Environment": "production"},
+				},
+				Name: "production",
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		config   *Config
+		typeName string
+		tags     map[string]string
+		wantRule string
+	}{
+		"nil config": {
+			typeName: "aws_rds_cluster",
+		},
+		"no match": {
+			config:   config,
+			typeName: "aws_vpc",
+			tags:     map[string]string{"Environment": "test"},
+		},
+		"resource type": {
+			config:   config,
+			typeName: "aws_db_instance",
+			wantRule: "destroy_protection[0]",
+		},
+		"tags": {
+			config:   config,
+			typeName: "aws_vpc",
+			tags:     map[string]string{"Environment": "production", "Name": "main"},
+			wantRule: `destroy_protection "production"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := ruleOf(testCase.config.CheckDestroy(testCase.typeName, testCase.tags)), testCase.wantRule; got != want {
+				t.Errorf("rule = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestCountDeletion(t *testing.T) {
+	t.Parallel()
+
+	config := &Config{MaxDeletions: 2}
+
+	for i := range 2 {
+		if v := config.CountDeletion("aws_vpc"); v != nil {
+			t.Fatalf("deletion %d: unexpected violation: %s", i, v)
+		}
+	}
+
+	for range 2 {
+		if got, want := ruleOf(config.CountDeletion("aws_vpc")), RuleMaxDeletions; got != want {
+			t.Errorf("rule = %q, want %q", got, want)
+		}
+	}
+
+	var unlimited *Config
+	if v := unlimited.CountDeletion("aws_vpc"); v != nil {
+		t.Errorf("nil config: unexpected violation: %s", v)
+	}
+}
+
+func ruleOf(v *Violation) string {
+	if v == nil {
+		return ""
+	}
+
+	return v.Rule
+}
+
+func TestSelectorMatchesResourceType(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		selector Selector
+		typeName string
+		want     bool
+	}{
+		"no resource types": {
+			selector: Selector{Tags: map[string]string{"Environment": "production"}},
+			typeName: "aws_efs_file_system",
+			want:     true,
+		},
+		"pattern": {
+			selector: Selector{ResourceTypes: []string{"aws_efs_*"}},
+			typeName: "aws_efs_file_system",
+			want:     true,
+		},
+		"no match": {
+			selector: Selector{ResourceTypes: []string{"aws_db_*"}},
+			typeName: "aws_efs_file_system",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.selector.MatchesResourceType(testCase.typeName), testCase.want; got != want {
+				t.Errorf("MatchesResourceType = %t, want %t", got, want)
+			}
+		})
+	}
+}
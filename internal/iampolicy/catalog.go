@@ -0,0 +1,189 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy
+
+import (
+	"strings"
+	"sync"
+)
+
+// The catalog is generated from the Service Authorization Reference by internal/generate/iampolicy.
+// Only the services in service-reference.json (kms, s3, secretsmanager, sns, sqs and sts) are in the catalog.
+// Actions, resource ARNs and service condition keys of services not in the catalog are not validated.
+// Global condition keys are validated for all services.
+
+type service struct {
+	actions       []string
+	conditionKeys []string
+	arnFormats    []string
+}
+
+// globalConditionKeys are the AWS global condition context keys.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_condition-keys.html.
+var globalConditionKeys = []string{
+	"aws:AssumedRoot",
+	"aws:CalledVia",
+	"aws:CalledViaFirst",
+	"aws:CalledViaLast",
+	"aws:ChatbotSourceArn",
+	"aws:CurrentTime",
+	"aws:Ec2InstanceSourcePrivateIPv4",
+	"aws:Ec2InstanceSourceVpc",
+	"aws:EpochTime",
+	"aws:FederatedProvider",
+	"aws:MultiFactorAuthAge",
+	"aws:MultiFactorAuthPresent",
+	"aws:PrincipalAccount",
+	"aws:PrincipalArn",
+	"aws:PrincipalIsAWSService",
+	"aws:PrincipalOrgID",
+	"aws:PrincipalOrgPaths",
+	"aws:PrincipalServiceName",
+	"aws:PrincipalServiceNamesList",
+	"aws:PrincipalTag/${TagKey}",
+	"aws:PrincipalType",
+	"aws:RequestTag/${TagKey}",
+	"aws:RequestedRegion",
+	"aws:ResourceAccount",
+	"aws:ResourceOrgID",
+	"aws:ResourceOrgPaths",
+	"aws:ResourceTag/${TagKey}",
+	"aws:SecureTransport",
+	"aws:SourceAccount",
+	"aws:SourceArn",
+	"aws:SourceIdentity",
+	"aws:SourceInstanceARN",
+	"aws:SourceIp",
+	"aws:SourceOrgID",
+	"aws:SourceOrgPaths",
+	"aws:SourceOwner",
+	"aws:SourceVpc",
+	"aws:SourceVpcArn",
+	"aws:SourceVpce",
+	"aws:TagKeys",
+	"aws:TokenIssueTime",
+	"aws:UserAgent",
+	"aws:ViaAWSService",
+	"aws:VpcSourceIp",
+	"aws:VpceAccount",
+	"aws:VpceOrgID",
+	"aws:VpceOrgPaths",
+	"aws:referer",
+	"aws:userid",
+	"aws:username",
+}
+
+type catalogIndex struct {
+	// actions maps lower-cased service prefix to lower-cased action names.
+	actions map[string][]string
+	// conditionKeys maps lower-cased service prefix ("aws" for global keys) to lower-cased condition key names.
+	conditionKeys map[string][]string
+	// arnFormats maps ARN service name to the parsed ARN formats.
+	arnFormats map[string][][]arnToken
+}
+
+var catalog = sync.OnceValue(func() *catalogIndex {
+	index := &catalogIndex{
+		actions:       make(map[string][]string),
+		conditionKeys: make(map[string][]string),
+		arnFormats:    make(map[string][][]arnToken),
+	}
+
+	for prefix, service := range services {
+		for _, v := range service.actions {
+			index.actions[prefix] = append(index.actions[prefix], strings.ToLower(v))
+		}
+		for _, v := range service.conditionKeys {
+			index.conditionKeys[prefix] = append(index.conditionKeys[prefix], strings.ToLower(v))
+		}
+		for _, v := range service.arnFormats {
+			index.arnFormats[prefix] = append(index.arnFormats[prefix], parseARNFormat(v))
+		}
+	}
+
+	for _, v := range globalConditionKeys {
+		index.conditionKeys["aws"] = append(index.conditionKeys["aws"], strings.ToLower(v))
+	}
+
+	return index
+})
+
+// arnToken is either a literal string or a variable in an ARN format.
+type arnToken struct {
+	literal  string
+	variable string
+}
+
+// parseARNFormat parses an ARN format such as `arn:${Partition}:s3:::${BucketName}` into tokens.
+func parseARNFormat(format string) []arnToken {
+	var tokens []arnToken
+
+	for len(format) > 0 {
+		start := strings.Index(format, "${")
+		if start == -1 {
+			tokens = append(tokens, arnToken{literal: format})
+			break
+		}
+
+		end := strings.Index(format[start:], "}")
+		if end == -1 {
+			tokens = append(tokens, arnToken{literal: format})
+			break
+		}
+
+		if start > 0 {
+			tokens = append(tokens, arnToken{literal: format[:start]})
+		}
+		tokens = append(tokens, arnToken{variable: format[start+2 : start+end]})
+		format = format[start+end+1:]
+	}
+
+	return tokens
+}
+
+// matchARNFormat returns whether the ARN matches the tokenized ARN format.
+// If prefixOnly is true it returns whether any ARN matching the format could start with arn.
+func matchARNFormat(tokens []arnToken, arn string, prefixOnly bool) bool {
+	if len(tokens) == 0 {
+		return len(arn) == 0
+	}
+
+	if prefixOnly && len(arn) == 0 {
+		return true
+	}
+
+	token := tokens[0]
+
+	if token.variable == "" {
+		n := min(len(token.literal), len(arn))
+		if token.literal[:n] != arn[:n] {
+			return false
+		}
+		if n < len(token.literal) {
+			// The ARN is exhausted.
+			return prefixOnly
+		}
+
+		return matchARNFormat(tokens[1:], arn[n:], prefixOnly)
+	}
+
+	// Partition, Region and Account variables do not span ARN sections.
+	spansSections := true
+	switch token.variable {
+	case "Partition", "Region", "Account":
+		spansSections = false
+	}
+
+	for i := 0; i <= len(arn); i++ {
+		if i > 0 && !spansSections && arn[i-1] == ':' {
+			break
+		}
+
+		if matchARNFormat(tokens[1:], arn[i:], prefixOnly) {
+			return true
+		}
+	}
+
+	return false
+}
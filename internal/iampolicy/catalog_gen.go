@@ -0,0 +1,512 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+// Code generated by internal/generate/iampolicy/main.go; DO NOT EDIT.
+
+package iampolicy
+
+// services is the catalog of service actions, condition keys and resource ARN formats,
+// keyed by service prefix.
+var services = map[string]service{
+	"kms": {
+		actions: []string{
+			"CancelKeyDeletion",
+			"ConnectCustomKeyStore",
+			"CreateAlias",
+			"CreateCustomKeyStore",
+			"CreateGrant",
+			"CreateKey",
+			"Decrypt",
+			"DeleteAlias",
+			"DeleteCustomKeyStore",
+			"DeleteImportedKeyMaterial",
+			"DeriveSharedSecret",
+			"DescribeCustomKeyStores",
+			"DescribeKey",
+			"DisableKey",
+			"DisableKeyRotation",
+			"DisconnectCustomKeyStore",
+			"EnableKey",
+			"EnableKeyRotation",
+			"Encrypt",
+			"GenerateDataKey",
+			"GenerateDataKeyPair",
+			"GenerateDataKeyPairWithoutPlaintext",
+			"GenerateDataKeyWithoutPlaintext",
+			"GenerateMac",
+			"GenerateRandom",
+			"GetKeyPolicy",
+			"GetKeyRotationStatus",
+			"GetParametersForImport",
+			"GetPublicKey",
+			"ImportKeyMaterial",
+			"ListAliases",
+			"ListGrants",
+			"ListKeyPolicies",
+			"ListKeyRotations",
+			"ListKeys",
+			"ListResourceTags",
+			"ListRetirableGrants",
+			"PutKeyPolicy",
+			"ReEncryptFrom",
+			"ReEncryptTo",
+			"ReplicateKey",
+			"RetireGrant",
+			"RevokeGrant",
+			"RotateKeyOnDemand",
+			"ScheduleKeyDeletion",
+			"Sign",
+			"SynchronizeMultiRegionKey",
+			"TagResource",
+			"UntagResource",
+			"UpdateAlias",
+			"UpdateCustomKeyStore",
+			"UpdateKeyDescription",
+			"UpdatePrimaryRegion",
+			"Verify",
+			"VerifyMac",
+		},
+		conditionKeys: []string{
+			"kms:BypassPolicyLockoutSafetyCheck",
+			"kms:CallerAccount",
+			"kms:CustomerMasterKeySpec",
+			"kms:CustomerMasterKeyUsage",
+			"kms:DataKeyPairSpec",
+			"kms:EncryptionAlgorithm",
+			"kms:EncryptionContext:${EncryptionContextKey}",
+			"kms:EncryptionContextKeys",
+			"kms:ExpirationModel",
+			"kms:GrantConstraintType",
+			"kms:GrantIsForAWSResource",
+			"kms:GrantOperations",
+			"kms:GranteePrincipal",
+			"kms:KeyAgreementAlgorithm",
+			"kms:KeyOrigin",
+			"kms:KeySpec",
+			"kms:KeyUsage",
+			"kms:MacAlgorithm",
+			"kms:MessageType",
+			"kms:MultiRegion",
+			"kms:MultiRegionKeyType",
+			"kms:PrimaryRegion",
+			"kms:ReEncryptOnSameKey",
+			"kms:RecipientAttestation:ImageSha384",
+			"kms:RecipientAttestation:PCR${PCR_ID}",
+			"kms:ReplicaRegion",
+			"kms:RequestAlias",
+			"kms:ResourceAliases",
+			"kms:RetiringPrincipal",
+			"kms:RotationPeriodInDays",
+			"kms:ScheduleKeyDeletionPendingWindowInDays",
+			"kms:SigningAlgorithm",
+			"kms:ValidTo",
+			"kms:ViaService",
+			"kms:WrappingAlgorithm",
+			"kms:WrappingKeySpec",
+		},
+		arnFormats: []string{
+			"arn:${Partition}:kms:${Region}:${Account}:alias/${Alias}",
+			"arn:${Partition}:kms:${Region}:${Account}:key/${KeyId}",
+		},
+	},
+	"s3": {
+		actions: []string{
+			"AbortMultipartUpload",
+			"AssociateAccessGrantsIdentityCenter",
+			"BypassGovernanceRetention",
+			"CreateAccessGrant",
+			"CreateAccessGrantsInstance",
+			"CreateAccessGrantsLocation",
+			"CreateAccessPoint",
+			"CreateAccessPointForObjectLambda",
+			"CreateBucket",
+			"CreateBucketMetadataConfiguration",
+			"CreateBucketMetadataTableConfiguration",
+			"CreateJob",
+			"CreateMultiRegionAccessPoint",
+			"CreateStorageLensGroup",
+			"DeleteAccessGrant",
+			"DeleteAccessGrantsInstance",
+			"DeleteAccessGrantsInstanceResourcePolicy",
+			"DeleteAccessGrantsLocation",
+			"DeleteAccessPoint",
+			"DeleteAccessPointForObjectLambda",
+			"DeleteAccessPointPolicy",
+			"DeleteAccessPointPolicyForObjectLambda",
+			"DeleteAccessPointScope",
+			"DeleteBucket",
+			"DeleteBucketMetadataConfiguration",
+			"DeleteBucketMetadataTableConfiguration",
+			"DeleteBucketOwnershipControls",
+			"DeleteBucketPolicy",
+			"DeleteBucketWebsite",
+			"DeleteJobTagging",
+			"DeleteMultiRegionAccessPoint",
+			"DeleteObject",
+			"DeleteObjectTagging",
+			"DeleteObjectVersion",
+			"DeleteObjectVersionTagging",
+			"DeleteStorageLensConfiguration",
+			"DeleteStorageLensConfigurationTagging",
+			"DeleteStorageLensGroup",
+			"DescribeJob",
+			"DescribeMultiRegionAccessPointOperation",
+			"DissociateAccessGrantsIdentityCenter",
+			"GetAccelerateConfiguration",
+			"GetAccessGrant",
+			"GetAccessGrantsInstance",
+			"GetAccessGrantsInstanceForPrefix",
+			"GetAccessGrantsInstanceResourcePolicy",
+			"GetAccessGrantsLocation",
+			"GetAccessPoint",
+			"GetAccessPointConfigurationForObjectLambda",
+			"GetAccessPointForObjectLambda",
+			"GetAccessPointPolicy",
+			"GetAccessPointPolicyForObjectLambda",
+			"GetAccessPointPolicyStatus",
+			"GetAccessPointPolicyStatusForObjectLambda",
+			"GetAccessPointScope",
+			"GetAccountPublicAccessBlock",
+			"GetAnalyticsConfiguration",
+			"GetBucketAbac",
+			"GetBucketAcl",
+			"GetBucketCORS",
+			"GetBucketLocation",
+			"GetBucketLogging",
+			"GetBucketMetadataConfiguration",
+			"GetBucketMetadataTableConfiguration",
+			"GetBucketNotification",
+			"GetBucketObjectLockConfiguration",
+			"GetBucketOwnershipControls",
+			"GetBucketPolicy",
+			"GetBucketPolicyStatus",
+			"GetBucketPublicAccessBlock",
+			"GetBucketRequestPayment",
+			"GetBucketTagging",
+			"GetBucketVersioning",
+			"GetBucketWebsite",
+			"GetDataAccess",
+			"GetEncryptionConfiguration",
+			"GetIntelligentTieringConfiguration",
+			"GetInventoryConfiguration",
+			"GetJobTagging",
+			"GetLifecycleConfiguration",
+			"GetMetricsConfiguration",
+			"GetMultiRegionAccessPoint",
+			"GetMultiRegionAccessPointPolicy",
+			"GetMultiRegionAccessPointPolicyStatus",
+			"GetMultiRegionAccessPointRoutes",
+			"GetObject",
+			"GetObjectAcl",
+			"GetObjectAttributes",
+			"GetObjectLegalHold",
+			"GetObjectRetention",
+			"GetObjectTagging",
+			"GetObjectTorrent",
+			"GetObjectVersion",
+			"GetObjectVersionAcl",
+			"GetObjectVersionAttributes",
+			"GetObjectVersionForReplication",
+			"GetObjectVersionTagging",
+			"GetObjectVersionTorrent",
+			"GetReplicationConfiguration",
+			"GetStorageLensConfiguration",
+			"GetStorageLensConfigurationTagging",
+			"GetStorageLensDashboard",
+			"GetStorageLensGroup",
+			"InitiateReplication",
+			"ListAccessGrants",
+			"ListAccessGrantsInstances",
+			"ListAccessGrantsLocations",
+			"ListAccessPoints",
+			"ListAccessPointsForDirectoryBuckets",
+			"ListAccessPointsForObjectLambda",
+			"ListAllMyBuckets",
+			"ListBucket",
+			"ListBucketMultipartUploads",
+			"ListBucketVersions",
+			"ListCallerAccessGrants",
+			"ListJobs",
+			"ListMultiRegionAccessPoints",
+			"ListMultipartUploadParts",
+			"ListStorageLensConfigurations",
+			"ListStorageLensGroups",
+			"ListTagsForResource",
+			"ObjectOwnerOverrideToBucketOwner",
+			"PauseReplication",
+			"PutAccelerateConfiguration",
+			"PutAccessGrantsInstanceResourcePolicy",
+			"PutAccessPointConfigurationForObjectLambda",
+			"PutAccessPointPolicy",
+			"PutAccessPointPolicyForObjectLambda",
+			"PutAccessPointPublicAccessBlock",
+			"PutAccessPointScope",
+			"PutAccountPublicAccessBlock",
+			"PutAnalyticsConfiguration",
+			"PutBucketAbac",
+			"PutBucketAcl",
+			"PutBucketCORS",
+			"PutBucketLogging",
+			"PutBucketNotification",
+			"PutBucketObjectLockConfiguration",
+			"PutBucketOwnershipControls",
+			"PutBucketPolicy",
+			"PutBucketPublicAccessBlock",
+			"PutBucketRequestPayment",
+			"PutBucketTagging",
+			"PutBucketVersioning",
+			"PutBucketWebsite",
+			"PutEncryptionConfiguration",
+			"PutIntelligentTieringConfiguration",
+			"PutInventoryConfiguration",
+			"PutJobTagging",
+			"PutLifecycleConfiguration",
+			"PutMetricsConfiguration",
+			"PutMultiRegionAccessPointPolicy",
+			"PutObject",
+			"PutObjectAcl",
+			"PutObjectLegalHold",
+			"PutObjectRetention",
+			"PutObjectTagging",
+			"PutObjectVersionAcl",
+			"PutObjectVersionTagging",
+			"PutReplicationConfiguration",
+			"PutStorageLensConfiguration",
+			"PutStorageLensConfigurationTagging",
+			"ReplicateDelete",
+			"ReplicateObject",
+			"ReplicateTags",
+			"RestoreObject",
+			"SubmitMultiRegionAccessPointRoutes",
+			"TagResource",
+			"UntagResource",
+			"UpdateAccessGrantsLocation",
+			"UpdateBucketMetadataInventoryTableConfiguration",
+			"UpdateBucketMetadataJournalTableConfiguration",
+			"UpdateJobPriority",
+			"UpdateJobStatus",
+			"UpdateStorageLensGroup",
+		},
+		conditionKeys: []string{
+			"s3:AccessGrantsInstanceArn",
+			"s3:AccessPointNetworkOrigin",
+			"s3:BucketTag/${TagKey}",
+			"s3:DataAccessPointAccount",
+			"s3:DataAccessPointArn",
+			"s3:ExistingJobOperation",
+			"s3:ExistingJobPriority",
+			"s3:ExistingObjectTag/${TagKey}",
+			"s3:JobSuspendedCause",
+			"s3:LocationConstraint",
+			"s3:ObjectCreationOperation",
+			"s3:RequestJobOperation",
+			"s3:RequestJobPriority",
+			"s3:RequestObjectTag/${TagKey}",
+			"s3:RequestObjectTagKeys",
+			"s3:ResourceAccount",
+			"s3:TlsVersion",
+			"s3:authType",
+			"s3:delimiter",
+			"s3:if-match",
+			"s3:if-none-match",
+			"s3:max-keys",
+			"s3:object-lock-legal-hold",
+			"s3:object-lock-mode",
+			"s3:object-lock-remaining-retention-days",
+			"s3:object-lock-retain-until-date",
+			"s3:prefix",
+			"s3:signatureAge",
+			"s3:signatureversion",
+			"s3:versionid",
+			"s3:x-amz-acl",
+			"s3:x-amz-content-sha256",
+			"s3:x-amz-copy-source",
+			"s3:x-amz-grant-full-control",
+			"s3:x-amz-grant-read",
+			"s3:x-amz-grant-read-acp",
+			"s3:x-amz-grant-write",
+			"s3:x-amz-grant-write-acp",
+			"s3:x-amz-metadata-directive",
+			"s3:x-amz-object-ownership",
+			"s3:x-amz-server-side-encryption",
+			"s3:x-amz-server-side-encryption-aws-kms-key-id",
+			"s3:x-amz-server-side-encryption-customer-algorithm",
+			"s3:x-amz-storage-class",
+			"s3:x-amz-website-redirect-location",
+		},
+		arnFormats: []string{
+			"arn:${Partition}:s3:${Region}:${Account}:access-grants/default",
+			"arn:${Partition}:s3:${Region}:${Account}:access-grants/default/grant/${TokenId}",
+			"arn:${Partition}:s3:${Region}:${Account}:access-grants/default/location/${TokenId}",
+			"arn:${Partition}:s3:${Region}:${Account}:accesspoint/${AccessPointName}",
+			"arn:${Partition}:s3:${Region}:${Account}:async-request/mrap/${Operation}/${Token}",
+			"arn:${Partition}:s3:${Region}:${Account}:job/${JobId}",
+			"arn:${Partition}:s3:${Region}:${Account}:storage-lens-group/${Name}",
+			"arn:${Partition}:s3:${Region}:${Account}:storage-lens/${ConfigId}",
+			"arn:${Partition}:s3::${Account}:accesspoint/${AccessPointAlias}",
+			"arn:${Partition}:s3:::${BucketName}",
+			"arn:${Partition}:s3:::${BucketName}/${ObjectName}",
+		},
+	},
+	"secretsmanager": {
+		actions: []string{
+			"BatchGetSecretValue",
+			"CancelRotateSecret",
+			"CreateSecret",
+			"DeleteResourcePolicy",
+			"DeleteSecret",
+			"DescribeSecret",
+			"GetRandomPassword",
+			"GetResourcePolicy",
+			"GetSecretValue",
+			"ListSecretVersionIds",
+			"ListSecrets",
+			"PutResourcePolicy",
+			"PutSecretValue",
+			"RemoveRegionsFromReplication",
+			"ReplicateSecretToRegions",
+			"RestoreSecret",
+			"RotateSecret",
+			"StopReplicationToReplica",
+			"TagResource",
+			"UntagResource",
+			"UpdateSecret",
+			"UpdateSecretVersionStage",
+			"ValidateResourcePolicy",
+		},
+		conditionKeys: []string{
+			"secretsmanager:AddReplicaRegions",
+			"secretsmanager:BlockPublicPolicy",
+			"secretsmanager:Description",
+			"secretsmanager:ForceDeleteWithoutRecovery",
+			"secretsmanager:ForceOverwriteReplicaSecret",
+			"secretsmanager:KmsKeyId",
+			"secretsmanager:ModifyRotationRules",
+			"secretsmanager:Name",
+			"secretsmanager:RecoveryWindowInDays",
+			"secretsmanager:ResourceTag/${TagKey}",
+			"secretsmanager:RotateImmediately",
+			"secretsmanager:RotationLambdaARN",
+			"secretsmanager:SecretId",
+			"secretsmanager:SecretPrimaryRegion",
+			"secretsmanager:VersionId",
+			"secretsmanager:VersionStage",
+			"secretsmanager:resource/AllowRotationLambdaArn",
+		},
+		arnFormats: []string{
+			"arn:${Partition}:secretsmanager:${Region}:${Account}:secret:${SecretId}",
+		},
+	},
+	"sns": {
+		actions: []string{
+			"AddPermission",
+			"CheckIfPhoneNumberIsOptedOut",
+			"ConfirmSubscription",
+			"CreatePlatformApplication",
+			"CreatePlatformEndpoint",
+			"CreateSMSSandboxPhoneNumber",
+			"CreateTopic",
+			"DeleteEndpoint",
+			"DeletePlatformApplication",
+			"DeleteSMSSandboxPhoneNumber",
+			"DeleteTopic",
+			"GetDataProtectionPolicy",
+			"GetEndpointAttributes",
+			"GetPlatformApplicationAttributes",
+			"GetSMSAttributes",
+			"GetSMSSandboxAccountStatus",
+			"GetSubscriptionAttributes",
+			"GetTopicAttributes",
+			"ListEndpointsByPlatformApplication",
+			"ListOriginationNumbers",
+			"ListPhoneNumbersOptedOut",
+			"ListPlatformApplications",
+			"ListSMSSandboxPhoneNumbers",
+			"ListSubscriptions",
+			"ListSubscriptionsByTopic",
+			"ListTagsForResource",
+			"ListTopics",
+			"OptInPhoneNumber",
+			"Publish",
+			"PutDataProtectionPolicy",
+			"RemovePermission",
+			"SetEndpointAttributes",
+			"SetPlatformApplicationAttributes",
+			"SetSMSAttributes",
+			"SetSubscriptionAttributes",
+			"SetTopicAttributes",
+			"Subscribe",
+			"TagResource",
+			"Unsubscribe",
+			"UntagResource",
+			"VerifySMSSandboxPhoneNumber",
+		},
+		conditionKeys: []string{
+			"sns:Endpoint",
+			"sns:Protocol",
+		},
+		arnFormats: []string{
+			"arn:${Partition}:sns:${Region}:${Account}:${TopicName}",
+		},
+	},
+	"sqs": {
+		actions: []string{
+			"AddPermission",
+			"CancelMessageMoveTask",
+			"ChangeMessageVisibility",
+			"CreateQueue",
+			"DeleteMessage",
+			"DeleteQueue",
+			"GetQueueAttributes",
+			"GetQueueUrl",
+			"ListDeadLetterSourceQueues",
+			"ListMessageMoveTasks",
+			"ListQueueTags",
+			"ListQueues",
+			"PurgeQueue",
+			"ReceiveMessage",
+			"RemovePermission",
+			"SendMessage",
+			"SetQueueAttributes",
+			"StartMessageMoveTask",
+			"TagQueue",
+			"UntagQueue",
+		},
+		arnFormats: []string{
+			"arn:${Partition}:sqs:${Region}:${Account}:${QueueName}",
+		},
+	},
+	"sts": {
+		actions: []string{
+			"AssumeRole",
+			"AssumeRoleWithSAML",
+			"AssumeRoleWithWebIdentity",
+			"AssumeRoot",
+			"DecodeAuthorizationMessage",
+			"GetAccessKeyInfo",
+			"GetCallerIdentity",
+			"GetFederationToken",
+			"GetServiceBearerToken",
+			"GetSessionToken",
+			"GetWebIdentityToken",
+			"SetContext",
+			"SetSourceIdentity",
+			"TagSession",
+		},
+		conditionKeys: []string{
+			"sts:AWSServiceName",
+			"sts:DurationSeconds",
+			"sts:ExternalId",
+			"sts:IdentityTokenAudience",
+			"sts:RequestContext",
+			"sts:RequestContextProviders",
+			"sts:RoleSessionName",
+			"sts:SigningAlgorithm",
+			"sts:SourceIdentity",
+			"sts:TaskPolicyArn",
+			"sts:TransitiveTagKeys",
+		},
+		arnFormats: []string{
+			"arn:${Partition}:sts::${Account}:self",
+		},
+	},
+}
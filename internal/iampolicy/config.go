@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// ValidationEnvVar is the environment variable configuring plan-time IAM policy validation.
+	ValidationEnvVar = "TF_AWS_IAM_POLICY_VALIDATION"
+)
+
+// ValidationConfig is the provider-level configuration of plan-time IAM policy validation.
+type ValidationConfig struct {
+	// Severity indicates the severity of the diagnostic
+	//
+	// Must be one of "error" or "warning". As with tag policy compliance, this is a
+	// higher level abstraction on the diagnostic severity types exposed by the plugin
+	// libraries, as it must be shared across both Plugin SDK V2 and Plugin Framework
+	// based resources.
+	Severity string
+}
+
+// Attribute is a top-level resource attribute containing an IAM policy document.
+type Attribute struct {
+	Name       string
+	PolicyType PolicyType
+}
+
+// ResourceAttributes maps Terraform resource type names to the attributes containing
+// IAM policy documents that are validated at plan time.
+// Top-level attributes of Plugin Framework based resources with the fwtypes.IAMPolicy type
+// are always validated, with PolicyTypeUnspecified unless listed here.
+var ResourceAttributes = map[string][]Attribute{
+	"aws_iam_group_policy": {
+		{Name: names.AttrPolicy, PolicyType: PolicyTypeIdentity},
+	},
+	"aws_iam_policy": {
+		{Name: names.AttrPolicy, PolicyType: PolicyTypeIdentity},
+	},
+	"aws_iam_role": {
+		{Name: "assume_role_policy", PolicyType: PolicyTypeResource},
+	},
+	"aws_iam_role_policy": {
+		{Name: names.AttrPolicy, PolicyType: PolicyTypeIdentity},
+	},
+	"aws_iam_user_policy": {
+		{Name: names.AttrPolicy, PolicyType: PolicyTypeIdentity},
+	},
+	"aws_kms_key": {
+		{Name: names.AttrPolicy, PolicyType: PolicyTypeResource},
+	},
+	"aws_kms_key_policy": {
+		{Name: names.AttrPolicy, PolicyType: PolicyTypeResource},
+	},
+	"aws_s3_bucket_policy": {
+		{Name: names.AttrPolicy, PolicyType: PolicyTypeResource},
+	},
+	"aws_secretsmanager_secret_policy": {
+		{Name: names.AttrPolicy, PolicyType: PolicyTypeResource},
+	},
+	"aws_sns_topic": {
+		{Name: names.AttrPolicy, PolicyType: PolicyTypeResource},
+	},
+	"aws_sns_topic_policy": {
+		{Name: names.AttrPolicy, PolicyType: PolicyTypeResource},
+	},
+	"aws_sqs_queue": {
+		{Name: names.AttrPolicy, PolicyType: PolicyTypeResource},
+	},
+	"aws_sqs_queue_policy": {
+		{Name: names.AttrPolicy, PolicyType: PolicyTypeResource},
+	},
+}
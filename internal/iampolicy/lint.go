@@ -0,0 +1,331 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// PolicyType is the type of an IAM policy document.
+type PolicyType int
+
+const (
+	// PolicyTypeUnspecified is used when the type of policy is not known.
+	// Checks specific to a type of policy are not run.
+	PolicyTypeUnspecified PolicyType = iota
+	// PolicyTypeIdentity is an identity-based policy attached to an IAM user, group or role.
+	PolicyTypeIdentity
+	// PolicyTypeResource is a resource-based policy, including role trust policies.
+	PolicyTypeResource
+)
+
+// Finding is a problem found in an IAM policy document.
+type Finding struct {
+	// Statement is the index of the statement containing the problem.
+	Statement int
+	// Sid is the statement's Sid, if any.
+	Sid     string
+	Message string
+}
+
+func (f Finding) String() string {
+	if f.Sid != "" {
+		return fmt.Sprintf("Statement[%d] (Sid %q): %s", f.Statement, f.Sid, f.Message)
+	}
+
+	return fmt.Sprintf("Statement[%d]: %s", f.Statement, f.Message)
+}
+
+// Lint checks an IAM policy document against the service catalog.
+// Actions, resource ARNs and condition keys for services not in the catalog are not checked.
+// An error is returned if the document is not a valid JSON object.
+func Lint(document string, policyType PolicyType) ([]Finding, error) {
+	decoder := json.NewDecoder(bytes.NewReader([]byte(document)))
+	decoder.UseNumber()
+
+	var doc map[string]any
+	if err := decoder.Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	var statements []any
+	switch v := doc["Statement"].(type) {
+	case []any:
+		statements = v
+	case map[string]any:
+		statements = []any{v}
+	}
+
+	var findings []Finding
+
+	for i, v := range statements {
+		statement, ok := v.(map[string]any)
+		if !ok {
+			findings = append(findings, Finding{Statement: i, Message: "statement is not a JSON object"})
+			continue
+		}
+
+		sid, _ := statement["Sid"].(string)
+		for _, message := range lintStatement(statement, policyType) {
+			findings = append(findings, Finding{Statement: i, Sid: sid, Message: message})
+		}
+	}
+
+	return findings, nil
+}
+
+func lintStatement(statement map[string]any, policyType PolicyType) []string {
+	var messages []string
+
+	if v, ok := statement["Effect"]; ok {
+		if effect, _ := v.(string); effect != "Allow" && effect != "Deny" {
+			messages = append(messages, fmt.Sprintf(`Effect %q must be "Allow" or "Deny"`, v))
+		}
+	}
+
+	for _, element := range []string{"Action", "NotAction"} {
+		for _, action := range stringOrStrings(statement[element]) {
+			if message := lintAction(action); message != "" {
+				messages = append(messages, fmt.Sprintf("%s %q %s", element, action, message))
+			}
+		}
+	}
+
+	for _, element := range []string{"Resource", "NotResource"} {
+		for _, resource := range stringOrStrings(statement[element]) {
+			if message := lintResource(resource); message != "" {
+				messages = append(messages, fmt.Sprintf("%s %q %s", element, resource, message))
+			}
+		}
+	}
+
+	if policyType == PolicyTypeIdentity {
+		for _, element := range []string{"Principal", "NotPrincipal"} {
+			if _, ok := statement[element]; ok {
+				messages = append(messages, element+" is not allowed in an identity-based policy")
+			}
+		}
+	}
+
+	if conditions, ok := statement["Condition"].(map[string]any); ok {
+		for _, operator := range slices.Sorted(maps.Keys(conditions)) {
+			if !validConditionOperator(operator) {
+				messages = append(messages, fmt.Sprintf("Condition operator %q is not valid", operator))
+			}
+
+			if keys, ok := conditions[operator].(map[string]any); ok {
+				for _, key := range slices.Sorted(maps.Keys(keys)) {
+					if message := lintConditionKey(key); message != "" {
+						messages = append(messages, fmt.Sprintf("Condition key %q %s", key, message))
+					}
+				}
+			}
+		}
+	}
+
+	return messages
+}
+
+// lintAction checks a single Action or NotAction value.
+func lintAction(action string) string {
+	if action == "*" {
+		return ""
+	}
+
+	prefix, name, ok := strings.Cut(action, ":")
+	if !ok || prefix == "" || name == "" {
+		return `is not in the form "service:action"`
+	}
+
+	prefix = strings.ToLower(prefix)
+	actions, ok := catalog().actions[prefix]
+	if !ok {
+		return ""
+	}
+
+	name = strings.ToLower(name)
+	if strings.ContainsAny(name, "*?") {
+		if !slices.ContainsFunc(actions, func(v string) bool {
+			return wildcardMatch(name, v)
+		}) {
+			return fmt.Sprintf("does not match any %s actions", prefix)
+		}
+
+		return ""
+	}
+
+	if !slices.Contains(actions, name) {
+		return fmt.Sprintf("is not a known %s action", prefix)
+	}
+
+	return ""
+}
+
+// lintResource checks a single Resource or NotResource value.
+func lintResource(resource string) string {
+	if !strings.HasPrefix(resource, "arn:") {
+		return ""
+	}
+
+	// Everything up to the first wildcard or policy variable must be matched literally.
+	literal := resource
+	if i := strings.IndexAny(literal, "*?"); i != -1 {
+		literal = literal[:i]
+	}
+	if i := strings.Index(literal, "${"); i != -1 {
+		literal = literal[:i]
+	}
+	prefixOnly := literal != resource
+
+	parts := strings.SplitN(literal, ":", 6)
+	if len(parts) < 6 && !prefixOnly {
+		return "is not a valid ARN"
+	}
+	if len(parts) < 4 {
+		return ""
+	}
+
+	formats, ok := catalog().arnFormats[parts[2]]
+	if !ok {
+		return ""
+	}
+
+	if !slices.ContainsFunc(formats, func(tokens []arnToken) bool {
+		return matchARNFormat(tokens, literal, prefixOnly)
+	}) {
+		return fmt.Sprintf("does not match any %s resource ARN format", parts[2])
+	}
+
+	return ""
+}
+
+var conditionOperators = []string{
+	"arnequals",
+	"arnlike",
+	"arnnotequals",
+	"arnnotlike",
+	"binaryequals",
+	"bool",
+	"dateequals",
+	"dategreaterthan",
+	"dategreaterthanequals",
+	"datelessthan",
+	"datelessthanequals",
+	"datenotequals",
+	"ipaddress",
+	"notipaddress",
+	"numericequals",
+	"numericgreaterthan",
+	"numericgreaterthanequals",
+	"numericlessthan",
+	"numericlessthanequals",
+	"numericnotequals",
+	"stringequals",
+	"stringequalsignorecase",
+	"stringlike",
+	"stringnotequals",
+	"stringnotequalsignorecase",
+	"stringnotlike",
+}
+
+// validConditionOperator returns whether the condition operator, including any set operator prefix and IfExists suffix, is valid.
+func validConditionOperator(operator string) bool {
+	operator = strings.ToLower(operator)
+
+	for _, prefix := range []string{"forallvalues:", "foranyvalue:"} {
+		if v, ok := strings.CutPrefix(operator, prefix); ok {
+			operator = v
+			break
+		}
+	}
+
+	if operator == "null" {
+		return true
+	}
+
+	operator = strings.TrimSuffix(operator, "ifexists")
+
+	return slices.Contains(conditionOperators, operator)
+}
+
+// lintConditionKey checks a single condition key.
+func lintConditionKey(key string) string {
+	prefix, _, ok := strings.Cut(key, ":")
+	if !ok {
+		return ""
+	}
+
+	prefix = strings.ToLower(prefix)
+	keys, ok := catalog().conditionKeys[prefix]
+	if !ok {
+		return ""
+	}
+
+	key = strings.ToLower(key)
+	if slices.ContainsFunc(keys, func(v string) bool {
+		// Keys such as "aws:ResourceTag/${TagKey}" match any suffix.
+		if before, _, ok := strings.Cut(v, "${"); ok {
+			return strings.HasPrefix(key, before) && len(key) > len(before)
+		}
+
+		return key == v
+	}) {
+		return ""
+	}
+
+	if prefix == "aws" {
+		return "is not a known global condition key"
+	}
+
+	return fmt.Sprintf("is not a known %s condition key", prefix)
+}
+
+func stringOrStrings(v any) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		var s []string
+		for _, v := range v {
+			if v, ok := v.(string); ok {
+				s = append(s, v)
+			}
+		}
+		return s
+	}
+
+	return nil
+}
+
+// wildcardMatch returns whether s matches pattern, where '*' matches any sequence of characters and '?' any single character.
+func wildcardMatch(pattern, s string) bool {
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case '*':
+			for i := 0; i <= len(s); i++ {
+				if wildcardMatch(pattern[1:], s[i:]) {
+					return true
+				}
+			}
+			return false
+		case '?':
+			if len(s) == 0 {
+				return false
+			}
+		default:
+			if len(s) == 0 || s[0] != pattern[0] {
+				return false
+			}
+		}
+
+		pattern, s = pattern[1:], s[1:]
+	}
+
+	return len(s) == 0
+}
@@ -0,0 +1,145 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package iampolicy_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+)
+
+func TestLint(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		document      string
+		policyType    iampolicy.PolicyType
+		expectedError bool
+		expected      []string
+	}
+	tests := map[string]testCase{
+		"invalid JSON": {
+			document:      `{"Statement":`,
+			expectedError: true,
+		},
+		"valid": {
+			document: `{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Sid": "Read",
+    "Effect": "Allow",
+    "Action": ["s3:GetObject", "s3:list*", "sqs:*", "ec2:DescribeInstances"],
+    "Resource": [
+      "arn:aws:s3:::my-bucket",
+      "arn:aws:s3:::my-bucket/*",
+      "arn:aws:s3:us-west-2:123456789012:accesspoint/my-access-point",
+      "arn:aws:sqs:*:*:*",
+      "arn:aws:kms:*:123456789012:*",
+      "arn:aws:s3:::${aws:username}/*",
+      "arn:aws:ec2:us-west-2:123456789012:whatever",
+      "*"
+    ],
+    "Condition": {
+      "StringLike": {"s3:prefix": ["home/"]},
+      "ForAnyValue:StringEqualsIfExists": {"aws:PrincipalTag/team": "a"},
+      "Null": {"aws:SourceVpce": "false"},
+      "StringEquals": {"token.actions.githubusercontent.com:sub": "repo:example/*"}
+    }
+  }]
+}`,
+			policyType: iampolicy.PolicyTypeIdentity,
+		},
+		"single statement": {
+			document:   `{"Statement": {"Effect": "Allow", "Action": "s3:GetObjects", "Resource": "*"}}`,
+			policyType: iampolicy.PolicyTypeIdentity,
+			expected: []string{
+				`Statement[0]: Action "s3:GetObjects" is not a known s3 action`,
+			},
+		},
+		"invalid actions": {
+			document: `{"Statement": [{
+  "Sid": "Bad",
+  "Effect": "Permit",
+  "Action": ["s3GetObject", "kms:Decript"],
+  "NotAction": "sns:Pubx*",
+  "Resource": "*"
+}]}`,
+			expected: []string{
+				`Statement[0] (Sid "Bad"): Effect "Permit" must be "Allow" or "Deny"`,
+				`Statement[0] (Sid "Bad"): Action "s3GetObject" is not in the form "service:action"`,
+				`Statement[0] (Sid "Bad"): Action "kms:Decript" is not a known kms action`,
+				`Statement[0] (Sid "Bad"): NotAction "sns:Pubx*" does not match any sns actions`,
+			},
+		},
+		"invalid resources": {
+			document: `{"Statement": [{"Effect": "Allow", "Action": "*", "Resource": [
+  "arn:aws:s3:us-east-1:123456789012:my-bucket",
+  "arn:aws:s3:us-east-1:123456789012:my-bucket/*",
+  "arn:aws:sqs:us-east-1",
+  "arn:aws:kms:us-east-1:123456789012:key/1234"
+]}]}`,
+			expected: []string{
+				`Statement[0]: Resource "arn:aws:s3:us-east-1:123456789012:my-bucket" does not match any s3 resource ARN format`,
+				`Statement[0]: Resource "arn:aws:s3:us-east-1:123456789012:my-bucket/*" does not match any s3 resource ARN format`,
+				`Statement[0]: Resource "arn:aws:sqs:us-east-1" is not a valid ARN`,
+			},
+		},
+		"invalid conditions": {
+			document: `{"Statement": [{"Effect": "Deny", "Action": "*", "Resource": "*", "Condition": {
+  "StringEqual": {"aws:SourceVpc": "vpc-12345678"},
+  "Bool": {"aws:SecureTransports": "false", "s3:prefixes": "x", "sqs:Anything": "x"}
+}}]}`,
+			expected: []string{
+				`Statement[0]: Condition key "aws:SecureTransports" is not a known global condition key`,
+				`Statement[0]: Condition key "s3:prefixes" is not a known s3 condition key`,
+				`Statement[0]: Condition operator "StringEqual" is not valid`,
+			},
+		},
+		"global condition keys": {
+			document: `{"Statement": [{"Effect": "Allow", "Principal": {"Service": "sns.amazonaws.com"}, "Action": "sqs:SendMessage", "Resource": "*", "Condition": {
+  "StringEquals": {"aws:SourceOwner": "123456789012", "aws:SourceAccount": "123456789012"},
+  "ArnEquals": {"aws:SourceInstanceARN": "arn:aws:ec2:us-west-2:123456789012:instance/i-1234567890abcdef0"}
+}}]}`,
+			policyType: iampolicy.PolicyTypeResource,
+		},
+		"service not in catalog": {
+			document: `{"Statement": [{"Effect": "Allow", "Action": "ec2:DescribeInstancez", "Resource": "arn:aws:ec2:us-west-2:123456789012:not-a-resource", "Condition": {
+  "StringEquals": {"ec2:NotAConditionKey": "x"}
+}}]}`,
+		},
+		"principal identity policy": {
+			document:   `{"Statement": [{"Effect": "Allow", "Principal": {"AWS": "*"}, "Action": "sts:AssumeRole"}]}`,
+			policyType: iampolicy.PolicyTypeIdentity,
+			expected: []string{
+				`Statement[0]: Principal is not allowed in an identity-based policy`,
+			},
+		},
+		"principal resource policy": {
+			document:   `{"Statement": [{"Effect": "Allow", "Principal": {"AWS": "*"}, "Action": "sts:AssumeRole"}]}`,
+			policyType: iampolicy.PolicyTypeResource,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			findings, err := iampolicy.Lint(test.document, test.policyType)
+
+			if got, want := err != nil, test.expectedError; got != want {
+				t.Fatalf("Lint() err %t, want %t: %v", got, want, err)
+			}
+
+			var got []string
+			for _, v := range findings {
+				got = append(got, v.String())
+			}
+
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package io_test
+
+import (
+	"testing"
+
+	tfio "github.com/hashicorp/terraform-provider-aws/internal/io"
+)
+
+func TestMatchPattern(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		pattern  string
+		name     string
+		expected bool
+	}
+	tests := map[string]testCase{
+		"base name": {
+			pattern:  "*.html",
+			name:     "docs/index.html",
+			expected: true,
+		},
+		"base name no match": {
+			pattern:  "*.css",
+			name:     "docs/index.html",
+			expected: false,
+		},
+		"path": {
+			pattern:  "docs/*.html",
+			name:     "docs/index.html",
+			expected: true,
+		},
+		"path too deep": {
+			pattern:  "docs/*.html",
+			name:     "docs/v1/index.html",
+			expected: false,
+		},
+		"double star": {
+			pattern:  "assets/**/*.js",
+			name:     "assets/js/vendor/app.js",
+			expected: true,
+		},
+		"double star zero directories": {
+			pattern:  "assets/**/*.js",
+			name:     "assets/app.js",
+			expected: true,
+		},
+		"leading double star": {
+			pattern:  "**/.git/**",
+			name:     "vendor/lib/.git/HEAD",
+			expected: true,
+		},
+		"double star no match": {
+			pattern:  "assets/**/*.js",
+			name:     "static/app.js",
+			expected: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tfio.MatchPattern(test.pattern, test.name); got != test.expected {
+				t.Errorf("got %t, expected %t", got, test.expected)
+			}
+		})
+	}
+}
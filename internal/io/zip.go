@@ -0,0 +1,207 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package io
+
+import (
+	"archive/zip"
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	homedir "github.com/mitchellh/go-homedir"
+)
+
+var (
+	// zipModTime is the modification time recorded for every archive entry, the earliest time representable in a ZIP archive.
+	zipModTime = time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)
+)
+
+const (
+	zipFileMode       fs.FileMode = 0o644
+	zipExecutableMode fs.FileMode = 0o755
+)
+
+// ZipDirectory returns a reproducible ZIP archive of the regular files below the specified directory.
+// Files whose slash-separated path relative to the directory matches any of the exclude patterns are omitted.
+// See MatchPattern for the pattern syntax.
+func ZipDirectory(dir string, excludes []string) ([]byte, error) {
+	dir, names, err := directoryFiles(dir, excludes)
+	if err != nil {
+		return nil, err
+	}
+
+	return writeZip(dir, names)
+}
+
+// HashDirectory returns the SHA-256 hash of the files that ZipDirectory archives.
+// See hashFiles for what the hash covers.
+func HashDirectory(dir string, excludes []string) ([]byte, error) {
+	dir, names, err := directoryFiles(dir, excludes)
+	if err != nil {
+		return nil, err
+	}
+
+	return hashFiles(dir, names)
+}
+
+// ZipFile returns a reproducible ZIP archive containing the specified file at the root of the archive.
+func ZipFile(path string) ([]byte, error) {
+	path, err := homedir.Expand(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return writeZip(filepath.Dir(path), []string{filepath.Base(path)})
+}
+
+// HashFile returns the SHA-256 hash of the file that ZipFile archives.
+// See hashFiles for what the hash covers.
+func HashFile(path string) ([]byte, error) {
+	path, err := homedir.Expand(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return hashFiles(filepath.Dir(path), []string{filepath.Base(path)})
+}
+
+// directoryFiles returns the expanded directory and the slash-separated paths, relative to it, of the regular files below it
+// that do not match any of the exclude patterns.
+func directoryFiles(dir string, excludes []string) (string, []string, error) {
+	dir, err := homedir.Expand(dir)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var names []string
+	err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		if slices.ContainsFunc(excludes, func(pattern string) bool { return MatchPattern(pattern, rel) }) {
+			return nil
+		}
+
+		names = append(names, rel)
+
+		return nil
+	})
+
+	if err != nil {
+		return "", nil, err
+	}
+
+	if len(names) == 0 {
+		return "", nil, fmt.Errorf("no files found in %s", dir)
+	}
+
+	return dir, names, nil
+}
+
+// hashFiles returns the SHA-256 hash of the names, archived modes and contents of the named files, relative to dir, in name order.
+// Unlike a hash of the archive itself, it does not depend on how the archive's entries are compressed.
+func hashFiles(dir string, names []string) ([]byte, error) {
+	slices.Sort(names)
+
+	h := sha256.New()
+
+	for _, name := range names {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+
+		fi, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		// Variable-length fields are length-prefixed so that one entry cannot run into the next.
+		fmt.Fprintf(h, "%d:%s %o %d:", len(name), name, zipMode(fi), len(content))
+		h.Write(content)
+	}
+
+	return h.Sum(nil), nil
+}
+
+// writeZip writes the named files, relative to dir, to a ZIP archive.
+// Entries are sorted by name and have a fixed modification time and normalized permissions
+// so that the archive's content depends only on the files' names, content and executable bits.
+func writeZip(dir string, names []string) ([]byte, error) {
+	slices.Sort(names)
+
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+
+	for _, name := range names {
+		if err := writeZipEntry(w, dir, name); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func writeZipEntry(w *zip.Writer, dir, name string) error {
+	f, err := os.Open(filepath.Join(dir, filepath.FromSlash(name)))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+
+	header := &zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: zipModTime,
+	}
+	header.SetMode(zipMode(fi))
+
+	ew, err := w.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(ew, f); err != nil {
+		return fmt.Errorf("adding %s to archive: %w", name, err)
+	}
+
+	return nil
+}
+
+// zipMode returns the mode recorded in the archive for the file, which only preserves whether it is executable.
+func zipMode(fi fs.FileInfo) fs.FileMode {
+	if fi.Mode().Perm()&0o111 != 0 {
+		return zipExecutableMode
+	}
+
+	return zipFileMode
+}
@@ -0,0 +1,204 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package io_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	tfio "github.com/hashicorp/terraform-provider-aws/internal/io"
+)
+
+func TestZipDirectory(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "index.js"), "exports.handler = () => {}", 0o600)
+	writeTestFile(t, filepath.Join(dir, "bin", "tool"), "#!/bin/sh", 0o700)
+	writeTestFile(t, filepath.Join(dir, "lib", "util.js"), "module.exports = {}", 0o644)
+	writeTestFile(t, filepath.Join(dir, "lib", ".DS_Store"), "", 0o644)
+	writeTestFile(t, filepath.Join(dir, "test", "index_test.js"), "", 0o644)
+
+	got, err := tfio.ZipDirectory(dir, []string{".DS_Store", "test/**"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(got), int64(len(got)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type entry struct {
+		Name string
+		Mode os.FileMode
+	}
+	var entries []entry
+	for _, f := range r.File {
+		entries = append(entries, entry{Name: f.Name, Mode: f.Mode()})
+		if !f.Modified.Equal(time.Date(1980, time.January, 1, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("%s: unexpected modification time %s", f.Name, f.Modified)
+		}
+	}
+
+	want := []entry{
+		{Name: "bin/tool", Mode: 0o755},
+		{Name: "index.js", Mode: 0o644},
+		{Name: "lib/util.js", Mode: 0o644},
+	}
+	if diff := cmp.Diff(entries, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	// Touching the files must not change the archive.
+	later := time.Now().Add(time.Hour)
+	if err := os.Chtimes(filepath.Join(dir, "index.js"), later, later); err != nil {
+		t.Fatal(err)
+	}
+
+	again, err := tfio.ZipDirectory(dir, []string{".DS_Store", "test/**"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(got, again) {
+		t.Error("archive is not reproducible")
+	}
+}
+
+func TestZipDirectory_empty(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "README.md"), "", 0o644)
+
+	if _, err := tfio.ZipDirectory(dir, []string{"*.md"}); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestZipFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "src", "main.py")
+	writeTestFile(t, path, "def handler(event, context): pass", 0o644)
+
+	got, err := tfio.ZipFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(got), int64(len(got)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(r.File) != 1 || r.File[0].Name != "main.py" {
+		t.Errorf("unexpected entries: %v", r.File)
+	}
+}
+
+func TestHashDirectory(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "index.js"), "exports.handler = () => {}", 0o644)
+	writeTestFile(t, filepath.Join(dir, "lib", "util.js"), "module.exports = {}", 0o644)
+	writeTestFile(t, filepath.Join(dir, "test", "index_test.js"), "", 0o644)
+
+	hash := func(t *testing.T) []byte {
+		t.Helper()
+
+		got, err := tfio.HashDirectory(dir, []string{"test/**"})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return got
+	}
+
+	initial := hash(t)
+
+	// Touching or changing excluded files must not change the hash.
+	later := time.Now().Add(time.Hour)
+	if err := os.Chtimes(filepath.Join(dir, "index.js"), later, later); err != nil {
+		t.Fatal(err)
+	}
+	writeTestFile(t, filepath.Join(dir, "test", "index_test.js"), "test", 0o644)
+
+	if got := hash(t); !bytes.Equal(got, initial) {
+		t.Error("hash changed for unchanged files")
+	}
+
+	for name, change := range map[string]func(t *testing.T){
+		"content": func(t *testing.T) {
+			writeTestFile(t, filepath.Join(dir, "index.js"), "exports.handler = async () => {}", 0o644)
+		},
+		"mode": func(t *testing.T) {
+			writeTestFile(t, filepath.Join(dir, "index.js"), "exports.handler = async () => {}", 0o755)
+		},
+		"name": func(t *testing.T) {
+			if err := os.Rename(filepath.Join(dir, "lib", "util.js"), filepath.Join(dir, "lib", "utils.js")); err != nil {
+				t.Fatal(err)
+			}
+		},
+	} {
+		before := hash(t)
+		change(t)
+
+		if got := hash(t); bytes.Equal(got, before) {
+			t.Errorf("%s: hash unchanged", name)
+		}
+	}
+}
+
+func TestHashFile(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	writeTestFile(t, filepath.Join(dir, "a", "main.py"), "def handler(event, context): pass", 0o644)
+	writeTestFile(t, filepath.Join(dir, "b", "main.py"), "def handler(event, context): pass", 0o644)
+	writeTestFile(t, filepath.Join(dir, "b", "other.py"), "def handler(event, context): pass", 0o644)
+
+	a, err := tfio.HashFile(filepath.Join(dir, "a", "main.py"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := tfio.HashFile(filepath.Join(dir, "b", "main.py"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := tfio.HashFile(filepath.Join(dir, "b", "other.py"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the file's name, not its directory, is archived.
+	if !bytes.Equal(a, b) {
+		t.Error("hash depends on the file's directory")
+	}
+	if bytes.Equal(b, other) {
+		t.Error("hash does not depend on the file's name")
+	}
+}
+
+func writeTestFile(t *testing.T, path, content string, mode os.FileMode) {
+	t.Helper()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), mode); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chmod(path, mode); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,321 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	aschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	erschema "github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	lschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/datasourceattribute"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/listresourceattribute"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/resourceattribute"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// overrideAssumeRole returns the value of the top-level `assume_role` block.
+// nil is returned if the block is not set or its role ARN is not yet known.
+func overrideAssumeRole(ctx context.Context, getAttribute getAttributeFunc) (*conns.AssumeRole, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var target fwtypes.ListNestedObjectValueOf[framework.AssumeRoleModel]
+	diags.Append(getAttribute(ctx, path.Root(names.TopLevelAssumeRoleAttribute), &target)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if target.IsNull() || target.IsUnknown() {
+		return nil, diags
+	}
+
+	data, d := target.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if data == nil || data.RoleARN.ValueString() == "" {
+		return nil, diags
+	}
+
+	return &conns.AssumeRole{
+		ExternalID:  data.ExternalID.ValueString(),
+		RoleARN:     data.RoleARN.ValueString(),
+		SessionName: data.SessionName.ValueString(),
+	}, diags
+}
+
+type dataSourceInjectAssumeRoleAttributeInterceptor struct{}
+
+func (r dataSourceInjectAssumeRoleAttributeInterceptor) schema(ctx context.Context, opts interceptorOptions[datasource.SchemaRequest, datasource.SchemaResponse]) {
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		if _, ok := response.Schema.Blocks[names.TopLevelAssumeRoleAttribute]; !ok {
+			// Inject a top-level "assume_role" block.
+			if response.Schema.Blocks == nil {
+				response.Schema.Blocks = make(map[string]dsschema.Block)
+			}
+			response.Schema.Blocks[names.TopLevelAssumeRoleAttribute] = datasourceattribute.AssumeRole()
+		}
+	}
+}
+
+// dataSourceInjectAssumeRoleAttribute injects a top-level "assume_role" block into a data source's schema.
+func dataSourceInjectAssumeRoleAttribute() dataSourceSchemaInterceptor {
+	return &dataSourceInjectAssumeRoleAttributeInterceptor{}
+}
+
+type ephemeralResourceInjectAssumeRoleAttributeInterceptor struct{}
+
+func (r ephemeralResourceInjectAssumeRoleAttributeInterceptor) schema(ctx context.Context, opts interceptorOptions[ephemeral.SchemaRequest, ephemeral.SchemaResponse]) {
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		if _, ok := response.Schema.Blocks[names.TopLevelAssumeRoleAttribute]; !ok {
+			// Inject a top-level "assume_role" block.
+			if response.Schema.Blocks == nil {
+				response.Schema.Blocks = make(map[string]erschema.Block)
+			}
+			response.Schema.Blocks[names.TopLevelAssumeRoleAttribute] = erschema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[framework.AssumeRoleModel](ctx),
+				Description: names.TopLevelAssumeRoleAttributeDescription,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: erschema.NestedBlockObject{
+					Attributes: map[string]erschema.Attribute{
+						names.AttrExternalID: erschema.StringAttribute{
+							Optional:    true,
+							Description: names.TopLevelAssumeRoleExternalIDAttributeDescription,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(2, 1224),
+							},
+						},
+						names.AttrRoleARN: erschema.StringAttribute{
+							Required:    true,
+							Description: names.TopLevelAssumeRoleRoleARNAttributeDescription,
+							Validators: []validator.String{
+								fwvalidators.ARN(),
+							},
+						},
+						names.TopLevelAssumeRoleSessionNameAttribute: erschema.StringAttribute{
+							Optional:    true,
+							Description: names.TopLevelAssumeRoleSessionNameAttributeDescription,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(2, 64),
+							},
+						},
+					},
+				},
+			}
+		}
+	}
+}
+
+// ephemeralResourceInjectAssumeRoleAttribute injects a top-level "assume_role" block into an ephemeral resource's schema.
+func ephemeralResourceInjectAssumeRoleAttribute() ephemeralResourceSchemaInterceptor {
+	return &ephemeralResourceInjectAssumeRoleAttributeInterceptor{}
+}
+
+type resourceInjectAssumeRoleAttributeInterceptor struct{}
+
+func (r resourceInjectAssumeRoleAttributeInterceptor) schema(ctx context.Context, opts interceptorOptions[resource.SchemaRequest, resource.SchemaResponse]) {
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		if _, ok := response.Schema.Blocks[names.TopLevelAssumeRoleAttribute]; !ok {
+			// Inject a top-level "assume_role" block.
+			if response.Schema.Blocks == nil {
+				response.Schema.Blocks = make(map[string]rschema.Block)
+			}
+			response.Schema.Blocks[names.TopLevelAssumeRoleAttribute] = resourceattribute.AssumeRole()
+		}
+	}
+}
+
+// resourceInjectAssumeRoleAttribute injects a top-level "assume_role" block into a resource's schema.
+func resourceInjectAssumeRoleAttribute() resourceSchemaInterceptor {
+	return &resourceInjectAssumeRoleAttributeInterceptor{}
+}
+
+type resourceForceNewIfAssumeRoleAccountChangesInterceptor struct{}
+
+func (r resourceForceNewIfAssumeRoleAccountChangesInterceptor) modifyPlan(ctx context.Context, opts interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]) {
+	c := opts.c
+
+	switch request, response, when := opts.request, opts.response, opts.when; when {
+	case Before:
+		// If the entire plan is null, the resource is planned for destruction.
+		if request.Plan.Raw.IsNull() {
+			return
+		}
+
+		// If the entire state is null, the resource is new.
+		if request.State.Raw.IsNull() {
+			return
+		}
+
+		planRoleARN, diags := assumeRoleARN(ctx, request.Plan.GetAttribute)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		stateRoleARN, diags := assumeRoleARN(ctx, request.State.GetAttribute)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if planRoleARN.Equal(stateRoleARN) {
+			return
+		}
+
+		if !planRoleARN.IsUnknown() {
+			providerAccountID := c.ProviderAccountID(ctx)
+			if roleAccountID(planRoleARN.ValueString(), providerAccountID) == roleAccountID(stateRoleARN.ValueString(), providerAccountID) {
+				return
+			}
+		}
+
+		response.RequiresReplace = append(response.RequiresReplace, path.Root(names.TopLevelAssumeRoleAttribute))
+	}
+}
+
+// resourceForceNewIfAssumeRoleAccountChanges forces resource replacement if a change to the top-level `assume_role` block
+// moves the resource to a different AWS account.
+// The account of a resource without an IAM role override is the provider's configured account.
+func resourceForceNewIfAssumeRoleAccountChanges() resourceModifyPlanInterceptor {
+	return &resourceForceNewIfAssumeRoleAccountChangesInterceptor{}
+}
+
+// assumeRoleARN returns the role ARN of the top-level `assume_role` block.
+// A null value is returned if the block is not set.
+func assumeRoleARN(ctx context.Context, getAttribute getAttributeFunc) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var target fwtypes.ListNestedObjectValueOf[framework.AssumeRoleModel]
+	diags.Append(getAttribute(ctx, path.Root(names.TopLevelAssumeRoleAttribute), &target)...)
+	if diags.HasError() {
+		return types.StringNull(), diags
+	}
+
+	if target.IsUnknown() {
+		return types.StringUnknown(), diags
+	}
+
+	data, d := target.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return types.StringNull(), diags
+	}
+
+	if data == nil {
+		return types.StringNull(), diags
+	}
+
+	return data.RoleARN, diags
+}
+
+// roleAccountID returns the ID of the AWS account owning the specified IAM role.
+// The default account ID is returned if no role is specified.
+func roleAccountID(roleARN, defaultAccountID string) string {
+	if roleARN == "" {
+		return defaultAccountID
+	}
+
+	if v, err := arn.Parse(roleARN); err == nil {
+		return v.AccountID
+	}
+
+	return roleARN
+}
+
+type actionInjectAssumeRoleAttributeInterceptor struct{}
+
+func (a actionInjectAssumeRoleAttributeInterceptor) schema(ctx context.Context, opts interceptorOptions[action.SchemaRequest, action.SchemaResponse]) {
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		if _, exists := response.Schema.Blocks[names.TopLevelAssumeRoleAttribute]; !exists {
+			// Inject a top-level "assume_role" block.
+			if response.Schema.Blocks == nil {
+				response.Schema.Blocks = make(map[string]aschema.Block)
+			}
+			response.Schema.Blocks[names.TopLevelAssumeRoleAttribute] = aschema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[framework.AssumeRoleModel](ctx),
+				Description: names.TopLevelAssumeRoleAttributeDescription,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: aschema.NestedBlockObject{
+					Attributes: map[string]aschema.Attribute{
+						names.AttrExternalID: aschema.StringAttribute{
+							Optional:    true,
+							Description: names.TopLevelAssumeRoleExternalIDAttributeDescription,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(2, 1224),
+							},
+						},
+						names.AttrRoleARN: aschema.StringAttribute{
+							Required:    true,
+							Description: names.TopLevelAssumeRoleRoleARNAttributeDescription,
+							Validators: []validator.String{
+								fwvalidators.ARN(),
+							},
+						},
+						names.TopLevelAssumeRoleSessionNameAttribute: aschema.StringAttribute{
+							Optional:    true,
+							Description: names.TopLevelAssumeRoleSessionNameAttributeDescription,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(2, 64),
+							},
+						},
+					},
+				},
+			}
+		}
+	}
+}
+
+// actionInjectAssumeRoleAttribute injects a top-level "assume_role" block into an action's schema.
+func actionInjectAssumeRoleAttribute() actionSchemaInterceptor {
+	return &actionInjectAssumeRoleAttributeInterceptor{}
+}
+
+type listResourceInjectAssumeRoleAttributeInterceptor struct{}
+
+func (r listResourceInjectAssumeRoleAttributeInterceptor) schema(ctx context.Context, opts interceptorOptions[list.ListResourceSchemaRequest, list.ListResourceSchemaResponse]) {
+	switch response, when := opts.response, opts.when; when {
+	case After:
+		if _, ok := response.Schema.Blocks[names.TopLevelAssumeRoleAttribute]; !ok {
+			// Inject a top-level "assume_role" block.
+			if response.Schema.Blocks == nil {
+				response.Schema.Blocks = make(map[string]lschema.Block)
+			}
+			response.Schema.Blocks[names.TopLevelAssumeRoleAttribute] = listresourceattribute.AssumeRole()
+		}
+	}
+}
+
+// listResourceInjectAssumeRoleAttribute injects a top-level "assume_role" block into a resource's List schema.
+func listResourceInjectAssumeRoleAttribute() listResourceSchemaInterceptor {
+	return &listResourceInjectAssumeRoleAttributeInterceptor{}
+}
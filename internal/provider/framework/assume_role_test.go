@@ -0,0 +1,142 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/resourceattribute"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestResourceForceNewIfAssumeRoleAccountChangesInterceptor_ModifyPlan(t *testing.T) {
+	t.Parallel()
+
+	const (
+		providerAccountID = "111111111111"
+		roleARN1          = "arn:aws:iam::111111111111:role/one"
+		roleARN2          = "arn:aws:iam::111111111111:role/two"
+		roleARN3          = "arn:aws:iam::222222222222:role/three"
+	)
+
+	ctx := context.Background()
+	client := mockClient{accountID: providerAccountID}
+	icpt := resourceForceNewIfAssumeRoleAccountChangesInterceptor{}
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrName: schema.StringAttribute{Required: true},
+		},
+		Blocks: map[string]schema.Block{
+			names.TopLevelAssumeRoleAttribute: resourceattribute.AssumeRole(),
+		},
+	}
+
+	tests := map[string]struct {
+		state         tftypes.Value
+		plan          tftypes.Value
+		expectReplace bool
+	}{
+		"create": {
+			state: tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+			plan:  assumeRoleObjectValue(ctx, s, tftypes.NewValue(tftypes.String, roleARN3)),
+		},
+		"destroy": {
+			state: assumeRoleObjectValue(ctx, s, tftypes.NewValue(tftypes.String, roleARN1)),
+			plan:  tftypes.NewValue(s.Type().TerraformType(ctx), nil),
+		},
+		"unchanged": {
+			state: assumeRoleObjectValue(ctx, s, tftypes.NewValue(tftypes.String, roleARN1)),
+			plan:  assumeRoleObjectValue(ctx, s, tftypes.NewValue(tftypes.String, roleARN1)),
+		},
+		"not set": {
+			state: assumeRoleObjectValue(ctx, s, tftypes.Value{}),
+			plan:  assumeRoleObjectValue(ctx, s, tftypes.Value{}),
+		},
+		"role changed in same account": {
+			state: assumeRoleObjectValue(ctx, s, tftypes.NewValue(tftypes.String, roleARN1)),
+			plan:  assumeRoleObjectValue(ctx, s, tftypes.NewValue(tftypes.String, roleARN2)),
+		},
+		"role changed to other account": {
+			state:         assumeRoleObjectValue(ctx, s, tftypes.NewValue(tftypes.String, roleARN1)),
+			plan:          assumeRoleObjectValue(ctx, s, tftypes.NewValue(tftypes.String, roleARN3)),
+			expectReplace: true,
+		},
+		"added in provider account": {
+			state: assumeRoleObjectValue(ctx, s, tftypes.Value{}),
+			plan:  assumeRoleObjectValue(ctx, s, tftypes.NewValue(tftypes.String, roleARN1)),
+		},
+		"added in other account": {
+			state:         assumeRoleObjectValue(ctx, s, tftypes.Value{}),
+			plan:          assumeRoleObjectValue(ctx, s, tftypes.NewValue(tftypes.String, roleARN3)),
+			expectReplace: true,
+		},
+		"removed from other account": {
+			state:         assumeRoleObjectValue(ctx, s, tftypes.NewValue(tftypes.String, roleARN3)),
+			plan:          assumeRoleObjectValue(ctx, s, tftypes.Value{}),
+			expectReplace: true,
+		},
+		"role unknown": {
+			state:         assumeRoleObjectValue(ctx, s, tftypes.NewValue(tftypes.String, roleARN1)),
+			plan:          assumeRoleObjectValue(ctx, s, tftypes.NewValue(tftypes.String, tftypes.UnknownValue)),
+			expectReplace: true,
+		},
+	}
+
+	for tn, tc := range tests {
+		t.Run(tn, func(t *testing.T) {
+			t.Parallel()
+
+			req := resource.ModifyPlanRequest{
+				Plan:  tfsdk.Plan{Raw: tc.plan, Schema: s},
+				State: tfsdk.State{Raw: tc.state, Schema: s},
+			}
+			resp := resource.ModifyPlanResponse{
+				Plan: req.Plan,
+			}
+
+			icpt.modifyPlan(ctx, interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]{
+				c:        client,
+				request:  &req,
+				response: &resp,
+				when:     Before,
+			})
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diags: %s", resp.Diagnostics)
+			}
+
+			if got, want := resp.RequiresReplace.Contains(path.Root(names.TopLevelAssumeRoleAttribute)), tc.expectReplace; got != want {
+				t.Errorf("RequiresReplace: got %t, expected %t", got, want)
+			}
+		})
+	}
+}
+
+// assumeRoleObjectValue returns a resource object value with the specified `assume_role` role ARN.
+// The `assume_role` block is empty if roleARN is the zero value.
+func assumeRoleObjectValue(ctx context.Context, s schema.Schema, roleARN tftypes.Value) tftypes.Value {
+	objectType := s.Type().TerraformType(ctx).(tftypes.Object)
+	listType := objectType.AttributeTypes[names.TopLevelAssumeRoleAttribute].(tftypes.List)
+	elemType := listType.ElementType.(tftypes.Object)
+
+	var elems []tftypes.Value
+	if roleARN.Type() != nil {
+		elems = append(elems, tftypes.NewValue(elemType, map[string]tftypes.Value{
+			names.AttrExternalID:                         tftypes.NewValue(tftypes.String, nil),
+			names.AttrRoleARN:                            roleARN,
+			names.TopLevelAssumeRoleSessionNameAttribute: tftypes.NewValue(tftypes.String, nil),
+		}))
+	}
+
+	return tftypes.NewValue(objectType, map[string]tftypes.Value{
+		names.AttrName:                    tftypes.NewValue(tftypes.String, "example"),
+		names.TopLevelAssumeRoleAttribute: tftypes.NewValue(listType, elems),
+	})
+}
@@ -0,0 +1,134 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/guardrails"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type resourceValidateGuardrailsInterceptor struct {
+	resourceNoOpCRUDInterceptor
+}
+
+func (r resourceValidateGuardrailsInterceptor) modifyPlan(ctx context.Context, opts interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]) {
+	c := opts.c
+
+	config := c.GuardrailsConfig(ctx)
+	if config == nil {
+		return
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return
+	}
+	typeName := inContext.TypeName()
+
+	switch request, response, when := opts.request, opts.response, opts.when; when {
+	case Before:
+		// If the entire plan is null, the resource is planned for destruction.
+		if request.Plan.Raw.IsNull() {
+			response.Diagnostics.Append(checkDestroyGuardrails(config, typeName, request.State.Raw)...)
+			return
+		}
+
+		if request.State.Raw.IsNull() {
+			if v := config.CheckResourceType(typeName); v != nil {
+				response.Diagnostics.AddError(guardrails.Summary, v.Error())
+			}
+		}
+	case After:
+		// Replacement is known once the resource's ModifyPlan method has run.
+		if request.State.Raw.IsNull() || request.Plan.Raw.IsNull() || len(response.RequiresReplace) == 0 {
+			return
+		}
+
+		response.Diagnostics.Append(checkDestroyGuardrails(config, typeName, request.State.Raw)...)
+	}
+}
+
+func (r resourceValidateGuardrailsInterceptor) delete(ctx context.Context, opts interceptorOptions[resource.DeleteRequest, resource.DeleteResponse]) {
+	c := opts.c
+
+	config := c.GuardrailsConfig(ctx)
+	if config == nil {
+		return
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return
+	}
+	typeName := inContext.TypeName()
+
+	switch request, response, when := opts.request, opts.response, opts.when; when {
+	case Before:
+		if v := config.CheckDestroy(typeName, topLevelStringMap(request.State.Raw, names.AttrTagsAll)); v != nil {
+			response.Diagnostics.AddError(guardrails.Summary, v.Error())
+		}
+	}
+}
+
+// resourceValidateGuardrails enforces the guardrail policies.
+// Violations are reported at plan time, destroy protection is also enforced on Delete.
+func resourceValidateGuardrails() resourceModifyPlanInterceptor {
+	return &resourceValidateGuardrailsInterceptor{}
+}
+
+// checkDestroyGuardrails enforces the destroy protection and maximum deletions guardrails
+// for a resource, with the specified prior state, planned for destruction or replacement.
+func checkDestroyGuardrails(config *guardrails.Config, typeName string, state tftypes.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if v := config.CheckDestroy(typeName, topLevelStringMap(state, names.AttrTagsAll)); v != nil {
+		diags.AddError(guardrails.Summary, v.Error())
+		return diags
+	}
+
+	if v := config.CountDeletion(typeName); v != nil {
+		diags.AddError(guardrails.Summary, v.Error())
+	}
+
+	return diags
+}
+
+// topLevelStringMap returns the known values of the specified top-level map of strings attribute.
+func topLevelStringMap(v tftypes.Value, name string) map[string]string {
+	if v.IsNull() || !v.IsKnown() {
+		return nil
+	}
+
+	attr, _, err := tftypes.WalkAttributePath(v, tftypes.NewAttributePath().WithAttributeName(name))
+	if err != nil {
+		return nil
+	}
+
+	value, ok := attr.(tftypes.Value)
+	if !ok || value.IsNull() || !value.IsKnown() || !value.Type().Is(tftypes.Map{ElementType: tftypes.String}) {
+		return nil
+	}
+
+	var elems map[string]tftypes.Value
+	if err := value.As(&elems); err != nil {
+		return nil
+	}
+
+	m := make(map[string]string, len(elems))
+	for k, v := range elems {
+		var s string
+		if v.IsNull() || !v.IsKnown() || v.As(&s) != nil {
+			continue
+		}
+		m[k] = s
+	}
+
+	return m
+}
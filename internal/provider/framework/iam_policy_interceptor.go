@@ -0,0 +1,114 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+// resourceValidateIAMPolicies validates IAM policy documents against the IAM policy catalog.
+// Top-level attributes of type fwtypes.IAMPolicy and any attributes listed in iampolicy.ResourceAttributes are validated.
+func resourceValidateIAMPolicies() resourceModifyPlanInterceptor {
+	return &resourceValidateIAMPoliciesInterceptor{}
+}
+
+type resourceValidateIAMPoliciesInterceptor struct{}
+
+func (r resourceValidateIAMPoliciesInterceptor) modifyPlan(ctx context.Context, opts interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]) {
+	c := opts.c
+
+	config := c.IAMPolicyValidationConfig(ctx)
+	if config == nil {
+		return
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return
+	}
+	typeName := inContext.TypeName()
+
+	switch request, when := opts.request, opts.when; when {
+	case Before:
+		// If the entire plan is null, the resource is planned for destruction.
+		if request.Plan.Raw.IsNull() {
+			return
+		}
+
+		attributes := make(map[string]iampolicy.PolicyType)
+		for name, attribute := range request.Plan.Schema.GetAttributes() {
+			if attribute.GetType().Equal(fwtypes.IAMPolicyType) {
+				attributes[name] = iampolicy.PolicyTypeUnspecified
+			}
+		}
+		for _, v := range iampolicy.ResourceAttributes[typeName] {
+			attributes[v.Name] = v.PolicyType
+		}
+
+		for _, name := range slices.Sorted(maps.Keys(attributes)) {
+			planned, ok := topLevelString(request.Plan.Raw, name)
+			if !ok || planned == "" {
+				continue
+			}
+
+			if current, ok := topLevelString(request.State.Raw, name); ok && current == planned {
+				continue
+			}
+
+			findings, err := iampolicy.Lint(planned, attributes[name])
+			if err != nil {
+				// Invalid JSON is reported by the attribute's validation.
+				continue
+			}
+			if len(findings) == 0 {
+				continue
+			}
+
+			summary := "Invalid IAM Policy Document"
+			detail := strings.Join(tfslices.ApplyToAll(findings, iampolicy.Finding.String), "\n")
+
+			switch config.Severity {
+			case "warning":
+				opts.response.Diagnostics.AddAttributeWarning(path.Root(name), summary, detail)
+			default:
+				opts.response.Diagnostics.AddAttributeError(path.Root(name), summary, detail)
+			}
+		}
+	}
+}
+
+// topLevelString returns the known string value of the specified top-level attribute.
+func topLevelString(v tftypes.Value, name string) (string, bool) {
+	if v.IsNull() || !v.IsKnown() {
+		return "", false
+	}
+
+	attr, _, err := tftypes.WalkAttributePath(v, tftypes.NewAttributePath().WithAttributeName(name))
+	if err != nil {
+		return "", false
+	}
+
+	value, ok := attr.(tftypes.Value)
+	if !ok || value.IsNull() || !value.IsKnown() || !value.Type().Is(tftypes.String) {
+		return "", false
+	}
+
+	var s string
+	if err := value.As(&s); err != nil {
+		return "", false
+	}
+
+	return s, true
+}
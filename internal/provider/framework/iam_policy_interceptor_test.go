@@ -0,0 +1,154 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type mockIAMPolicyValidationClient struct {
+	mockClient
+	config *iampolicy.ValidationConfig
+}
+
+func (c mockIAMPolicyValidationClient) IAMPolicyValidationConfig(context.Context) *iampolicy.ValidationConfig {
+	return c.config
+}
+
+func TestResourceValidateIAMPoliciesInterceptor(t *testing.T) {
+	t.Parallel()
+
+	const (
+		validPolicy   = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`
+		invalidPolicy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObjects","Resource":"*"}]}`
+	)
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrName: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrPolicy: schema.StringAttribute{
+				CustomType: fwtypes.IAMPolicyType,
+				Optional:   true,
+			},
+		},
+	}
+
+	ctx := context.Background()
+	objectType := s.Type().TerraformType(ctx)
+	value := func(policy string) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			names.AttrName:   tftypes.NewValue(tftypes.String, "test"),
+			names.AttrPolicy: tftypes.NewValue(tftypes.String, policy),
+		})
+	}
+	null := tftypes.NewValue(objectType, nil)
+
+	testCases := map[string]struct {
+		config         *iampolicy.ValidationConfig
+		state          tftypes.Value
+		plan           tftypes.Value
+		expectErrors   int
+		expectWarnings int
+	}{
+		"disabled": {
+			state: null,
+			plan:  value(invalidPolicy),
+		},
+		"valid": {
+			config: &iampolicy.ValidationConfig{Severity: "error"},
+			state:  null,
+			plan:   value(validPolicy),
+		},
+		"invalid error": {
+			config:       &iampolicy.ValidationConfig{Severity: "error"},
+			state:        null,
+			plan:         value(invalidPolicy),
+			expectErrors: 1,
+		},
+		"invalid warning": {
+			config:         &iampolicy.ValidationConfig{Severity: "warning"},
+			state:          null,
+			plan:           value(invalidPolicy),
+			expectWarnings: 1,
+		},
+		"invalid unchanged": {
+			config: &iampolicy.ValidationConfig{Severity: "error"},
+			state:  value(invalidPolicy),
+			plan:   value(invalidPolicy),
+		},
+		"invalid changed": {
+			config:       &iampolicy.ValidationConfig{Severity: "error"},
+			state:        value(validPolicy),
+			plan:         value(invalidPolicy),
+			expectErrors: 1,
+		},
+		"invalid JSON": {
+			config: &iampolicy.ValidationConfig{Severity: "error"},
+			state:  null,
+			plan:   value(`{"Statement":`),
+		},
+		"unknown": {
+			config: &iampolicy.ValidationConfig{Severity: "error"},
+			state:  null,
+			plan: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				names.AttrName:   tftypes.NewValue(tftypes.String, "test"),
+				names.AttrPolicy: tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			}),
+		},
+		"destroy": {
+			config: &iampolicy.ValidationConfig{Severity: "error"},
+			state:  value(invalidPolicy),
+			plan:   null,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := conns.NewResourceContext(ctx, "test", "Test", "aws_test", "")
+			client := mockIAMPolicyValidationClient{config: tc.config}
+
+			request := resource.ModifyPlanRequest{
+				Plan:  tfsdk.Plan{Raw: tc.plan, Schema: s},
+				State: tfsdk.State{Raw: tc.state, Schema: s},
+			}
+			response := resource.ModifyPlanResponse{
+				Plan: request.Plan,
+			}
+
+			resourceValidateIAMPolicies().modifyPlan(ctx, interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]{
+				c:        client,
+				request:  &request,
+				response: &response,
+				when:     Before,
+			})
+
+			if got, want := response.Diagnostics.ErrorsCount(), tc.expectErrors; got != want {
+				t.Errorf("errors: got %d, want %d: %v", got, want, response.Diagnostics)
+			}
+			if got, want := response.Diagnostics.WarningsCount(), tc.expectWarnings; got != want {
+				t.Errorf("warnings: got %d, want %d: %v", got, want, response.Diagnostics)
+			}
+			for _, d := range response.Diagnostics {
+				if d, ok := d.(diag.DiagnosticWithPath); !ok || d.Path().String() != names.AttrPolicy {
+					t.Errorf("diagnostic has no %q attribute path: %v", names.AttrPolicy, d)
+				}
+			}
+		})
+	}
+}
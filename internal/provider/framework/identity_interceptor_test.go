@@ -17,7 +17,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/guardrails"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/identity"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework/resourceattribute"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -58,7 +61,7 @@ func TestIdentityInterceptor(t *testing.T) {
 		operation func(ctx context.Context, interceptor identityInterceptor, resourceSchema schema.Schema, stateAttrs map[string]string, identity *tfsdk.ResourceIdentity, client awsClient) (*tfsdk.ResourceIdentity, diag.Diagnostics)
 	}{
 		"create": {
-			operation: create,
+			operation: createResource,
 		},
 		"read": {
 			operation: read,
@@ -116,7 +119,7 @@ func TestIdentityInterceptor(t *testing.T) {
 	}
 }
 
-func create(ctx context.Context, interceptor identityInterceptor, resourceSchema schema.Schema, stateAttrs map[string]string, identity *tfsdk.ResourceIdentity, client awsClient) (*tfsdk.ResourceIdentity, diag.Diagnostics) {
+func createResource(ctx context.Context, interceptor identityInterceptor, resourceSchema schema.Schema, stateAttrs map[string]string, identity *tfsdk.ResourceIdentity, client awsClient) (*tfsdk.ResourceIdentity, diag.Diagnostics) {
 	request := resource.CreateRequest{
 		Config:   configFromSchema(ctx, resourceSchema, stateAttrs),
 		Plan:     planFromSchema(ctx, resourceSchema, stateAttrs),
@@ -390,14 +393,30 @@ func (c mockClient) DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig
 	panic("not implemented") //lintignore:R009
 }
 
+func (c mockClient) GuardrailsConfig(context.Context) *guardrails.Config {
+	panic("not implemented") //lintignore:R009
+}
+
+func (c mockClient) IAMPolicyValidationConfig(ctx context.Context) *iampolicy.ValidationConfig {
+	panic("not implemented") //lintignore:R009
+}
+
 func (c mockClient) IgnoreTagsConfig(ctx context.Context) *tftags.IgnoreConfig {
 	panic("not implemented") //lintignore:R009
 }
 
+func (c mockClient) NamingPolicy(context.Context) *create.NamingPolicy {
+	panic("not implemented") //lintignore:R009
+}
+
 func (c mockClient) Partition(context.Context) string {
 	panic("not implemented") //lintignore:R009
 }
 
+func (c mockClient) ProviderAccountID(context.Context) string {
+	return c.accountID
+}
+
 func (c mockClient) ServicePackage(_ context.Context, name string) conns.ServicePackage {
 	panic("not implemented") //lintignore:R009
 }
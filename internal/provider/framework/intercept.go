@@ -15,6 +15,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/guardrails"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
 	tfiter "github.com/hashicorp/terraform-provider-aws/internal/iter"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -24,8 +27,12 @@ type awsClient interface {
 	AccountID(context.Context) string
 	Region(context.Context) string
 	DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig
+	GuardrailsConfig(context.Context) *guardrails.Config
+	IAMPolicyValidationConfig(ctx context.Context) *iampolicy.ValidationConfig
 	IgnoreTagsConfig(ctx context.Context) *tftags.IgnoreConfig
+	NamingPolicy(context.Context) *create.NamingPolicy
 	Partition(context.Context) string
+	ProviderAccountID(context.Context) string
 	ServicePackage(_ context.Context, name string) conns.ServicePackage
 	TagPolicyConfig(ctx context.Context) *tftags.TagPolicyConfig
 	ValidateInContextRegionInPartition(ctx context.Context) error
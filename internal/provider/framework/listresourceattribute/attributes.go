@@ -4,12 +4,54 @@
 package listresourceattribute
 
 import (
+	"context"
 	"sync"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+var AssumeRole = sync.OnceValue(func() schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType:  fwtypes.NewListNestedObjectTypeOf[framework.AssumeRoleModel](context.Background()),
+		Description: names.TopLevelAssumeRoleAttributeDescription,
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrExternalID: schema.StringAttribute{
+					Optional:    true,
+					Description: names.TopLevelAssumeRoleExternalIDAttributeDescription,
+					Validators: []validator.String{
+						stringvalidator.LengthBetween(2, 1224),
+					},
+				},
+				names.AttrRoleARN: schema.StringAttribute{
+					Required:    true,
+					Description: names.TopLevelAssumeRoleRoleARNAttributeDescription,
+					Validators: []validator.String{
+						fwvalidators.ARN(),
+					},
+				},
+				names.TopLevelAssumeRoleSessionNameAttribute: schema.StringAttribute{
+					Optional:    true,
+					Description: names.TopLevelAssumeRoleSessionNameAttributeDescription,
+					Validators: []validator.String{
+						stringvalidator.LengthBetween(2, 64),
+					},
+				},
+			},
+		},
+	}
+})
+
 var Region = sync.OnceValue(func() schema.StringAttribute {
 	return schema.StringAttribute{
 		Optional:    true,
@@ -0,0 +1,141 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+)
+
+// resourceValidateNamingPolicy validates configured names and name prefixes against the provider-level naming policy at plan time.
+// Top-level attributes with a corresponding name prefix attribute, e.g. `name` and `name_prefix`, are validated.
+// Values are only validated when the resource is created or the value changes, so existing resources remain manageable.
+// The policy's casing is only enforced if values in that casing pass the attributes' own validators.
+// If neither a name nor a name prefix is configured on create, names generated to conform to the policy are validated
+// against the name attribute's own validators.
+func resourceValidateNamingPolicy() resourceModifyPlanInterceptor {
+	return &resourceValidateNamingPolicyInterceptor{}
+}
+
+type resourceValidateNamingPolicyInterceptor struct{}
+
+func (r resourceValidateNamingPolicyInterceptor) modifyPlan(ctx context.Context, opts interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]) {
+	c := opts.c
+
+	policy := c.NamingPolicy(ctx)
+	if policy == nil {
+		return
+	}
+
+	switch request, response, when := opts.request, opts.response, opts.when; when {
+	case Before:
+		// If the entire plan is null, the resource is planned for destruction.
+		if request.Plan.Raw.IsNull() {
+			return
+		}
+
+		schemaAttributes := request.Plan.Schema.GetAttributes()
+		for _, name := range slices.Sorted(maps.Keys(schemaAttributes)) {
+			prefix := name + "_prefix"
+			if _, ok := schemaAttributes[prefix]; !ok {
+				continue
+			}
+
+			if v, ok := topLevelString(request.Config.Raw, name); ok && v != "" {
+				if current, ok := topLevelString(request.State.Raw, name); !ok || current != v {
+					if err := policy.ValidateName(v, attributeNameValidator(ctx, request.Config, name)); err != nil {
+						response.Diagnostics.AddAttributeError(path.Root(name), create.NamingPolicySummary, err.Error())
+					}
+				}
+			}
+
+			if v, ok := topLevelString(request.Config.Raw, prefix); ok && v != "" {
+				if current, ok := topLevelString(request.State.Raw, prefix); !ok || current != v {
+					if err := policy.ValidateNamePrefix(v, attributeNameValidator(ctx, request.Config, prefix)); err != nil {
+						response.Diagnostics.AddAttributeError(path.Root(prefix), create.NamingPolicySummary, err.Error())
+					}
+				}
+			}
+
+			if request.State.Raw.IsNull() && topLevelNullString(request.Config.Raw, name) && topLevelNullString(request.Config.Raw, prefix) {
+				if err := policy.ValidateGeneratedName(attributeNameValidator(ctx, request.Config, name)); err != nil {
+					response.Diagnostics.AddAttributeError(path.Root(name), create.NamingPolicySummary, fmt.Sprintf("naming policy cannot be applied, configure %s or %s: %s", name, prefix, err))
+				}
+			}
+		}
+	}
+}
+
+// attributeNameValidator returns a create.NameValidator that validates a value of the specified top-level string attribute
+// against the attribute's own validators.
+func attributeNameValidator(ctx context.Context, config tfsdk.Config, name string) create.NameValidator {
+	if config.Schema == nil {
+		return nil
+	}
+
+	attribute, ok := config.Schema.GetAttributes()[name].(interface {
+		StringValidators() []validator.String
+	})
+	if !ok || len(attribute.StringValidators()) == 0 {
+		return nil
+	}
+	validators := attribute.StringValidators()
+
+	return func(value string) error {
+		var diags diag.Diagnostics
+
+		request := validator.StringRequest{
+			Config:         config,
+			ConfigValue:    types.StringValue(value),
+			Path:           path.Root(name),
+			PathExpression: path.MatchRoot(name),
+		}
+		for _, v := range validators {
+			var response validator.StringResponse
+			v.ValidateString(ctx, request, &response)
+			diags.Append(response.Diagnostics...)
+		}
+
+		return fwdiag.DiagnosticsError(diags)
+	}
+}
+
+// topLevelNullString returns whether the specified top-level string attribute is null or empty.
+func topLevelNullString(v tftypes.Value, name string) bool {
+	if v.IsNull() || !v.IsKnown() {
+		return false
+	}
+
+	attr, _, err := tftypes.WalkAttributePath(v, tftypes.NewAttributePath().WithAttributeName(name))
+	if err != nil {
+		return false
+	}
+
+	value, ok := attr.(tftypes.Value)
+	if !ok || !value.Type().Is(tftypes.String) {
+		return false
+	}
+	if value.IsNull() {
+		return true
+	}
+
+	var s string
+	if !value.IsKnown() || value.As(&s) != nil {
+		return false
+	}
+
+	return s == ""
+}
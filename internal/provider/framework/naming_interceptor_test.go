@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type mockNamingPolicyClient struct {
+	mockClient
+	policy *create.NamingPolicy
+}
+
+func (c mockNamingPolicyClient) NamingPolicy(context.Context) *create.NamingPolicy {
+	return c.policy
+}
+
+func TestResourceValidateNamingPolicyInterceptor(t *testing.T) {
+	t.Parallel()
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrName: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9a-z-]+$`), ""),
+				},
+			},
+			names.AttrNamePrefix: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+
+	ctx := context.Background()
+	objectType := s.Type().TerraformType(ctx)
+	value := func(name, namePrefix any) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			names.AttrName:       tftypes.NewValue(tftypes.String, name),
+			names.AttrNamePrefix: tftypes.NewValue(tftypes.String, namePrefix),
+		})
+	}
+	null := tftypes.NewValue(objectType, nil)
+
+	testCases := map[string]struct {
+		policy       *create.NamingPolicy
+		state        tftypes.Value
+		config       tftypes.Value
+		expectErrors int
+	}{
+		"no policy": {
+			state:  null,
+			config: value("TESTING", nil),
+		},
+		"name conforms": {
+			policy: &create.NamingPolicy{Casing: create.NamingPolicyCasingLower, Prefix: "acme", Separator: "-"},
+			state:  null,
+			config: value("acme-testing", nil),
+		},
+		"name does not conform": {
+			policy:       &create.NamingPolicy{Casing: create.NamingPolicyCasingLower, Prefix: "acme", Separator: "-"},
+			state:        null,
+			config:       value("testing", nil),
+			expectErrors: 1,
+		},
+		"name does not conform unchanged": {
+			policy: &create.NamingPolicy{Casing: create.NamingPolicyCasingLower, Prefix: "acme", Separator: "-"},
+			state:  value("testing", ""),
+			config: value("testing", nil),
+		},
+		"name casing not valid for resource": {
+			policy: &create.NamingPolicy{Casing: create.NamingPolicyCasingUpper},
+			state:  null,
+			config: value("acme-testing", nil),
+		},
+		"name prefix does not conform": {
+			policy:       &create.NamingPolicy{Prefix: "acme", Separator: "-"},
+			state:        null,
+			config:       value(nil, "testing-"),
+			expectErrors: 1,
+		},
+		"generated name valid": {
+			policy: &create.NamingPolicy{Casing: create.NamingPolicyCasingLower, Prefix: "acme", Separator: "-"},
+			state:  null,
+			config: value(nil, nil),
+		},
+		"generated name not valid": {
+			policy:       &create.NamingPolicy{Casing: create.NamingPolicyCasingUpper, Prefix: "acme", Separator: "-"},
+			state:        null,
+			config:       value(nil, nil),
+			expectErrors: 1,
+		},
+		"generated name not valid update": {
+			policy: &create.NamingPolicy{Casing: create.NamingPolicyCasingUpper, Prefix: "acme", Separator: "-"},
+			state:  value("terraform-20261019094623000000000001", ""),
+			config: value(nil, nil),
+		},
+		"name unknown": {
+			policy: &create.NamingPolicy{Casing: create.NamingPolicyCasingUpper, Prefix: "acme", Separator: "-"},
+			state:  null,
+			config: value(tftypes.UnknownValue, nil),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := conns.NewResourceContext(ctx, "test", "Test", "aws_test", "")
+			client := mockNamingPolicyClient{policy: tc.policy}
+
+			request := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Raw: tc.config, Schema: s},
+				Plan:   tfsdk.Plan{Raw: tc.config, Schema: s},
+				State:  tfsdk.State{Raw: tc.state, Schema: s},
+			}
+			response := resource.ModifyPlanResponse{
+				Plan: request.Plan,
+			}
+
+			resourceValidateNamingPolicy().modifyPlan(ctx, interceptorOptions[resource.ModifyPlanRequest, resource.ModifyPlanResponse]{
+				c:        client,
+				request:  &request,
+				response: &response,
+				when:     Before,
+			})
+
+			if got, want := response.Diagnostics.ErrorsCount(), tc.expectErrors; got != want {
+				t.Errorf("errors: got %d, want %d: %v", got, want, response.Diagnostics)
+			}
+		})
+	}
+}
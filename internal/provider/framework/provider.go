@@ -14,6 +14,7 @@ import (
 	"sync"
 	"unique"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	aschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
@@ -34,6 +35,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	tfunique "github.com/hashicorp/terraform-provider-aws/internal/unique"
@@ -109,6 +111,11 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"allowed_regions": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Regions in which resources can be managed and data sources read, including using the per-resource `region` argument.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -121,10 +128,21 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Optional:    true,
 				Description: "Protocol to use with EC2 metadata service endpoint.Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 			},
+			"endpoint_url": schema.StringAttribute{
+				Optional: true,
+				Description: "Base URL of the endpoint to use for all AWS services, e.g. of a local AWS stand-in. " +
+					"Service-specific endpoints configured in the `endpoints` block or with `AWS_ENDPOINT_URL_<SERVICE>` environment variables take precedence. " +
+					"Takes precedence over the `AWS_ENDPOINT_URL` environment variable.",
+			},
 			"forbidden_account_ids": schema.SetAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"forbidden_regions": schema.SetAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Regions in which resources must not be managed nor data sources read, including using the per-resource `region` argument.",
+			},
 			"http_proxy": schema.StringAttribute{
 				Optional:    true,
 				Description: "URL of a proxy to use for HTTP requests when accessing the AWS API. Can also be set using the `HTTP_PROXY` or `http_proxy` environment variables.",
@@ -133,6 +151,14 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 				Optional:    true,
 				Description: "URL of a proxy to use for HTTPS requests when accessing the AWS API. Can also be set using the `HTTPS_PROXY` or `https_proxy` environment variables.",
 			},
+			"iam_policy_validation": schema.StringAttribute{
+				Optional: true,
+				Description: `The severity with which to report problems found by plan-time validation of IAM policy documents ` +
+					`against the bundled catalog of service actions, resource ARN formats and condition keys. ` +
+					`Valid values are "error", "warning", and "disabled". ` +
+					`When unset or "disabled", IAM policy documents are not validated by the provider. ` +
+					`Can also be configured with the ` + iampolicy.ValidationEnvVar + ` environment variable.`,
+			},
 			"insecure": schema.BoolAttribute{
 				Optional:    true,
 				Description: "Explicitly allow the provider to perform \"insecure\" SSL requests. If omitted, default value is `false`",
@@ -331,7 +357,95 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 					},
 				},
 			},
+			"backup_before_destroy": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to back up resources before they are destroyed or replaced.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"backup_tags": schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Tags to apply to backups.",
+						},
+						"backup_vault_name": schema.StringAttribute{
+							Optional:    true,
+							Description: "Name of the AWS Backup vault for backups taken with AWS Backup.",
+						},
+						names.AttrIAMRoleARN: schema.StringAttribute{
+							Optional:    true,
+							Description: "ARN of the IAM role that AWS Backup assumes for backups taken with AWS Backup.",
+						},
+						"resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Patterns, e.g. `aws_db_*`, matching the types of resources to back up.",
+						},
+						names.AttrTags: schema.MapAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Tags that resources to back up have.",
+						},
+						names.AttrTimeout: schema.StringAttribute{
+							Optional:    true,
+							Description: "Time to wait for a backup to complete. Defaults to `60m`.",
+						},
+					},
+				},
+			},
 			"endpoints": endpointsBlock(),
+			"guardrails": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with guardrail policies enforced across all resources.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"allowed_regions": schema.SetAttribute{
+							ElementType:        types.StringType,
+							Optional:           true,
+							Description:        "Regions in which resources can be managed and data sources read. Superseded by the top-level `allowed_regions` argument.",
+							DeprecationMessage: "allowed_regions is deprecated. Use the top-level allowed_regions argument instead.",
+						},
+						"forbidden_resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Patterns, e.g. `aws_iam_user*`, matching the types of resources that must not be created.",
+						},
+						"max_deletions": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
+							Description: "Maximum number of resources deleted in a single run. `0` means unlimited.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"destroy_protection": schema.ListNestedBlock{
+							Description: "Rules matching resources that must not be destroyed or replaced.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrName: schema.StringAttribute{
+										Optional:    true,
+										Description: "Name of the rule, reported in guardrail policy violations.",
+									},
+									"resource_types": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Patterns, e.g. `aws_db_*`, matching the types of protected resources.",
+									},
+									names.AttrTags: schema.MapAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Tags that protected resources have.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"ignore_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
 					listvalidator.SizeAtMost(1),
@@ -354,6 +468,74 @@ func (*frameworkProvider) Schema(ctx context.Context, request provider.SchemaReq
 					},
 				},
 			},
+			"naming_policy": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with the naming convention applied to generated resource names and validated against configured names.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"casing": schema.StringAttribute{
+							Optional:    true,
+							Description: "Casing of resource names, `lower` or `upper`.",
+						},
+						"max_length": schema.Int64Attribute{
+							Optional:    true,
+							Description: "Maximum length of resource names. Longer generated names are truncated and a hash appended.",
+						},
+						names.AttrPrefix: schema.StringAttribute{
+							Optional:    true,
+							Description: "Prefix of resource names.",
+						},
+						"separator": schema.StringAttribute{
+							Optional:    true,
+							Description: "Separator between the prefix or suffix and the rest of resource names.",
+						},
+						"suffix": schema.StringAttribute{
+							Optional:    true,
+							Description: "Suffix of resource names.",
+						},
+					},
+				},
+			},
+			"operation_defaults": schema.ListNestedBlock{
+				Description: "Default operation timeouts and retryable API error codes for resources of the matching types or service packages.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"create": schema.StringAttribute{
+							Optional:    true,
+							Description: "Default Create timeout for matching resources that support one.",
+						},
+						"delete": schema.StringAttribute{
+							Optional:    true,
+							Description: "Default Delete timeout for matching resources that support one.",
+						},
+						"read": schema.StringAttribute{
+							Optional:    true,
+							Description: "Default Read timeout for matching resources that support one.",
+						},
+						"resource_types": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Patterns, e.g. `aws_eks_*`, matching resource types.",
+						},
+						"retryable_error_codes": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "API error codes, e.g. `ThrottlingException`, to retry in addition to the default retryable errors. Applies to the service packages in `service_packages`.",
+						},
+						"service_packages": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Service package names, e.g. `eks`.",
+						},
+						"update": schema.StringAttribute{
+							Optional:    true,
+							Description: "Default Update timeout for matching resources that support one.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -424,6 +606,7 @@ func (p *frameworkProvider) Functions(_ context.Context) []func() function.Funct
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewRoute53ZoneFileParseFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 		tffunction.NewUserAgentFunction,
 	}
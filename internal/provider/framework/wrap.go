@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
@@ -62,6 +63,8 @@ func newWrappedDataSource(spec *inttypes.ServicePackageFrameworkDataSource, serv
 		}
 		interceptors = append(interceptors, dataSourceSetRegionInState())
 	}
+	// The per-resource IAM role override is available whether or not the data source supports the per-resource Region override.
+	interceptors = append(interceptors, dataSourceInjectAssumeRoleAttribute())
 
 	if !tfunique.IsHandleNil(spec.Tags) {
 		interceptors = append(interceptors, dataSourceTransparentTagging(spec.Tags))
@@ -81,23 +84,34 @@ func newWrappedDataSource(spec *inttypes.ServicePackageFrameworkDataSource, serv
 func (w *wrappedDataSource) context(ctx context.Context, getAttribute getAttributeFunc, providerMeta *tfsdk.Config, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	var overrideRegion string
+	var assumeRole *conns.AssumeRole
 
 	var isRegionOverrideEnabled bool
 	if regionSpec := w.spec.Region; !tfunique.IsHandleNil(regionSpec) && regionSpec.Value().IsOverrideEnabled {
 		isRegionOverrideEnabled = true
 	}
 
-	if isRegionOverrideEnabled && getAttribute != nil {
-		var target types.String
-		diags.Append(getAttribute(ctx, path.Root(names.AttrRegion), &target)...)
+	if getAttribute != nil {
+		if isRegionOverrideEnabled {
+			var target types.String
+			diags.Append(getAttribute(ctx, path.Root(names.AttrRegion), &target)...)
+			if diags.HasError() {
+				return ctx, diags
+			}
+
+			overrideRegion = target.ValueString()
+		}
+
+		var d diag.Diagnostics
+		assumeRole, d = overrideAssumeRole(ctx, getAttribute)
+		diags.Append(d...)
 		if diags.HasError() {
 			return ctx, diags
 		}
-
-		overrideRegion = target.ValueString()
 	}
 
 	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion)
+	ctx = conns.NewOverrideAssumeRoleContext(ctx, assumeRole)
 	if c != nil {
 		ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
 		ctx = c.RegisterLogger(ctx)
@@ -231,6 +245,8 @@ func newWrappedEphemeralResource(spec *inttypes.ServicePackageEphemeralResource,
 		}
 		interceptors = append(interceptors, ephemeralResourceSetRegionInResult())
 	}
+	// The per-resource IAM role override is available whether or not the ephemeral resource supports the per-resource Region override.
+	interceptors = append(interceptors, ephemeralResourceInjectAssumeRoleAttribute())
 
 	inner, _ := spec.Factory(context.TODO())
 
@@ -246,23 +262,34 @@ func newWrappedEphemeralResource(spec *inttypes.ServicePackageEphemeralResource,
 func (w *wrappedEphemeralResource) context(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	var overrideRegion string
+	var assumeRole *conns.AssumeRole
 
 	var isRegionOverrideEnabled bool
 	if regionSpec := w.spec.Region; !tfunique.IsHandleNil(regionSpec) && regionSpec.Value().IsOverrideEnabled {
 		isRegionOverrideEnabled = true
 	}
 
-	if isRegionOverrideEnabled && getAttribute != nil {
-		var target types.String
-		diags.Append(getAttribute(ctx, path.Root(names.AttrRegion), &target)...)
+	if getAttribute != nil {
+		if isRegionOverrideEnabled {
+			var target types.String
+			diags.Append(getAttribute(ctx, path.Root(names.AttrRegion), &target)...)
+			if diags.HasError() {
+				return ctx, diags
+			}
+
+			overrideRegion = target.ValueString()
+		}
+
+		var d diag.Diagnostics
+		assumeRole, d = overrideAssumeRole(ctx, getAttribute)
+		diags.Append(d...)
 		if diags.HasError() {
 			return ctx, diags
 		}
-
-		overrideRegion = target.ValueString()
 	}
 
 	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion)
+	ctx = conns.NewOverrideAssumeRoleContext(ctx, assumeRole)
 	if c != nil {
 		ctx = c.RegisterLogger(ctx)
 		ctx = fwflex.RegisterLogger(ctx)
@@ -401,6 +428,8 @@ func newWrappedAction(spec *inttypes.ServicePackageAction, servicePackageName st
 			interceptors = append(interceptors, actionValidateRegion())
 		}
 	}
+	// The per-resource IAM role override is available whether or not the action supports the per-resource Region override.
+	interceptors = append(interceptors, actionInjectAssumeRoleAttribute())
 
 	inner, _ := spec.Factory(context.TODO())
 
@@ -416,23 +445,34 @@ func newWrappedAction(spec *inttypes.ServicePackageAction, servicePackageName st
 func (w *wrappedAction) context(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	var overrideRegion string
+	var assumeRole *conns.AssumeRole
 
 	var isRegionOverrideEnabled bool
 	if regionSpec := w.spec.Region; !tfunique.IsHandleNil(regionSpec) && regionSpec.Value().IsOverrideEnabled {
 		isRegionOverrideEnabled = true
 	}
 
-	if isRegionOverrideEnabled && getAttribute != nil {
-		var target types.String
-		diags.Append(getAttribute(ctx, path.Root(names.AttrRegion), &target)...)
+	if getAttribute != nil {
+		if isRegionOverrideEnabled {
+			var target types.String
+			diags.Append(getAttribute(ctx, path.Root(names.AttrRegion), &target)...)
+			if diags.HasError() {
+				return ctx, diags
+			}
+
+			overrideRegion = target.ValueString()
+		}
+
+		var d diag.Diagnostics
+		assumeRole, d = overrideAssumeRole(ctx, getAttribute)
+		diags.Append(d...)
 		if diags.HasError() {
 			return ctx, diags
 		}
-
-		overrideRegion = target.ValueString()
 	}
 
 	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion)
+	ctx = conns.NewOverrideAssumeRoleContext(ctx, assumeRole)
 	if c != nil {
 		ctx = c.RegisterLogger(ctx)
 		ctx = fwflex.RegisterLogger(ctx)
@@ -561,12 +601,20 @@ func newWrappedResource(spec *inttypes.ServicePackageFrameworkResource, serviceP
 			interceptors = append(interceptors, resourceImportRegion())
 		}
 	}
+	// The per-resource IAM role override is available whether or not the resource supports the per-resource Region override.
+	interceptors = append(interceptors, resourceInjectAssumeRoleAttribute())
+	// Moving a resource to a different AWS account is a replacement.
+	interceptors = append(interceptors, resourceForceNewIfAssumeRoleAccountChanges())
 
 	if !tfunique.IsHandleNil(spec.Tags) {
 		interceptors = append(interceptors, resourceTransparentTagging(spec.Tags))
 		interceptors = append(interceptors, resourceValidateRequiredTags())
 	}
 
+	interceptors = append(interceptors, resourceValidateIAMPolicies())
+	interceptors = append(interceptors, resourceValidateNamingPolicy())
+	interceptors = append(interceptors, resourceValidateGuardrails())
+
 	inner, _ := spec.Factory(context.TODO())
 
 	if len(spec.Identity.Attributes) == 0 {
@@ -605,25 +653,38 @@ func newWrappedResource(spec *inttypes.ServicePackageFrameworkResource, serviceP
 func (w *wrappedResource) context(ctx context.Context, getAttribute getAttributeFunc, providerMeta *tfsdk.Config, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	var overrideRegion string
+	var assumeRole *conns.AssumeRole
 
 	var isRegionOverrideEnabled bool
 	if regionSpec := w.spec.Region; !tfunique.IsHandleNil(regionSpec) && regionSpec.Value().IsOverrideEnabled {
 		isRegionOverrideEnabled = true
 	}
 
-	if isRegionOverrideEnabled && getAttribute != nil {
-		var target types.String
-		diags.Append(getAttribute(ctx, path.Root(names.AttrRegion), &target)...)
+	if getAttribute != nil {
+		if isRegionOverrideEnabled {
+			var target types.String
+			diags.Append(getAttribute(ctx, path.Root(names.AttrRegion), &target)...)
+			if diags.HasError() {
+				return ctx, diags
+			}
+
+			overrideRegion = target.ValueString()
+		}
+
+		var d diag.Diagnostics
+		assumeRole, d = overrideAssumeRole(ctx, getAttribute)
+		diags.Append(d...)
 		if diags.HasError() {
 			return ctx, diags
 		}
-
-		overrideRegion = target.ValueString()
 	}
 
 	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion)
+	ctx = conns.NewOverrideAssumeRoleContext(ctx, assumeRole)
 	if c != nil {
+		ctx = conns.NewOperationTimeoutsContext(ctx, c.OperationTimeouts(ctx, w.servicePackageName, w.spec.TypeName))
 		ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
+		ctx = create.NewNamingPolicyContext(ctx, c.NamingPolicy(ctx))
 		ctx = c.RegisterLogger(ctx)
 		ctx = fwflex.RegisterLogger(ctx)
 	}
@@ -861,6 +922,8 @@ func newWrappedListResourceFramework(spec *inttypes.ServicePackageFrameworkListR
 		interceptors = append(interceptors, listResourceInjectRegionAttribute())
 		// TODO: validate region in partition, needs tweaked error message
 	}
+	// The per-resource IAM role override is available whether or not the list resource supports the per-resource Region override.
+	interceptors = append(interceptors, listResourceInjectAssumeRoleAttribute())
 
 	inner := spec.Factory()
 
@@ -895,27 +958,38 @@ func newWrappedListResourceFramework(spec *inttypes.ServicePackageFrameworkListR
 func (w *wrappedListResourceFramework) context(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	var overrideRegion string
+	var assumeRole *conns.AssumeRole
 
 	var isRegionOverrideEnabled bool
 	if regionSpec := w.spec.Region; !tfunique.IsHandleNil(regionSpec) && regionSpec.Value().IsOverrideEnabled {
 		isRegionOverrideEnabled = true
 	}
 
-	if isRegionOverrideEnabled && getAttribute != nil {
-		var target types.String
-		diags.Append(getAttribute(ctx, path.Root(names.AttrRegion), &target)...)
-		if diags.HasError() {
-			return ctx, diags
+	if getAttribute != nil {
+		if isRegionOverrideEnabled {
+			var target types.String
+			diags.Append(getAttribute(ctx, path.Root(names.AttrRegion), &target)...)
+			if diags.HasError() {
+				return ctx, diags
+			}
+
+			if target.IsNull() || target.IsUnknown() {
+				overrideRegion = c.AwsConfig(ctx).Region
+			} else {
+				overrideRegion = target.ValueString()
+			}
 		}
 
-		if target.IsNull() || target.IsUnknown() {
-			overrideRegion = c.AwsConfig(ctx).Region
-		} else {
-			overrideRegion = target.ValueString()
+		var d diag.Diagnostics
+		assumeRole, d = overrideAssumeRole(ctx, getAttribute)
+		diags.Append(d...)
+		if diags.HasError() {
+			return ctx, diags
 		}
 	}
 
 	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion)
+	ctx = conns.NewOverrideAssumeRoleContext(ctx, assumeRole)
 	if c != nil {
 		ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
 		ctx = c.RegisterLogger(ctx)
@@ -987,6 +1061,8 @@ func newWrappedListResourceSDK(spec *inttypes.ServicePackageSDKListResource, ser
 		interceptors = append(interceptors, listResourceInjectRegionAttribute())
 		// TODO: validate region in partition, needs tweaked error message
 	}
+	// The per-resource IAM role override is available whether or not the list resource supports the per-resource Region override.
+	interceptors = append(interceptors, listResourceInjectAssumeRoleAttribute())
 
 	inner := spec.Factory()
 
@@ -1022,27 +1098,38 @@ func (w *wrappedListResourceSDK) Metadata(_ context.Context, request resource.Me
 func (w *wrappedListResourceSDK) context(ctx context.Context, getAttribute getAttributeFunc, c *conns.AWSClient) (context.Context, diag.Diagnostics) {
 	var diags diag.Diagnostics
 	var overrideRegion string
+	var assumeRole *conns.AssumeRole
 
 	var isRegionOverrideEnabled bool
 	if regionSpec := w.spec.Region; !tfunique.IsHandleNil(regionSpec) && regionSpec.Value().IsOverrideEnabled {
 		isRegionOverrideEnabled = true
 	}
 
-	if isRegionOverrideEnabled && getAttribute != nil {
-		var target types.String
-		diags.Append(getAttribute(ctx, path.Root(names.AttrRegion), &target)...)
-		if diags.HasError() {
-			return ctx, diags
+	if getAttribute != nil {
+		if isRegionOverrideEnabled {
+			var target types.String
+			diags.Append(getAttribute(ctx, path.Root(names.AttrRegion), &target)...)
+			if diags.HasError() {
+				return ctx, diags
+			}
+
+			if target.IsNull() || target.IsUnknown() {
+				overrideRegion = c.AwsConfig(ctx).Region
+			} else {
+				overrideRegion = target.ValueString()
+			}
 		}
 
-		if target.IsNull() || target.IsUnknown() {
-			overrideRegion = c.AwsConfig(ctx).Region
-		} else {
-			overrideRegion = target.ValueString()
+		var d diag.Diagnostics
+		assumeRole, d = overrideAssumeRole(ctx, getAttribute)
+		diags.Append(d...)
+		if diags.HasError() {
+			return ctx, diags
 		}
 	}
 
 	ctx = conns.NewResourceContext(ctx, w.servicePackageName, w.spec.Name, w.spec.TypeName, overrideRegion)
+	ctx = conns.NewOverrideAssumeRoleContext(ctx, assumeRole)
 	if c != nil {
 		ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
 		ctx = c.RegisterLogger(ctx)
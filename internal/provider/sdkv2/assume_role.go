@@ -0,0 +1,117 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/internal/attribute"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// overrideAssumeRole returns the value of the top-level `assume_role` attribute.
+// nil is returned if the attribute is not set or its role ARN is not yet known.
+func overrideAssumeRole(getAttribute getAttributeFunc) *conns.AssumeRole {
+	v, ok := getAttribute(names.TopLevelAssumeRoleAttribute)
+	if !ok {
+		return nil
+	}
+
+	tfList, ok := v.([]any)
+	if !ok || len(tfList) == 0 {
+		return nil
+	}
+
+	tfMap, ok := tfList[0].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	apiObject := &conns.AssumeRole{}
+
+	if v, ok := tfMap[names.AttrExternalID].(string); ok {
+		apiObject.ExternalID = v
+	}
+
+	if v, ok := tfMap[names.AttrRoleARN].(string); ok {
+		apiObject.RoleARN = v
+	}
+
+	if v, ok := tfMap[names.TopLevelAssumeRoleSessionNameAttribute].(string); ok {
+		apiObject.SessionName = v
+	}
+
+	if apiObject.RoleARN == "" {
+		return nil
+	}
+
+	return apiObject
+}
+
+// injectAssumeRoleAttribute injects a top-level "assume_role" attribute into a resource's or data source's schema.
+func injectAssumeRoleAttribute(r *schema.Resource) {
+	assumeRoleSchema := attribute.AssumeRole()
+
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			s := f()
+			s[names.TopLevelAssumeRoleAttribute] = assumeRoleSchema
+			return s
+		}
+	} else {
+		r.Schema[names.TopLevelAssumeRoleAttribute] = assumeRoleSchema
+	}
+}
+
+// forceNewIfAssumeRoleAccountChanges forces resource replacement if a change to the top-level `assume_role` attribute
+// moves the resource to a different AWS account.
+// The account of a resource without an IAM role override is the provider's configured account.
+func forceNewIfAssumeRoleAccountChanges() customizeDiffInterceptor {
+	return interceptorFunc1[*schema.ResourceDiff, error](func(ctx context.Context, opts customizeDiffInterceptorOptions) error {
+		c := opts.c
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case CustomizeDiff:
+				key := names.TopLevelAssumeRoleAttribute + ".0." + names.AttrRoleARN
+
+				if d.Id() == "" || !d.HasChange(key) {
+					return nil
+				}
+
+				if !d.NewValueKnown(key) {
+					return d.ForceNew(key)
+				}
+
+				providerAccountID := c.ProviderAccountID(ctx)
+				o, n := d.GetChange(key)
+				if roleAccountID(o.(string), providerAccountID) == roleAccountID(n.(string), providerAccountID) {
+					return nil
+				}
+
+				return d.ForceNew(key)
+			}
+		}
+
+		return nil
+	})
+}
+
+// roleAccountID returns the ID of the AWS account owning the specified IAM role.
+// The default account ID is returned if no role is specified.
+func roleAccountID(roleARN, defaultAccountID string) string {
+	if roleARN == "" {
+		return defaultAccountID
+	}
+
+	if v, err := arn.Parse(roleARN); err == nil {
+		return v.AccountID
+	}
+
+	return roleARN
+}
@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// backupBeforeDestroy backs up resources selected by the provider's `backup_before_destroy` configuration before they are deleted.
+// The resource is not deleted if the backup fails.
+func backupBeforeDestroy(f conns.BackupBeforeDestroyFunc, hasTags bool) crudInterceptor {
+	return interceptorFunc1[schemaResourceData, diag.Diagnostics](func(ctx context.Context, opts crudInterceptorOptions) diag.Diagnostics {
+		var diags diag.Diagnostics
+		c := opts.c
+
+		config := c.BackupBeforeDestroyConfig(ctx)
+		if config == nil {
+			return diags
+		}
+
+		inContext, ok := conns.FromContext(ctx)
+		if !ok {
+			return diags
+		}
+		typeName := inContext.TypeName()
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case Delete:
+				var tags map[string]string
+				if hasTags {
+					if v, ok := d.Get(names.AttrTagsAll).(map[string]any); ok {
+						tags = flex.ExpandStringValueMap(v)
+					}
+				}
+
+				if !config.Matches(typeName, tags) {
+					return diags
+				}
+
+				id, err := f(ctx, d, c, config)
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "backing up %s (%s) before destroy: %s", typeName, d.Id(), err)
+				}
+
+				if id != "" {
+					tflog.Info(ctx, "Backed up resource before destroy", map[string]any{
+						"tf_aws.resource_type": typeName,
+						"tf_aws.resource_id":   d.Id(),
+						"tf_aws.backup_id":     id,
+					})
+				}
+			}
+		}
+
+		return diags
+	})
+}
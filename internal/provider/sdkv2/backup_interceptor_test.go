@@ -0,0 +1,148 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/guardrails"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type mockBackupClient struct {
+	mockClient
+	config *guardrails.BackupBeforeDestroyConfig
+}
+
+func (c mockBackupClient) BackupBeforeDestroyConfig(context.Context) *guardrails.BackupBeforeDestroyConfig {
+	return c.config
+}
+
+func TestBackupBeforeDestroyInterceptor(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		config      *guardrails.BackupBeforeDestroyConfig
+		hasTags     bool
+		when        when
+		why         why
+		backupErr   error
+		expectCall  bool
+		expectError bool
+	}{
+		"no configuration": {
+			when: Before,
+			why:  Delete,
+		},
+		"all resource types": {
+			config:     &guardrails.BackupBeforeDestroyConfig{},
+			hasTags:    true,
+			when:       Before,
+			why:        Delete,
+			expectCall: true,
+		},
+		"resource type matches": {
+			config: &guardrails.BackupBeforeDestroyConfig{
+				Selector: guardrails.Selector{ResourceTypes: []string{"aws_te*"}},
+			},
+			when:       Before,
+			why:        Delete,
+			expectCall: true,
+		},
+		"resource type does not match": {
+			config: &guardrails.BackupBeforeDestroyConfig{
+				Selector: guardrails.Selector{ResourceTypes: []string{"aws_db_*"}},
+			},
+			when: Before,
+			why:  Delete,
+		},
+		"tags match": {
+			config: &guardrails.BackupBeforeDestroyConfig{
+				Selector: guardrails.Selector{Tags: map[string]string{"Environment": "production"}},
+			},
+			hasTags:    true,
+			when:       Before,
+			why:        Delete,
+			expectCall: true,
+		},
+		"tags do not match": {
+			config: &guardrails.BackupBeforeDestroyConfig{
+				Selector: guardrails.Selector{Tags: map[string]string{"Environment": "development"}},
+			},
+			hasTags: true,
+			when:    Before,
+			why:     Delete,
+		},
+		"tags not supported": {
+			config: &guardrails.BackupBeforeDestroyConfig{
+				Selector: guardrails.Selector{Tags: map[string]string{"Environment": "production"}},
+			},
+			when: Before,
+			why:  Delete,
+		},
+		"after delete": {
+			config: &guardrails.BackupBeforeDestroyConfig{},
+			when:   After,
+			why:    Delete,
+		},
+		"before update": {
+			config: &guardrails.BackupBeforeDestroyConfig{},
+			when:   Before,
+			why:    Update,
+		},
+		"backup fails": {
+			config:      &guardrails.BackupBeforeDestroyConfig{},
+			when:        Before,
+			why:         Delete,
+			backupErr:   errors.New("test error"),
+			expectCall:  true,
+			expectError: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := conns.NewResourceContext(t.Context(), "Test", "test", "aws_test", "")
+			d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+				names.AttrTagsAll: {
+					Type:     schema.TypeMap,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			}, map[string]any{
+				names.AttrTagsAll: map[string]any{
+					"Environment": "production",
+				},
+			})
+			d.SetId("test")
+
+			var called bool
+			f := func(ctx context.Context, d conns.BackupResourceData, meta any, config *guardrails.BackupBeforeDestroyConfig) (string, error) {
+				called = true
+
+				return "backup", tc.backupErr
+			}
+
+			diags := backupBeforeDestroy(f, tc.hasTags).run(ctx, crudInterceptorOptions{
+				c:    mockBackupClient{config: tc.config},
+				d:    d,
+				when: tc.when,
+				why:  tc.why,
+			})
+
+			if got, want := called, tc.expectCall; got != want {
+				t.Errorf("backup called = %t, want %t", got, want)
+			}
+			if got, want := diags.HasError(), tc.expectError; got != want {
+				t.Errorf("HasError() = %t, want %t: %v", got, want, diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/guardrails"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// validateGuardrails enforces the forbidden resource types guardrail at plan time.
+// Destruction is not planned via CustomizeDiff so destroy protection and the maximum number of deletions
+// are enforced at plan time by providerServer.
+func validateGuardrails() customizeDiffInterceptor {
+	return interceptorFunc1[*schema.ResourceDiff, error](func(ctx context.Context, opts customizeDiffInterceptorOptions) error {
+		c := opts.c
+
+		config := c.GuardrailsConfig(ctx)
+		if config == nil {
+			return nil
+		}
+
+		inContext, ok := conns.FromContext(ctx)
+		if !ok {
+			return nil
+		}
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case CustomizeDiff:
+				if d.GetRawState().IsNull() {
+					if v := config.CheckResourceType(inContext.TypeName()); v != nil {
+						return v
+					}
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+// enforceDestroyGuardrails enforces the destroy protection guardrail on Delete.
+func enforceDestroyGuardrails(hasTags bool) crudInterceptor {
+	return interceptorFunc1[schemaResourceData, diag.Diagnostics](func(ctx context.Context, opts crudInterceptorOptions) diag.Diagnostics {
+		var diags diag.Diagnostics
+		c := opts.c
+
+		config := c.GuardrailsConfig(ctx)
+		if config == nil {
+			return diags
+		}
+
+		inContext, ok := conns.FromContext(ctx)
+		if !ok {
+			return diags
+		}
+		typeName := inContext.TypeName()
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case Delete:
+				var tags map[string]string
+				if hasTags {
+					if v, ok := d.Get(names.AttrTagsAll).(map[string]any); ok {
+						tags = flex.ExpandStringValueMap(v)
+					}
+				}
+
+				if v := config.CheckDestroy(typeName, tags); v != nil {
+					return sdkdiag.AppendErrorf(diags, "%s: %s", guardrails.Summary, v)
+				}
+			}
+		}
+
+		return diags
+	})
+}
@@ -0,0 +1,74 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+// validateIAMPolicies validates the IAM policy documents in the specified attributes against the IAM policy catalog.
+func validateIAMPolicies(attributes []iampolicy.Attribute) customizeDiffInterceptor {
+	return interceptorFunc1[*schema.ResourceDiff, error](func(ctx context.Context, opts customizeDiffInterceptorOptions) error {
+		c := opts.c
+
+		config := c.IAMPolicyValidationConfig(ctx)
+		if config == nil {
+			return nil
+		}
+
+		var errs []error
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case CustomizeDiff:
+				for _, attribute := range attributes {
+					if !d.HasChange(attribute.Name) || !d.NewValueKnown(attribute.Name) {
+						continue
+					}
+
+					policy, _ := d.Get(attribute.Name).(string)
+					if policy == "" {
+						continue
+					}
+
+					findings, err := iampolicy.Lint(policy, attribute.PolicyType)
+					if err != nil {
+						// Invalid JSON is reported by the attribute's validation.
+						continue
+					}
+					if len(findings) == 0 {
+						continue
+					}
+
+					summary := "Invalid IAM Policy Document"
+					detail := fmt.Sprintf("%s: %s", attribute.Name, strings.Join(tfslices.ApplyToAll(findings, iampolicy.Finding.String), "; "))
+
+					// CustomizeDiff does not support diagnostics (only an error return)
+					switch config.Severity {
+					case "warning":
+						// Warning diagnostics are only logged
+						tflog.Warn(ctx, "IAM Policy Validation", map[string]any{
+							"summary": summary,
+							"detail":  detail,
+						})
+					default:
+						// Error diagnostics merge summary and detail into a single message
+						errs = append(errs, fmt.Errorf("%s - %s", summary, detail))
+					}
+				}
+			}
+		}
+
+		return errors.Join(errs...)
+	})
+}
@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type mockIAMPolicyValidationClient struct {
+	mockClient
+	config *iampolicy.ValidationConfig
+}
+
+func (c mockIAMPolicyValidationClient) IAMPolicyValidationConfig(context.Context) *iampolicy.ValidationConfig {
+	return c.config
+}
+
+func TestValidateIAMPolicies(t *testing.T) {
+	t.Parallel()
+
+	const (
+		validPolicy   = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"*"}]}`
+		invalidPolicy = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObjects","Resource":"*"}]}`
+	)
+
+	testCases := map[string]struct {
+		config      *iampolicy.ValidationConfig
+		prior       string
+		policy      string
+		expectError bool
+	}{
+		"disabled": {
+			policy: invalidPolicy,
+		},
+		"valid": {
+			config: &iampolicy.ValidationConfig{Severity: "error"},
+			policy: validPolicy,
+		},
+		"invalid error": {
+			config:      &iampolicy.ValidationConfig{Severity: "error"},
+			policy:      invalidPolicy,
+			expectError: true,
+		},
+		"invalid warning": {
+			config: &iampolicy.ValidationConfig{Severity: "warning"},
+			policy: invalidPolicy,
+		},
+		"invalid unchanged": {
+			config: &iampolicy.ValidationConfig{Severity: "error"},
+			prior:  invalidPolicy,
+			policy: invalidPolicy,
+		},
+		"invalid changed": {
+			config:      &iampolicy.ValidationConfig{Severity: "error"},
+			prior:       validPolicy,
+			policy:      invalidPolicy,
+			expectError: true,
+		},
+		"invalid JSON": {
+			config: &iampolicy.ValidationConfig{Severity: "error"},
+			policy: `{"Statement":`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrPolicy: {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+			}
+			wrapResource(r, wrappedResourceOptions{
+				bootstrapContext: func(ctx context.Context, _ getAttributeFunc, _ getProviderMetaFunc, _ any) (context.Context, error) {
+					return ctx, nil
+				},
+				interceptors: interceptorInvocations{
+					{
+						when: Before,
+						why:  CustomizeDiff,
+						interceptor: validateIAMPolicies([]iampolicy.Attribute{
+							{Name: names.AttrPolicy, PolicyType: iampolicy.PolicyTypeIdentity},
+						}),
+					},
+				},
+				typeName: "aws_test",
+			})
+
+			var state *terraform.InstanceState
+			if testCase.prior != "" {
+				state = &terraform.InstanceState{
+					ID: "test",
+					Attributes: map[string]string{
+						names.AttrID:     "test",
+						names.AttrPolicy: testCase.prior,
+					},
+				}
+			}
+			config := terraform.NewResourceConfigRaw(map[string]any{
+				names.AttrPolicy: testCase.policy,
+			})
+
+			_, err := r.SimpleDiff(ctx, state, config, mockIAMPolicyValidationClient{config: testCase.config})
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("SimpleDiff() err %t, want %t: %v", got, want, err)
+			}
+		})
+	}
+}
@@ -10,6 +10,9 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/guardrails"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/identity"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/internal/attribute"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -287,8 +290,9 @@ func regionalSingleParameterizedIdentitySpecNameMapped(identityAttrName, resourc
 }
 
 type mockClient struct {
-	accountID string
-	region    string
+	accountID        string
+	guardrailsConfig *guardrails.Config
+	region           string
 }
 
 func (c mockClient) AccountID(_ context.Context) string {
@@ -299,14 +303,30 @@ func (c mockClient) Region(_ context.Context) string {
 	return c.region
 }
 
+func (c mockClient) BackupBeforeDestroyConfig(context.Context) *guardrails.BackupBeforeDestroyConfig {
+	panic("not implemented") //lintignore:R009
+}
+
 func (c mockClient) DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig {
 	panic("not implemented") //lintignore:R009
 }
 
+func (c mockClient) GuardrailsConfig(context.Context) *guardrails.Config {
+	return c.guardrailsConfig
+}
+
+func (c mockClient) IAMPolicyValidationConfig(context.Context) *iampolicy.ValidationConfig {
+	panic("not implemented") //lintignore:R009
+}
+
 func (c mockClient) IgnoreTagsConfig(ctx context.Context) *tftags.IgnoreConfig {
 	panic("not implemented") //lintignore:R009
 }
 
+func (c mockClient) NamingPolicy(context.Context) *create.NamingPolicy {
+	panic("not implemented") //lintignore:R009
+}
+
 func (c mockClient) TagPolicyConfig(ctx context.Context) *tftags.TagPolicyConfig {
 	panic("not implemented") //lintignore:R009
 }
@@ -315,6 +335,10 @@ func (c mockClient) Partition(context.Context) string {
 	panic("not implemented") //lintignore:R009
 }
 
+func (c mockClient) ProviderAccountID(context.Context) string {
+	return c.accountID
+}
+
 func (c mockClient) ServicePackage(_ context.Context, name string) conns.ServicePackage {
 	panic("not implemented") //lintignore:R009
 }
@@ -12,7 +12,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/guardrails"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -20,10 +23,15 @@ import (
 
 type awsClient interface {
 	AccountID(ctx context.Context) string
+	BackupBeforeDestroyConfig(context.Context) *guardrails.BackupBeforeDestroyConfig
 	Region(ctx context.Context) string
 	DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig
+	GuardrailsConfig(context.Context) *guardrails.Config
+	IAMPolicyValidationConfig(context.Context) *iampolicy.ValidationConfig
 	IgnoreTagsConfig(ctx context.Context) *tftags.IgnoreConfig
+	NamingPolicy(context.Context) *create.NamingPolicy
 	Partition(context.Context) string
+	ProviderAccountID(context.Context) string
 	ServicePackage(_ context.Context, name string) conns.ServicePackage
 	TagPolicyConfig(context.Context) *tftags.TagPolicyConfig
 	ValidateInContextRegionInPartition(ctx context.Context) error
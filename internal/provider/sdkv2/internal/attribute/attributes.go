@@ -7,9 +7,42 @@ import (
 	"sync"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+var AssumeRole = sync.OnceValue(func() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: names.TopLevelAssumeRoleAttributeDescription,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				names.AttrExternalID: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  names.TopLevelAssumeRoleExternalIDAttributeDescription,
+					ValidateFunc: validation.StringLenBetween(2, 1224),
+				},
+				names.AttrRoleARN: {
+					Type:         schema.TypeString,
+					Required:     true,
+					Description:  names.TopLevelAssumeRoleRoleARNAttributeDescription,
+					ValidateFunc: verify.ValidARN,
+				},
+				names.TopLevelAssumeRoleSessionNameAttribute: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  names.TopLevelAssumeRoleSessionNameAttributeDescription,
+					ValidateFunc: validation.StringLenBetween(2, 64),
+				},
+			},
+		},
+	}
+})
+
 var Region = sync.OnceValue(func() *schema.Schema {
 	return &schema.Schema{
 		Type:        schema.TypeString,
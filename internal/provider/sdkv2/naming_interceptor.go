@@ -0,0 +1,128 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// namePrefixAttributes returns the top-level string attributes that have a corresponding name prefix attribute,
+// e.g. `name` and `name_prefix` or `key_name` and `key_name_prefix`, mapped to the name prefix attribute.
+func namePrefixAttributes(s map[string]*schema.Schema) map[string]string {
+	attributes := make(map[string]string)
+
+	for name, v := range s {
+		if v.Type != schema.TypeString {
+			continue
+		}
+
+		prefix := name + "_prefix"
+		if v, ok := s[prefix]; ok && v.Type == schema.TypeString {
+			attributes[name] = prefix
+		}
+	}
+
+	return attributes
+}
+
+// attributeNameValidator returns a create.NameValidator that validates a value of the specified top-level string attribute
+// against the attribute's own validators.
+func attributeNameValidator(s map[string]*schema.Schema, name string) create.NameValidator {
+	v, ok := s[name]
+	if !ok || (v.ValidateFunc == nil && v.ValidateDiagFunc == nil) {
+		return nil
+	}
+
+	return func(value string) error {
+		var errs []error
+
+		if f := v.ValidateFunc; f != nil {
+			_, es := f(value, name)
+			errs = append(errs, es...)
+		}
+		if f := v.ValidateDiagFunc; f != nil {
+			errs = append(errs, sdkdiag.DiagnosticsError(f(value, cty.GetAttrPath(name))))
+		}
+
+		return errors.Join(errs...)
+	}
+}
+
+// validateNamingPolicy validates configured names and name prefixes against the provider-level naming policy at plan time.
+// Values are only validated when the resource is created or the value changes, so existing resources remain manageable.
+// The policy's casing is only enforced if values in that casing pass the attributes' own validators.
+// If neither a name nor a name prefix is configured on create, names generated to conform to the policy are validated
+// against the name attribute's own validators.
+func validateNamingPolicy(s map[string]*schema.Schema, attributes map[string]string) customizeDiffInterceptor {
+	return interceptorFunc1[*schema.ResourceDiff, error](func(ctx context.Context, opts customizeDiffInterceptorOptions) error {
+		c := opts.c
+
+		policy := c.NamingPolicy(ctx)
+		if policy == nil {
+			return nil
+		}
+
+		var errs []error
+
+		switch d, when, why := opts.d, opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case CustomizeDiff:
+				config := d.GetRawConfig()
+				if config.IsNull() || !config.IsKnown() {
+					return nil
+				}
+				isCreate := d.GetRawState().IsNull()
+
+				for _, name := range slices.Sorted(maps.Keys(attributes)) {
+					if v, ok := configuredString(config, name); ok && (isCreate || d.HasChange(name)) {
+						if err := policy.ValidateName(v, attributeNameValidator(s, name)); err != nil {
+							errs = append(errs, fmt.Errorf("%s does not conform to the naming policy: %w", name, err))
+						}
+					}
+
+					prefix := attributes[name]
+					if v, ok := configuredString(config, prefix); ok && (isCreate || d.HasChange(prefix)) {
+						if err := policy.ValidateNamePrefix(v, attributeNameValidator(s, prefix)); err != nil {
+							errs = append(errs, fmt.Errorf("%s does not conform to the naming policy: %w", prefix, err))
+						}
+					}
+
+					if isCreate && isNullString(config, name) && isNullString(config, prefix) {
+						if err := policy.ValidateGeneratedName(attributeNameValidator(s, name)); err != nil {
+							errs = append(errs, fmt.Errorf("naming policy cannot be applied to %s, configure %s or %s: %w", name, name, prefix, err))
+						}
+					}
+				}
+			}
+		}
+
+		return errors.Join(errs...)
+	})
+}
+
+// configuredString returns the known, non-empty string value of the specified top-level attribute in configuration.
+func configuredString(config cty.Value, name string) (string, bool) {
+	v := config.GetAttr(name)
+	if v.IsNull() || !v.IsKnown() || !v.Type().Equals(cty.String) || v.AsString() == "" {
+		return "", false
+	}
+
+	return v.AsString(), true
+}
+
+// isNullString returns whether the specified top-level attribute is null or empty in configuration.
+func isNullString(config cty.Value, name string) bool {
+	v := config.GetAttr(name)
+	return v.IsNull() || (v.IsKnown() && v.Type().Equals(cty.String) && v.AsString() == "")
+}
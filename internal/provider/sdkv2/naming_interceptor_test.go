@@ -0,0 +1,205 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestNamePrefixAttributes(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema   map[string]*schema.Schema
+		expected map[string]string
+	}{
+		"no name": {
+			schema: map[string]*schema.Schema{
+				names.AttrDescription: {Type: schema.TypeString},
+			},
+			expected: map[string]string{},
+		},
+		"name only": {
+			schema: map[string]*schema.Schema{
+				names.AttrName: {Type: schema.TypeString},
+			},
+			expected: map[string]string{},
+		},
+		"name and name_prefix": {
+			schema: map[string]*schema.Schema{
+				names.AttrName:       {Type: schema.TypeString},
+				names.AttrNamePrefix: {Type: schema.TypeString},
+			},
+			expected: map[string]string{
+				names.AttrName: names.AttrNamePrefix,
+			},
+		},
+		"key_name and key_name_prefix": {
+			schema: map[string]*schema.Schema{
+				"key_name":        {Type: schema.TypeString},
+				"key_name_prefix": {Type: schema.TypeString},
+				names.AttrTags:    {Type: schema.TypeMap},
+			},
+			expected: map[string]string{
+				"key_name": "key_name_prefix",
+			},
+		},
+		"non-string prefix": {
+			schema: map[string]*schema.Schema{
+				names.AttrName:       {Type: schema.TypeString},
+				names.AttrNamePrefix: {Type: schema.TypeList},
+			},
+			expected: map[string]string{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := namePrefixAttributes(testCase.schema)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected name prefix attributes difference: %s", diff)
+			}
+		})
+	}
+}
+
+type mockNamingPolicyClient struct {
+	mockClient
+	policy *create.NamingPolicy
+}
+
+func (c mockNamingPolicyClient) NamingPolicy(context.Context) *create.NamingPolicy {
+	return c.policy
+}
+
+func TestValidateNamingPolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy      *create.NamingPolicy
+		prior       string
+		config      map[string]any
+		expectError bool
+	}{
+		"no policy": {
+			config: map[string]any{
+				names.AttrName: "TESTING",
+			},
+		},
+		"name conforms": {
+			policy: &create.NamingPolicy{Casing: create.NamingPolicyCasingLower, Prefix: "acme", Separator: "-"},
+			config: map[string]any{
+				names.AttrName: "acme-testing",
+			},
+		},
+		"name does not conform": {
+			policy: &create.NamingPolicy{Casing: create.NamingPolicyCasingLower, Prefix: "acme", Separator: "-"},
+			config: map[string]any{
+				names.AttrName: "testing",
+			},
+			expectError: true,
+		},
+		"name does not conform unchanged": {
+			policy: &create.NamingPolicy{Casing: create.NamingPolicyCasingLower, Prefix: "acme", Separator: "-"},
+			prior:  "testing",
+			config: map[string]any{
+				names.AttrName: "testing",
+			},
+		},
+		"name casing not valid for resource": {
+			policy: &create.NamingPolicy{Casing: create.NamingPolicyCasingUpper},
+			config: map[string]any{
+				names.AttrName: "acme-testing",
+			},
+		},
+		"name prefix does not conform": {
+			policy: &create.NamingPolicy{Prefix: "acme", Separator: "-"},
+			config: map[string]any{
+				names.AttrNamePrefix: "testing-",
+			},
+			expectError: true,
+		},
+		"generated name valid": {
+			policy: &create.NamingPolicy{Casing: create.NamingPolicyCasingLower, Prefix: "acme", Separator: "-"},
+			config: map[string]any{},
+		},
+		"generated name not valid": {
+			policy:      &create.NamingPolicy{Casing: create.NamingPolicyCasingUpper, Prefix: "acme", Separator: "-"},
+			config:      map[string]any{},
+			expectError: true,
+		},
+		"generated name not valid update": {
+			policy: &create.NamingPolicy{Casing: create.NamingPolicyCasingUpper, Prefix: "acme", Separator: "-"},
+			prior:  "terraform-20261019094623000000000001",
+			config: map[string]any{},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			s := map[string]*schema.Schema{
+				names.AttrName: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[0-9a-z-]+$`), ""),
+				},
+				names.AttrNamePrefix: {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+			}
+			r := &schema.Resource{
+				Schema: s,
+			}
+			wrapResource(r, wrappedResourceOptions{
+				bootstrapContext: func(ctx context.Context, _ getAttributeFunc, _ getProviderMetaFunc, _ any) (context.Context, error) {
+					return ctx, nil
+				},
+				interceptors: interceptorInvocations{
+					{
+						when:        Before,
+						why:         CustomizeDiff,
+						interceptor: validateNamingPolicy(s, namePrefixAttributes(s)),
+					},
+				},
+				typeName: "aws_test",
+			})
+
+			var state *terraform.InstanceState
+			if testCase.prior != "" {
+				state = &terraform.InstanceState{
+					ID: "test",
+					Attributes: map[string]string{
+						names.AttrID:   "test",
+						names.AttrName: testCase.prior,
+					},
+				}
+			}
+			config := terraform.NewResourceConfigRaw(testCase.config)
+
+			_, err := r.SimpleDiff(ctx, state, config, mockNamingPolicyClient{policy: testCase.policy})
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("SimpleDiff() err %t, want %t: %v", got, want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+type plannedOperationTimeoutsKey struct{}
+
+// newPlannedOperationTimeoutsContext returns a Context into which the provider-level default operation timeouts
+// of the resource being planned are recorded.
+func newPlannedOperationTimeoutsContext(ctx context.Context, timeouts *conns.OperationTimeouts) context.Context {
+	return context.WithValue(ctx, plannedOperationTimeoutsKey{}, timeouts)
+}
+
+// recordOperationTimeouts records the provider-level default operation timeouts in effect for the resource being planned.
+// The timeouts are applied to the planned resource change by providerServer.
+func recordOperationTimeouts() customizeDiffInterceptor {
+	return interceptorFunc1[*schema.ResourceDiff, error](func(ctx context.Context, opts customizeDiffInterceptorOptions) error {
+		timeouts, ok := ctx.Value(plannedOperationTimeoutsKey{}).(*conns.OperationTimeouts)
+		if !ok {
+			return nil
+		}
+
+		inContext, ok := conns.FromContext(ctx)
+		if !ok {
+			return nil
+		}
+
+		switch when, why := opts.when, opts.why; when {
+		case Before:
+			switch why {
+			case CustomizeDiff:
+				*timeouts = inContext.OperationTimeouts()
+			}
+		}
+
+		return nil
+	})
+}
+
+// withOperationTimeouts returns the specified planned private state with any provider-level default operation timeouts applied.
+// Only operations for which the resource has its own default timeout are affected.
+// Timeouts configured in the resource's `timeouts` block take precedence.
+func withOperationTimeouts(resource *schema.Resource, config *tfprotov5.DynamicValue, private []byte, defaults conns.OperationTimeouts) ([]byte, error) {
+	if resource.Timeouts == nil || defaults == (conns.OperationTimeouts{}) {
+		return private, nil
+	}
+
+	meta := make(map[string]any)
+	if len(private) > 0 {
+		if err := json.Unmarshal(private, &meta); err != nil {
+			return nil, err
+		}
+	}
+
+	// The Plugin SDK V2 encodes the resolved timeouts into the planned private state.
+	// The prior private state planned for destruction may not contain them.
+	timeouts, ok := meta[schema.TimeoutKey].(map[string]any)
+	if !ok {
+		timeouts = make(map[string]any)
+		meta[schema.TimeoutKey] = timeouts
+	}
+
+	configured := configuredTimeouts(resource, config)
+	override := func(key string, timeout *time.Duration, v time.Duration) {
+		if timeout == nil || v == 0 || configured[key] {
+			return
+		}
+		timeouts[key] = v.Nanoseconds()
+	}
+
+	override(schema.TimeoutCreate, resource.Timeouts.Create, defaults.Create)
+	override(schema.TimeoutRead, resource.Timeouts.Read, defaults.Read)
+	override(schema.TimeoutUpdate, resource.Timeouts.Update, defaults.Update)
+	override(schema.TimeoutDelete, resource.Timeouts.Delete, defaults.Delete)
+
+	return json.Marshal(meta)
+}
+
+// configuredTimeouts returns the keys set in the resource's `timeouts` block in the specified configuration.
+func configuredTimeouts(resource *schema.Resource, config *tfprotov5.DynamicValue) map[string]bool {
+	if config == nil {
+		return nil
+	}
+
+	ty := resource.CoreConfigSchema().ImpliedType()
+	if !ty.IsObjectType() || !ty.HasAttribute(schema.TimeoutsConfigKey) {
+		return nil
+	}
+
+	val, err := msgpack.Unmarshal(config.MsgPack, ty)
+	if err != nil || val.IsNull() || !val.IsKnown() {
+		return nil
+	}
+
+	val = val.GetAttr(schema.TimeoutsConfigKey)
+	if val.IsNull() || !val.IsKnown() || !val.Type().IsObjectType() {
+		return nil
+	}
+
+	configured := make(map[string]bool)
+	for k, v := range val.AsValueMap() {
+		if !v.IsNull() {
+			configured[k] = true
+		}
+	}
+
+	return configured
+}
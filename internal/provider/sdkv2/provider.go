@@ -26,9 +26,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/guardrails"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2/internal/attribute"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -74,8 +77,16 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Optional:      true,
 					ConflictsWith: []string{"forbidden_account_ids"},
 				},
+				"allowed_regions": {
+					Type:          schema.TypeSet,
+					Elem:          &schema.Schema{Type: schema.TypeString},
+					Optional:      true,
+					ConflictsWith: []string{"forbidden_regions"},
+					Description:   "Regions in which resources can be managed and data sources read, including using the per-resource `region` argument.",
+				},
 				"assume_role":                   assumeRoleSchema(),
 				"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+				"backup_before_destroy":         backupBeforeDestroySchema(),
 				"custom_ca_bundle": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -112,6 +123,14 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "Protocol to use with EC2 metadata service endpoint." +
 						"Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 				},
+				"endpoint_url": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					Description: "Base URL of the endpoint to use for all AWS services, e.g. of a local AWS stand-in. " +
+						"Service-specific endpoints configured in the `endpoints` block or with `AWS_ENDPOINT_URL_<SERVICE>` environment variables take precedence. " +
+						"Takes precedence over the `AWS_ENDPOINT_URL` environment variable.",
+				},
 				"endpoints": endpointsSchema(),
 				"forbidden_account_ids": {
 					Type:          schema.TypeSet,
@@ -119,6 +138,14 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Optional:      true,
 					ConflictsWith: []string{"allowed_account_ids"},
 				},
+				"forbidden_regions": {
+					Type:          schema.TypeSet,
+					Elem:          &schema.Schema{Type: schema.TypeString},
+					Optional:      true,
+					ConflictsWith: []string{"allowed_regions"},
+					Description:   "Regions in which resources must not be managed nor data sources read, including using the per-resource `region` argument.",
+				},
+				"guardrails": guardrailsSchema(),
 				"http_proxy": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -131,6 +158,15 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 					Description: "URL of a proxy to use for HTTPS requests when accessing the AWS API. " +
 						"Can also be set using the `HTTPS_PROXY` or `https_proxy` environment variables.",
 				},
+				"iam_policy_validation": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Description: `The severity with which to report problems found by plan-time validation of IAM policy documents ` +
+						`against the bundled catalog of service actions, resource ARN formats and condition keys. ` +
+						`Valid values are "error", "warning", and "disabled". ` +
+						`When unset or "disabled", IAM policy documents are not validated by the provider. ` +
+						`Can also be configured with the ` + iampolicy.ValidationEnvVar + ` environment variable.`,
+				},
 				"ignore_tags": {
 					Type:        schema.TypeList,
 					Optional:    true,
@@ -168,12 +204,14 @@ func NewProvider(ctx context.Context) (*schema.Provider, error) {
 						"being executed. If the API request still fails, an error is\n" +
 						"thrown.",
 				},
+				"naming_policy": namingPolicySchema(),
 				"no_proxy": {
 					Type:     schema.TypeString,
 					Optional: true,
 					Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. " +
 						"Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
 				},
+				"operation_defaults": operationDefaultsSchema(),
 				"profile": {
 					Type:     schema.TypeString,
 					Optional: true,
@@ -367,6 +405,7 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		BaseEndpoint:                   d.Get("endpoint_url").(string),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
@@ -417,6 +456,10 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("allowed_regions"); ok && v.(*schema.Set).Len() > 0 {
+		config.AllowedRegions = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
 	if v, ok := d.GetOk("assume_role"); ok {
 		path := cty.GetAttrPath("assume_role")
 		v := v.([]any)
@@ -458,6 +501,10 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		})
 	}
 
+	if dg := validateAssumeRoleChain(cty.GetAttrPath("assume_role"), config.AssumeRole, config.AssumeRoleWithWebIdentity != nil); dg.HasError() {
+		return nil, append(diags, dg...)
+	}
+
 	if v, ok := d.GetOk("default_tags"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]any)[0].(map[string]any))
 	} else {
@@ -476,6 +523,50 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		config.ForbiddenAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("forbidden_regions"); ok && v.(*schema.Set).Len() > 0 {
+		config.ForbiddenRegions = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("backup_before_destroy"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		backupCfg, dg := expandBackupBeforeDestroyConfig(ctx, cty.GetAttrPath("backup_before_destroy").IndexInt(0), v.([]any)[0].(map[string]any))
+		diags = append(diags, dg...)
+		if dg.HasError() {
+			return nil, diags
+		}
+		config.BackupBeforeDestroyConfig = backupCfg
+	}
+
+	if v, ok := d.GetOk("guardrails"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		guardrailsCfg, dg := expandGuardrailsConfig(ctx, cty.GetAttrPath("guardrails").IndexInt(0), v.([]any)[0].(map[string]any))
+		diags = append(diags, dg...)
+		if dg.HasError() {
+			return nil, diags
+		}
+		config.GuardrailsConfig = guardrailsCfg
+
+		// guardrails.allowed_regions is superseded by the top-level allowed_regions.
+		if v, ok := v.([]any)[0].(map[string]any)["allowed_regions"].(*schema.Set); ok && v.Len() > 0 {
+			path := cty.GetAttrPath("guardrails").IndexInt(0).GetAttr("allowed_regions")
+			if len(config.AllowedRegions) > 0 || len(config.ForbiddenRegions) > 0 {
+				return nil, append(diags, errs.NewInvalidValueAttributeCombinationError(path, fmt.Sprintf("Attribute %q cannot be specified with the top-level \"allowed_regions\" or \"forbidden_regions\".", errs.PathString(path))))
+			}
+			config.AllowedRegions = flex.ExpandStringValueSet(v)
+		}
+	}
+
+	if v, ok := d.GetOk("naming_policy"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		namingPolicy, dg := expandNamingPolicy(ctx, cty.GetAttrPath("naming_policy").IndexInt(0), v.([]any)[0].(map[string]any))
+		diags = append(diags, dg...)
+		if dg.HasError() {
+			return nil, diags
+		}
+		config.NamingPolicy = namingPolicy
+	}
+
+	if _, ok := d.GetOkExists("s3_use_path_style"); ok {
+		config.S3UsePathStyleConfigured = true
+	}
+
 	if v, ok := d.GetOkExists("http_proxy"); ok {
 		if s, sok := v.(string); sok {
 			config.HTTPProxy = aws.String(s)
@@ -491,6 +582,15 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 		config.NoProxy = v
 	}
 
+	if v, ok := d.GetOk("operation_defaults"); ok && len(v.([]any)) > 0 {
+		operationDefaults, dg := expandOperationDefaults(ctx, cty.GetAttrPath("operation_defaults"), v.([]any))
+		diags = append(diags, dg...)
+		if dg.HasError() {
+			return nil, diags
+		}
+		config.OperationDefaults = operationDefaults
+	}
+
 	if v, ok := d.GetOk("ignore_tags"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, v.([]any)[0].(map[string]any))
 	} else {
@@ -504,6 +604,13 @@ func (p *sdkProvider) configure(ctx context.Context, d *schema.ResourceData) (an
 	}
 	config.TagPolicyConfig = tagCfg
 
+	iamPolicyCfg, dg := expandIAMPolicyValidationConfig(cty.GetAttrPath("iam_policy_validation"), d.Get("iam_policy_validation").(string))
+	diags = append(diags, dg...)
+	if dg.HasError() {
+		return nil, diags
+	}
+	config.IAMPolicyValidationConfig = iamPolicyCfg
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
@@ -611,6 +718,12 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 				})
 			}
 
+			if _, ok := r.SchemaMap()[names.TopLevelAssumeRoleAttribute]; !ok {
+				// Inject a top-level "assume_role" attribute.
+				// The per-resource IAM role override is available whether or not the data source supports the per-resource Region override.
+				injectAssumeRoleAttribute(r)
+			}
+
 			if !tfunique.IsHandleNil(v.Tags) {
 				interceptors = append(interceptors, interceptorInvocation{
 					when:        Before | After,
@@ -622,14 +735,19 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 			opts := wrappedDataSourceOptions{
 				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, getProviderMeta getProviderMetaFunc, meta any) (context.Context, error) {
 					var overrideRegion string
+					var assumeRole *conns.AssumeRole
 
-					if isRegionOverrideEnabled && getAttribute != nil {
-						if region, ok := getAttribute(names.AttrRegion); ok {
-							overrideRegion = region.(string)
+					if getAttribute != nil {
+						if isRegionOverrideEnabled {
+							if region, ok := getAttribute(names.AttrRegion); ok {
+								overrideRegion = region.(string)
+							}
 						}
+						assumeRole = overrideAssumeRole(getAttribute)
 					}
 
 					ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, v.TypeName, overrideRegion)
+					ctx = conns.NewOverrideAssumeRoleContext(ctx, assumeRole)
 					if c, ok := meta.(*conns.AWSClient); ok {
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
 						ctx = c.RegisterLogger(ctx)
@@ -746,6 +864,23 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 				}
 			}
 
+			if _, ok := r.SchemaMap()[names.TopLevelAssumeRoleAttribute]; !ok {
+				// Inject a top-level "assume_role" attribute.
+				// The per-resource IAM role override is available whether or not the resource supports the per-resource Region override.
+				injectAssumeRoleAttribute(r)
+
+				// If the resource defines no Update handler then add a stub to fake out 'Provider.Validate'.
+				if r.UpdateWithoutTimeout == nil {
+					r.UpdateWithoutTimeout = schema.NoopContext
+				}
+			}
+			// Moving a resource to a different AWS account is a replacement.
+			interceptors = append(interceptors, interceptorInvocation{
+				when:        Before,
+				why:         CustomizeDiff,
+				interceptor: forceNewIfAssumeRoleAccountChanges(),
+			})
+
 			if !tfunique.IsHandleNil(resource.Tags) {
 				interceptors = append(interceptors, interceptorInvocation{
 					when:        Before | After | Finally,
@@ -764,6 +899,49 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 				})
 			}
 
+			if v, ok := iampolicy.ResourceAttributes[typeName]; ok {
+				interceptors = append(interceptors, interceptorInvocation{
+					when:        Before,
+					why:         CustomizeDiff,
+					interceptor: validateIAMPolicies(v),
+				})
+			}
+
+			if v := namePrefixAttributes(r.SchemaMap()); len(v) > 0 {
+				interceptors = append(interceptors, interceptorInvocation{
+					when:        Before,
+					why:         CustomizeDiff,
+					interceptor: validateNamingPolicy(r.SchemaMap(), v),
+				})
+			}
+
+			interceptors = append(interceptors, interceptorInvocation{
+				when:        Before,
+				why:         CustomizeDiff,
+				interceptor: validateGuardrails(),
+			})
+			if r.Timeouts != nil {
+				interceptors = append(interceptors, interceptorInvocation{
+					when:        Before,
+					why:         CustomizeDiff,
+					interceptor: recordOperationTimeouts(),
+				})
+			}
+			interceptors = append(interceptors, interceptorInvocation{
+				when:        Before,
+				why:         Delete,
+				interceptor: enforceDestroyGuardrails(!tfunique.IsHandleNil(resource.Tags)),
+			})
+			if v, ok := sp.(conns.ServicePackageWithBackupBeforeDestroy); ok {
+				if f, ok := v.BackupBeforeDestroy(ctx)[typeName]; ok {
+					interceptors = append(interceptors, interceptorInvocation{
+						when:        Before,
+						why:         Delete,
+						interceptor: backupBeforeDestroy(f, !tfunique.IsHandleNil(resource.Tags)),
+					})
+				}
+			}
+
 			if len(resource.Identity.Attributes) > 0 {
 				r.Identity = newResourceIdentity(resource.Identity)
 
@@ -803,16 +981,23 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 				// bootstrapContext is run on all wrapped methods before any interceptors.
 				bootstrapContext: func(ctx context.Context, getAttribute getAttributeFunc, getProviderMeta getProviderMetaFunc, meta any) (context.Context, error) {
 					var overrideRegion string
+					var assumeRole *conns.AssumeRole
 
-					if isRegionOverrideEnabled && getAttribute != nil {
-						if region, ok := getAttribute(names.AttrRegion); ok && region != nil {
-							overrideRegion = region.(string)
+					if getAttribute != nil {
+						if isRegionOverrideEnabled {
+							if region, ok := getAttribute(names.AttrRegion); ok && region != nil {
+								overrideRegion = region.(string)
+							}
 						}
+						assumeRole = overrideAssumeRole(getAttribute)
 					}
 
 					ctx = conns.NewResourceContext(ctx, servicePackageName, resource.Name, resource.TypeName, overrideRegion)
+					ctx = conns.NewOverrideAssumeRoleContext(ctx, assumeRole)
 					if c, ok := meta.(*conns.AWSClient); ok {
+						ctx = conns.NewOperationTimeoutsContext(ctx, c.OperationTimeouts(ctx, servicePackageName, resource.TypeName))
 						ctx = tftags.NewContext(ctx, c.DefaultTagsConfig(ctx), c.IgnoreTagsConfig(ctx), c.TagPolicyConfig(ctx))
+						ctx = create.NewNamingPolicyContext(ctx, c.NamingPolicy(ctx))
 						ctx = c.RegisterLogger(ctx)
 						if s := c.RandomnessSource(); s != nil {
 							ctx = vcr.NewContext(ctx, s)
@@ -837,6 +1022,7 @@ func (p *sdkProvider) initialize(ctx context.Context) (map[string]conns.ServiceP
 			}
 			wrapResource(r, opts)
 			p.provider.ResourcesMap[typeName] = r
+
 		}
 	}
 
@@ -859,6 +1045,10 @@ func (p *sdkProvider) validateResourceSchemas(ctx context.Context) error {
 					continue
 				}
 			}
+			if _, ok := s[names.TopLevelAssumeRoleAttribute]; ok {
+				errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s data source", names.TopLevelAssumeRoleAttribute, typeName))
+				continue
+			}
 
 			if !tfunique.IsHandleNil(v.Tags) {
 				// The data source has opted in to transparent tagging.
@@ -886,6 +1076,10 @@ func (p *sdkProvider) validateResourceSchemas(ctx context.Context) error {
 					continue
 				}
 			}
+			if _, ok := s[names.TopLevelAssumeRoleAttribute]; ok {
+				errs = append(errs, fmt.Errorf("`%s` attribute is defined: %s resource", names.TopLevelAssumeRoleAttribute, typeName))
+				continue
+			}
 
 			if !tfunique.IsHandleNil(resource.Tags) {
 				// The resource has opted in to transparent tagging.
@@ -1049,6 +1243,199 @@ func assumeRoleWithWebIdentitySchema() *schema.Schema {
 	}
 }
 
+func backupBeforeDestroySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configuration block with settings to back up resources before they are destroyed or replaced.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"backup_tags": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Tags to apply to backups.",
+				},
+				"backup_vault_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Name of the AWS Backup vault for backups taken with AWS Backup.",
+				},
+				names.AttrIAMRoleARN: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidARN,
+					Description:  "ARN of the IAM role that AWS Backup assumes for backups taken with AWS Backup.",
+				},
+				"resource_types": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Patterns, e.g. `aws_db_*`, matching the types of resources to back up.",
+				},
+				names.AttrTags: {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Tags that resources to back up have.",
+				},
+				names.AttrTimeout: {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: verify.ValidDuration,
+					Description:  "Time to wait for a backup to complete. Defaults to `60m`.",
+				},
+			},
+		},
+	}
+}
+
+func operationDefaultsSchema() *schema.Schema {
+	timeoutSchema := func(operation string) *schema.Schema {
+		return &schema.Schema{
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: verify.ValidDuration,
+			Description:  fmt.Sprintf("Default %s timeout for matching resources that support one.", operation),
+		}
+	}
+
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		Description: "Default operation timeouts and retryable API error codes for resources of the matching types or service packages.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				schema.TimeoutCreate: timeoutSchema("Create"),
+				schema.TimeoutDelete: timeoutSchema("Delete"),
+				schema.TimeoutRead:   timeoutSchema("Read"),
+				"resource_types": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Patterns, e.g. `aws_eks_*`, matching resource types.",
+				},
+				"retryable_error_codes": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "API error codes, e.g. `ThrottlingException`, to retry in addition to the default retryable errors. Applies to the service packages in `service_packages`.",
+				},
+				"service_packages": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(names.ProviderPackages(), false),
+					},
+					Description: "Service package names, e.g. `eks`.",
+				},
+				schema.TimeoutUpdate: timeoutSchema("Update"),
+			},
+		},
+	}
+}
+
+func namingPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configuration block with the naming convention applied to generated resource names and validated against configured names.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"casing": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice([]string{create.NamingPolicyCasingLower, create.NamingPolicyCasingUpper}, false),
+					Description:  "Casing of resource names, `lower` or `upper`.",
+				},
+				"max_length": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+					Description:  "Maximum length of resource names. Longer generated names are truncated and a hash appended.",
+				},
+				names.AttrPrefix: {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Prefix of resource names.",
+				},
+				"separator": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "-",
+					Description: "Separator between the prefix or suffix and the rest of resource names.",
+				},
+				"suffix": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: "Suffix of resource names.",
+				},
+			},
+		},
+	}
+}
+
+func guardrailsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Optional:    true,
+		MaxItems:    1,
+		Description: "Configuration block with guardrail policies enforced across all resources.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"allowed_regions": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Regions in which resources can be managed and data sources read. Superseded by the top-level `allowed_regions` argument.",
+					Deprecated:  "allowed_regions is deprecated. Use the top-level allowed_regions argument instead.",
+				},
+				"destroy_protection": {
+					Type:        schema.TypeList,
+					Optional:    true,
+					Description: "Rules matching resources that must not be destroyed or replaced.",
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrName: {
+								Type:        schema.TypeString,
+								Optional:    true,
+								Description: "Name of the rule, reported in guardrail policy violations.",
+							},
+							"resource_types": {
+								Type:        schema.TypeSet,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Description: "Patterns, e.g. `aws_db_*`, matching the types of protected resources.",
+							},
+							names.AttrTags: {
+								Type:        schema.TypeMap,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+								Description: "Tags that protected resources have.",
+							},
+						},
+					},
+				},
+				"forbidden_resource_types": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Patterns, e.g. `aws_iam_user*`, matching the types of resources that must not be created.",
+				},
+				"max_deletions": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  "Maximum number of resources deleted in a single run. `0` means unlimited.",
+				},
+			},
+		},
+	}
+}
+
 func expandAssumeRoles(ctx context.Context, path cty.Path, tfList []any) (result []awsbase.AssumeRole, diags diag.Diagnostics) {
 	result = make([]awsbase.AssumeRole, len(tfList))
 
@@ -1076,6 +1463,34 @@ func expandAssumeRoles(ctx context.Context, path cty.Path, tfList []any) (result
 	return result, diags
 }
 
+// roleChainingMaxDuration is the maximum session duration of an IAM role assumed
+// using the credentials of another assumed role.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/id_roles_terms-and-concepts.html#iam-term-role-chaining.
+const roleChainingMaxDuration = 1 * time.Hour
+
+// validateAssumeRoleChain validates the hops of an assume_role chain.
+// Every hop after the first, and the first hop if assume_role_with_web_identity is configured,
+// is assumed using role credentials and so is subject to the role chaining session duration limit.
+func validateAssumeRoleChain(path cty.Path, assumeRoles []awsbase.AssumeRole, withWebIdentity bool) (diags diag.Diagnostics) {
+	for i, v := range assumeRoles {
+		if i == 0 && !withWebIdentity {
+			continue
+		}
+
+		if v.Duration > roleChainingMaxDuration {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				path.IndexInt(i).GetAttr("duration"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("IAM Role (%s) is assumed using the credentials of a previous role in the chain (hop %d of %d). "+
+					"AWS limits the session duration of chained roles to %s, but %s was configured.",
+					v.RoleARN, i+1, len(assumeRoles), roleChainingMaxDuration, v.Duration),
+			))
+		}
+	}
+
+	return diags
+}
+
 func expandAssumeRole(_ context.Context, path cty.Path, tfMap map[string]any) (result awsbase.AssumeRole, diags diag.Diagnostics) {
 	if v, ok := tfMap["role_arn"].(string); ok && v != "" {
 		result.RoleARN = v
@@ -1158,6 +1573,205 @@ func expandAssumeRoleWithWebIdentity(_ context.Context, tfMap map[string]any) *a
 	return &assumeRole
 }
 
+func expandBackupBeforeDestroyConfig(_ context.Context, path cty.Path, tfMap map[string]any) (*guardrails.BackupBeforeDestroyConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	config := &guardrails.BackupBeforeDestroyConfig{
+		Timeout: guardrails.BackupBeforeDestroyDefaultTimeout,
+	}
+
+	if v, ok := tfMap["backup_tags"].(map[string]any); ok && len(v) > 0 {
+		config.BackupTags = flex.ExpandStringValueMap(v)
+	}
+
+	if v, ok := tfMap["backup_vault_name"].(string); ok {
+		config.BackupVaultName = v
+	}
+
+	if v, ok := tfMap[names.AttrIAMRoleARN].(string); ok {
+		config.IAMRoleARN = v
+	}
+
+	if v, ok := tfMap["resource_types"].(*schema.Set); ok && v.Len() > 0 {
+		config.ResourceTypes = flex.ExpandStringValueSet(v)
+		diags = append(diags, validateGuardrailsPatterns(path.GetAttr("resource_types"), config.ResourceTypes)...)
+	}
+
+	if v, ok := tfMap[names.AttrTags].(map[string]any); ok && len(v) > 0 {
+		config.Selector.Tags = flex.ExpandStringValueMap(v)
+	}
+
+	if v, ok := tfMap[names.AttrTimeout].(string); ok && v != "" {
+		timeout, _ := time.ParseDuration(v)
+		config.Timeout = timeout
+	}
+
+	// EFS file systems are backed up with AWS Backup.
+	// The vault and role are only required here if EFS file systems are explicitly selected.
+	// Otherwise each EFS file system backup fails, and the file system is not deleted, if they are not configured.
+	if typeName := "aws_efs_file_system"; len(config.ResourceTypes) > 0 && config.MatchesResourceType(typeName) {
+		if config.BackupVaultName == "" {
+			path := path.GetAttr("backup_vault_name")
+			diags = append(diags, errs.NewInvalidValueAttributeCombinationError(path, fmt.Sprintf("Attribute %q must be specified to back up %s resources.", errs.PathString(path), typeName)))
+		}
+		if config.IAMRoleARN == "" {
+			path := path.GetAttr(names.AttrIAMRoleARN)
+			diags = append(diags, errs.NewInvalidValueAttributeCombinationError(path, fmt.Sprintf("Attribute %q must be specified to back up %s resources.", errs.PathString(path), typeName)))
+		}
+	}
+
+	return config, diags
+}
+
+func expandGuardrailsConfig(_ context.Context, path cty.Path, tfMap map[string]any) (*guardrails.Config, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	config := &guardrails.Config{}
+
+	if v, ok := tfMap["destroy_protection"].([]any); ok {
+		for i, v := range v {
+			tfMap, ok := v.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			path := path.GetAttr("destroy_protection").IndexInt(i)
+			rule := guardrails.DestroyProtectionRule{}
+
+			if v, ok := tfMap[names.AttrName].(string); ok {
+				rule.Name = v
+			}
+
+			if v, ok := tfMap["resource_types"].(*schema.Set); ok && v.Len() > 0 {
+				rule.ResourceTypes = flex.ExpandStringValueSet(v)
+				diags = append(diags, validateGuardrailsPatterns(path.GetAttr("resource_types"), rule.ResourceTypes)...)
+			}
+
+			if v, ok := tfMap[names.AttrTags].(map[string]any); ok && len(v) > 0 {
+				rule.Tags = flex.ExpandStringValueMap(v)
+			}
+
+			config.DestroyProtection = append(config.DestroyProtection, rule)
+		}
+	}
+
+	if v, ok := tfMap["forbidden_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+		config.ForbiddenResourceTypes = flex.ExpandStringValueSet(v)
+		diags = append(diags, validateGuardrailsPatterns(path.GetAttr("forbidden_resource_types"), config.ForbiddenResourceTypes)...)
+	}
+
+	if v, ok := tfMap["max_deletions"].(int); ok {
+		config.MaxDeletions = v
+	}
+
+	return config, diags
+}
+
+func expandNamingPolicy(_ context.Context, path cty.Path, tfMap map[string]any) (*create.NamingPolicy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	policy := &create.NamingPolicy{}
+
+	if v, ok := tfMap["casing"].(string); ok {
+		policy.Casing = v
+	}
+
+	if v, ok := tfMap[names.AttrPrefix].(string); ok {
+		policy.Prefix = v
+	}
+
+	if v, ok := tfMap["separator"].(string); ok {
+		policy.Separator = v
+	}
+
+	if v, ok := tfMap["suffix"].(string); ok {
+		policy.Suffix = v
+	}
+
+	if v, ok := tfMap["max_length"].(int); ok && v > 0 {
+		if n := policy.MinLength(); v < n {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(
+				path.GetAttr("max_length"),
+				"Invalid Attribute Value",
+				fmt.Sprintf("max_length (%d) must be at least %d to accommodate the prefix, suffix and a hash", v, n),
+			))
+		}
+		policy.MaxLength = v
+	}
+
+	return policy, diags
+}
+
+func expandOperationDefaults(_ context.Context, path cty.Path, tfList []any) ([]conns.OperationDefaults, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var apiObjects []conns.OperationDefaults
+
+	timeout := func(tfMap map[string]any, key string) time.Duration {
+		if v, ok := tfMap[key].(string); ok && v != "" {
+			timeout, _ := time.ParseDuration(v)
+			return timeout
+		}
+		return 0
+	}
+
+	for i, v := range tfList {
+		tfMap, ok := v.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		path := path.IndexInt(i)
+		apiObject := conns.OperationDefaults{
+			Timeouts: conns.OperationTimeouts{
+				Create: timeout(tfMap, schema.TimeoutCreate),
+				Read:   timeout(tfMap, schema.TimeoutRead),
+				Update: timeout(tfMap, schema.TimeoutUpdate),
+				Delete: timeout(tfMap, schema.TimeoutDelete),
+			},
+		}
+
+		if v, ok := tfMap["resource_types"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.ResourceTypes = flex.ExpandStringValueSet(v)
+			diags = append(diags, validateGuardrailsPatterns(path.GetAttr("resource_types"), apiObject.ResourceTypes)...)
+		}
+
+		if v, ok := tfMap["retryable_error_codes"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.RetryableErrorCodes = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["service_packages"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.ServicePackages = flex.ExpandStringValueSet(v)
+		}
+
+		if len(apiObject.ResourceTypes) == 0 && len(apiObject.ServicePackages) == 0 {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(path,
+				"Invalid Attribute Combination",
+				`At least one of "resource_types" or "service_packages" must be specified.`,
+			))
+		}
+
+		if len(apiObject.RetryableErrorCodes) > 0 && len(apiObject.ServicePackages) == 0 {
+			diags = append(diags, errs.NewAttributeErrorDiagnostic(path.GetAttr("retryable_error_codes"),
+				"Invalid Attribute Combination",
+				`"retryable_error_codes" applies to API clients and requires "service_packages" to be specified.`,
+			))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, diags
+}
+
+func validateGuardrailsPatterns(path cty.Path, patterns []string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, pattern := range patterns {
+		if err := guardrails.ValidatePattern(pattern); err != nil {
+			diags = append(diags, errs.NewInvalidValueAttributeErrorf(path, "Invalid resource type pattern %q: %s", pattern, err))
+		}
+	}
+
+	return diags
+}
+
 func expandDefaultTags(ctx context.Context, tfMap map[string]any) *tftags.DefaultConfig {
 	tags := make(map[string]any)
 	for _, ev := range os.Environ() {
@@ -1232,15 +1846,27 @@ func expandTagPolicyConfig(path cty.Path, severity string) (*tftags.TagPolicyCon
 	envSeverity := os.Getenv(tftags.TagPolicyComplianceEnvVar)
 	switch {
 	case severity != "" && severity != "disabled":
-		return &tftags.TagPolicyConfig{Severity: severity}, validateTagPolicySeverity(path, severity)
+		return &tftags.TagPolicyConfig{Severity: severity}, validateSeverity(path, severity)
+	case envSeverity != "" && severity != "disabled":
+		return &tftags.TagPolicyConfig{Severity: envSeverity}, validateSeverityEnvVar(tftags.TagPolicyComplianceEnvVar, envSeverity)
+	}
+
+	return nil, nil
+}
+
+func expandIAMPolicyValidationConfig(path cty.Path, severity string) (*iampolicy.ValidationConfig, diag.Diagnostics) {
+	envSeverity := os.Getenv(iampolicy.ValidationEnvVar)
+	switch {
+	case severity != "" && severity != "disabled":
+		return &iampolicy.ValidationConfig{Severity: severity}, validateSeverity(path, severity)
 	case envSeverity != "" && severity != "disabled":
-		return &tftags.TagPolicyConfig{Severity: envSeverity}, validateTagPolicySeverityEnvVar(envSeverity)
+		return &iampolicy.ValidationConfig{Severity: envSeverity}, validateSeverityEnvVar(iampolicy.ValidationEnvVar, envSeverity)
 	}
 
 	return nil, nil
 }
 
-func validateTagPolicySeverity(path cty.Path, s string) diag.Diagnostics {
+func validateSeverity(path cty.Path, s string) diag.Diagnostics {
 	var diags diag.Diagnostics
 	switch s {
 	case "error", "warning", "disabled":
@@ -1253,7 +1879,7 @@ const (
 	summaryInvalidEnvironmentVariableValue = "Invalid environment variable value"
 )
 
-func validateTagPolicySeverityEnvVar(s string) diag.Diagnostics {
+func validateSeverityEnvVar(name, s string) diag.Diagnostics {
 	var diags diag.Diagnostics
 	switch s {
 	case "error", "warning", "disabled":
@@ -1261,6 +1887,6 @@ func validateTagPolicySeverityEnvVar(s string) diag.Diagnostics {
 	}
 	return append(diags, errs.NewErrorDiagnostic(
 		summaryInvalidEnvironmentVariableValue,
-		fmt.Sprintf(`%s must be one of "error", "warning", or "disabled"`, tftags.TagPolicyComplianceEnvVar),
+		fmt.Sprintf(`%s must be one of "error", "warning", or "disabled"`, name),
 	))
 }
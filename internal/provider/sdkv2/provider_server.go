@@ -0,0 +1,223 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"iter"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-cty/cty/msgpack"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/guardrails"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// NewProtocol5ProviderServer returns a protocol version 5 provider server factory for the Plugin SDK V2 provider.
+// The provider server enables the PlanDestroy server capability so that the destroy protection and maximum deletions
+// guardrails are enforced when resources are planned for destruction, not only when they are deleted.
+// It also applies any provider-level default operation timeouts to planned resource changes.
+func NewProtocol5ProviderServer(provider *schema.Provider) func() tfprotov5.ProviderServer {
+	return func() tfprotov5.ProviderServer {
+		return &providerServer{
+			ProviderServer: provider.GRPCProvider(),
+			provider:       provider,
+		}
+	}
+}
+
+// providerServer is a Plugin SDK V2 provider server that plans the destruction of resources.
+// The Plugin SDK V2 does not call CustomizeDiff when planning destruction, so guardrails are enforced here.
+// The Plugin SDK V2 resolves operation timeouts after calling CustomizeDiff, so provider-level defaults are applied here.
+type providerServer struct {
+	tfprotov5.ProviderServer
+	provider *schema.Provider
+
+	servicePackageNames     map[string]string // Resource type name to service package name.
+	servicePackageNamesOnce sync.Once
+}
+
+// operationTimeoutsClient is the subset of the AWS client used to resolve provider-level default operation timeouts.
+type operationTimeoutsClient interface {
+	OperationTimeouts(ctx context.Context, servicePackageName, typeName string) conns.OperationTimeouts
+	ServicePackages(context.Context) iter.Seq[conns.ServicePackage]
+}
+
+func (s *providerServer) GetMetadata(ctx context.Context, request *tfprotov5.GetMetadataRequest) (*tfprotov5.GetMetadataResponse, error) {
+	response, err := s.ProviderServer.GetMetadata(ctx, request)
+	if response != nil {
+		response.ServerCapabilities = withPlanDestroy(response.ServerCapabilities)
+	}
+
+	return response, err
+}
+
+func (s *providerServer) GetProviderSchema(ctx context.Context, request *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	response, err := s.ProviderServer.GetProviderSchema(ctx, request)
+	if response != nil {
+		response.ServerCapabilities = withPlanDestroy(response.ServerCapabilities)
+	}
+
+	return response, err
+}
+
+func (s *providerServer) PlanResourceChange(ctx context.Context, request *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	var timeouts conns.OperationTimeouts
+	response, err := s.ProviderServer.PlanResourceChange(newPlannedOperationTimeoutsContext(ctx, &timeouts), request)
+	if err != nil || response == nil || hasErrorDiagnostic(response.Diagnostics) {
+		return response, err
+	}
+
+	resource, ok := s.provider.ResourcesMap[request.TypeName]
+	if !ok {
+		return response, nil
+	}
+
+	// Timeouts configured in the resource's `timeouts` block are recorded in the prior state on destruction.
+	config := request.Config
+	if isNullDynamicValue(request.ProposedNewState) {
+		// The Plugin SDK V2 returns the prior private state, unchanged, for destruction without calling CustomizeDiff,
+		// so the provider-level default delete timeout is resolved here.
+		timeouts = conns.OperationTimeouts{Delete: s.deleteTimeout(ctx, resource, request.TypeName)}
+		config = request.PriorState
+	}
+
+	private, err := withOperationTimeouts(resource, config, response.PlannedPrivate, timeouts)
+	if err != nil {
+		response.Diagnostics = append(response.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Applying provider-level default operation timeouts",
+			Detail:   err.Error(),
+		})
+		return response, nil
+	}
+	response.PlannedPrivate = private
+
+	c, ok := s.provider.Meta().(awsClient)
+	if !ok {
+		return response, nil
+	}
+
+	guardrailsConfig := c.GuardrailsConfig(ctx)
+	if guardrailsConfig == nil {
+		return response, nil
+	}
+
+	if isNullDynamicValue(request.PriorState) {
+		return response, nil
+	}
+
+	// The resource is planned for destruction or replacement.
+	if !isNullDynamicValue(request.ProposedNewState) && len(response.RequiresReplace) == 0 {
+		return response, nil
+	}
+
+	if v := guardrailsConfig.CheckDestroy(request.TypeName, priorStateTags(resource, request.PriorState)); v != nil {
+		response.Diagnostics = append(response.Diagnostics, guardrailsErrorDiagnostic(v))
+		return response, nil
+	}
+
+	if v := guardrailsConfig.CountDeletion(request.TypeName); v != nil {
+		response.Diagnostics = append(response.Diagnostics, guardrailsErrorDiagnostic(v))
+	}
+
+	return response, nil
+}
+
+// deleteTimeout returns the default delete timeout in effect for the specified resource type.
+// The resource's own default delete timeout is returned if no provider-level default applies,
+// replacing any provider-level default recorded in the prior private state.
+func (s *providerServer) deleteTimeout(ctx context.Context, resource *schema.Resource, typeName string) time.Duration {
+	if c, ok := s.provider.Meta().(operationTimeoutsClient); ok {
+		s.servicePackageNamesOnce.Do(func() {
+			s.servicePackageNames = make(map[string]string)
+			for sp := range c.ServicePackages(ctx) {
+				for _, r := range sp.SDKResources(ctx) {
+					s.servicePackageNames[r.TypeName] = sp.ServicePackageName()
+				}
+			}
+		})
+
+		if servicePackageName, ok := s.servicePackageNames[typeName]; ok {
+			if v := c.OperationTimeouts(ctx, servicePackageName, typeName).Delete; v != 0 {
+				return v
+			}
+		}
+	}
+
+	if resource.Timeouts != nil && resource.Timeouts.Delete != nil {
+		return *resource.Timeouts.Delete
+	}
+
+	return 0
+}
+
+func withPlanDestroy(capabilities *tfprotov5.ServerCapabilities) *tfprotov5.ServerCapabilities {
+	if capabilities == nil {
+		capabilities = &tfprotov5.ServerCapabilities{}
+	}
+	capabilities.PlanDestroy = true
+
+	return capabilities
+}
+
+func hasErrorDiagnostic(diags []*tfprotov5.Diagnostic) bool {
+	for _, diag := range diags {
+		if diag != nil && diag.Severity == tfprotov5.DiagnosticSeverityError {
+			return true
+		}
+	}
+
+	return false
+}
+
+func guardrailsErrorDiagnostic(v *guardrails.Violation) *tfprotov5.Diagnostic {
+	return &tfprotov5.Diagnostic{
+		Severity: tfprotov5.DiagnosticSeverityError,
+		Summary:  guardrails.Summary,
+		Detail:   v.Error(),
+	}
+}
+
+func isNullDynamicValue(v *tfprotov5.DynamicValue) bool {
+	if v == nil {
+		return true
+	}
+
+	null, err := v.IsNull()
+
+	return err == nil && null
+}
+
+// priorStateTags returns the known values of the resource's `tags_all` attribute in the specified prior state.
+func priorStateTags(resource *schema.Resource, state *tfprotov5.DynamicValue) map[string]string {
+	ty := resource.CoreConfigSchema().ImpliedType()
+	if !ty.IsObjectType() || !ty.HasAttribute(names.AttrTagsAll) {
+		return nil
+	}
+
+	val, err := msgpack.Unmarshal(state.MsgPack, ty)
+	if err != nil || val.IsNull() || !val.IsKnown() {
+		return nil
+	}
+
+	val = val.GetAttr(names.AttrTagsAll)
+	if val.IsNull() || !val.IsKnown() || !val.Type().Equals(cty.Map(cty.String)) {
+		return nil
+	}
+
+	tags := make(map[string]string, val.LengthInt())
+	for k, v := range val.AsValueMap() {
+		if v.IsNull() || !v.IsKnown() {
+			continue
+		}
+		tags[k] = v.AsString()
+	}
+
+	return tags
+}
@@ -0,0 +1,406 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"context"
+	"encoding/json"
+	"iter"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/guardrails"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestProviderServerPlanResourceChange(t *testing.T) {
+	t.Parallel()
+
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			names.AttrID:      tftypes.String,
+			names.AttrName:    tftypes.String,
+			names.AttrTagsAll: tftypes.Map{ElementType: tftypes.String},
+		},
+	}
+	state := func(name, environment string) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			names.AttrID:   tftypes.NewValue(tftypes.String, name),
+			names.AttrName: tftypes.NewValue(tftypes.String, name),
+			names.AttrTagsAll: tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+				"Environment": tftypes.NewValue(tftypes.String, environment),
+			}),
+		})
+	}
+	config := func(name string) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			names.AttrID:      tftypes.NewValue(tftypes.String, nil),
+			names.AttrName:    tftypes.NewValue(tftypes.String, name),
+			names.AttrTagsAll: tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+		})
+	}
+	null := tftypes.NewValue(objectType, nil)
+	destroyProtection := []guardrails.DestroyProtectionRule{
+		{
+			Selector: guardrails.Selector{
+				Tags: map[string]string{"Environment": "production"},
+			},
+		},
+	}
+
+	type change struct {
+		prior, proposed, config tftypes.Value
+	}
+	testCases := map[string]struct {
+		config    *guardrails.Config
+		changes   []change
+		wantError []bool
+	}{
+		"no guardrails": {
+			changes:   []change{{state("a", "production"), null, null}},
+			wantError: []bool{false},
+		},
+		"destroy protected": {
+			config:    &guardrails.Config{DestroyProtection: destroyProtection},
+			changes:   []change{{state("a", "production"), null, null}},
+			wantError: []bool{true},
+		},
+		"destroy unprotected": {
+			config:    &guardrails.Config{DestroyProtection: destroyProtection},
+			changes:   []change{{state("a", "development"), null, null}},
+			wantError: []bool{false},
+		},
+		"replace protected": {
+			config:    &guardrails.Config{DestroyProtection: destroyProtection},
+			changes:   []change{{state("a", "production"), state("b", "production"), config("b")}},
+			wantError: []bool{true},
+		},
+		"update protected": {
+			config:    &guardrails.Config{DestroyProtection: destroyProtection},
+			changes:   []change{{state("a", "production"), state("a", "production"), config("a")}},
+			wantError: []bool{false},
+		},
+		"create": {
+			config:    &guardrails.Config{MaxDeletions: 1},
+			changes:   []change{{null, config("a"), config("a")}, {null, config("b"), config("b")}},
+			wantError: []bool{false, false},
+		},
+		"max deletions": {
+			config: &guardrails.Config{MaxDeletions: 1},
+			changes: []change{
+				{state("a", "development"), null, null},
+				{state("b", "development"), state("c", "development"), config("c")},
+			},
+			wantError: []bool{false, true},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			provider := &schema.Provider{
+				ResourcesMap: map[string]*schema.Resource{
+					"aws_test": {
+						Schema: map[string]*schema.Schema{
+							names.AttrName: {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+							names.AttrTagsAll: {
+								Type:     schema.TypeMap,
+								Optional: true,
+								Computed: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+			}
+			provider.SetMeta(mockClient{guardrailsConfig: testCase.config})
+			server := NewProtocol5ProviderServer(provider)()
+
+			for i, change := range testCase.changes {
+				response, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+					TypeName:         "aws_test",
+					PriorState:       testDynamicValue(t, objectType, change.prior),
+					ProposedNewState: testDynamicValue(t, objectType, change.proposed),
+					Config:           testDynamicValue(t, objectType, change.config),
+				})
+				if err != nil {
+					t.Fatalf("change %d: unexpected error: %s", i, err)
+				}
+
+				if got, want := hasErrorDiagnostic(response.Diagnostics), testCase.wantError[i]; got != want {
+					t.Errorf("change %d: has error = %t, want %t: %v", i, got, want, response.Diagnostics)
+				}
+			}
+		})
+	}
+}
+
+func TestProviderServerGetProviderSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := t.Context()
+	server := NewProtocol5ProviderServer(&schema.Provider{})()
+
+	response, err := server.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if response.ServerCapabilities == nil || !response.ServerCapabilities.PlanDestroy {
+		t.Errorf("PlanDestroy server capability not enabled")
+	}
+}
+
+func TestProviderServerOperationTimeouts(t *testing.T) {
+	t.Parallel()
+
+	timeoutsType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			schema.TimeoutCreate: tftypes.String,
+			schema.TimeoutUpdate: tftypes.String,
+			schema.TimeoutDelete: tftypes.String,
+		},
+	}
+	objectType := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			names.AttrID:             tftypes.String,
+			names.AttrName:           tftypes.String,
+			schema.TimeoutsConfigKey: timeoutsType,
+		},
+	}
+	value := func(id, name string, configured map[string]string) tftypes.Value {
+		timeouts := tftypes.NewValue(timeoutsType, nil)
+		if configured != nil {
+			values := map[string]tftypes.Value{
+				schema.TimeoutCreate: tftypes.NewValue(tftypes.String, nil),
+				schema.TimeoutUpdate: tftypes.NewValue(tftypes.String, nil),
+				schema.TimeoutDelete: tftypes.NewValue(tftypes.String, nil),
+			}
+			for k, v := range configured {
+				values[k] = tftypes.NewValue(tftypes.String, v)
+			}
+			timeouts = tftypes.NewValue(timeoutsType, values)
+		}
+		idValue := tftypes.NewValue(tftypes.String, nil)
+		if id != "" {
+			idValue = tftypes.NewValue(tftypes.String, id)
+		}
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			names.AttrID:             idValue,
+			names.AttrName:           tftypes.NewValue(tftypes.String, name),
+			schema.TimeoutsConfigKey: timeouts,
+		})
+	}
+	null := tftypes.NewValue(objectType, nil)
+
+	testCases := map[string]struct {
+		defaults     conns.OperationTimeouts
+		priorState   tftypes.Value
+		priorPrivate []byte
+		config       tftypes.Value
+		want         map[string]time.Duration
+	}{
+		"create no provider defaults": {
+			priorState: null,
+			config:     value("", "a", nil),
+			want: map[string]time.Duration{
+				schema.TimeoutCreate: 10 * time.Minute,
+				schema.TimeoutDelete: 5 * time.Minute,
+			},
+		},
+		"create provider defaults": {
+			defaults:   conns.OperationTimeouts{Create: 30 * time.Minute, Update: 30 * time.Minute},
+			priorState: null,
+			config:     value("", "a", nil),
+			want: map[string]time.Duration{
+				schema.TimeoutCreate: 30 * time.Minute,
+				schema.TimeoutUpdate: 30 * time.Minute,
+				schema.TimeoutDelete: 5 * time.Minute,
+			},
+		},
+		"create configured": {
+			defaults:   conns.OperationTimeouts{Create: 30 * time.Minute, Delete: 15 * time.Minute},
+			priorState: null,
+			config:     value("", "a", map[string]string{schema.TimeoutCreate: "1m"}),
+			want: map[string]time.Duration{
+				schema.TimeoutCreate: 1 * time.Minute,
+				schema.TimeoutDelete: 15 * time.Minute,
+			},
+		},
+		"update provider defaults": {
+			defaults:   conns.OperationTimeouts{Update: 30 * time.Minute, Delete: 15 * time.Minute},
+			priorState: value("a", "a", nil),
+			config:     value("", "b", nil),
+			want: map[string]time.Duration{
+				schema.TimeoutCreate: 10 * time.Minute,
+				schema.TimeoutUpdate: 30 * time.Minute,
+				schema.TimeoutDelete: 15 * time.Minute,
+			},
+		},
+		"update configured": {
+			defaults:   conns.OperationTimeouts{Update: 30 * time.Minute, Delete: 15 * time.Minute},
+			priorState: value("a", "a", map[string]string{schema.TimeoutUpdate: "2m"}),
+			config:     value("", "b", map[string]string{schema.TimeoutUpdate: "2m"}),
+			want: map[string]time.Duration{
+				schema.TimeoutUpdate: 2 * time.Minute,
+				schema.TimeoutDelete: 15 * time.Minute,
+			},
+		},
+		"destroy provider defaults": {
+			defaults:   conns.OperationTimeouts{Create: 30 * time.Minute, Delete: 15 * time.Minute},
+			priorState: value("a", "a", nil),
+			config:     null,
+			want: map[string]time.Duration{
+				schema.TimeoutDelete: 15 * time.Minute,
+			},
+		},
+		"destroy provider defaults prior private": {
+			defaults:     conns.OperationTimeouts{Delete: 15 * time.Minute},
+			priorState:   value("a", "a", nil),
+			priorPrivate: []byte(`{"e2bfb730-ecaa-11e6-8f88-34363bc7c4c0":{"create":600000000000,"delete":300000000000},"schema_version":"0"}`),
+			config:       null,
+			want: map[string]time.Duration{
+				schema.TimeoutCreate: 10 * time.Minute,
+				schema.TimeoutDelete: 15 * time.Minute,
+			},
+		},
+		"destroy no provider defaults prior private": {
+			priorState:   value("a", "a", nil),
+			priorPrivate: []byte(`{"e2bfb730-ecaa-11e6-8f88-34363bc7c4c0":{"create":600000000000,"delete":900000000000},"schema_version":"0"}`),
+			config:       null,
+			want: map[string]time.Duration{
+				schema.TimeoutCreate: 10 * time.Minute,
+				schema.TimeoutDelete: 5 * time.Minute,
+			},
+		},
+		"destroy configured": {
+			defaults:     conns.OperationTimeouts{Delete: 15 * time.Minute},
+			priorState:   value("a", "a", map[string]string{schema.TimeoutDelete: "3m"}),
+			priorPrivate: []byte(`{"e2bfb730-ecaa-11e6-8f88-34363bc7c4c0":{"create":600000000000,"delete":180000000000},"schema_version":"0"}`),
+			config:       null,
+			want: map[string]time.Duration{
+				schema.TimeoutDelete: 3 * time.Minute,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrName: {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+				},
+				Timeouts: &schema.ResourceTimeout{
+					Create: schema.DefaultTimeout(10 * time.Minute),
+					Update: schema.DefaultTimeout(10 * time.Minute),
+					Delete: schema.DefaultTimeout(5 * time.Minute),
+				},
+			}
+			wrapResource(r, wrappedResourceOptions{
+				bootstrapContext: func(ctx context.Context, _ getAttributeFunc, _ getProviderMetaFunc, _ any) (context.Context, error) {
+					ctx = conns.NewResourceContext(ctx, "test", "Test", "aws_test", "")
+					ctx = conns.NewOperationTimeoutsContext(ctx, testCase.defaults)
+					return ctx, nil
+				},
+				interceptors: interceptorInvocations{
+					{
+						when:        Before,
+						why:         CustomizeDiff,
+						interceptor: recordOperationTimeouts(),
+					},
+				},
+				typeName: "aws_test",
+			})
+			provider := &schema.Provider{
+				ResourcesMap: map[string]*schema.Resource{
+					"aws_test": r,
+				},
+			}
+			provider.SetMeta(operationTimeoutsMockClient{defaults: testCase.defaults})
+			server := NewProtocol5ProviderServer(provider)()
+
+			response, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+				TypeName:         "aws_test",
+				PriorState:       testDynamicValue(t, objectType, testCase.priorState),
+				PriorPrivate:     testCase.priorPrivate,
+				ProposedNewState: testDynamicValue(t, objectType, testCase.config),
+				Config:           testDynamicValue(t, objectType, testCase.config),
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if hasErrorDiagnostic(response.Diagnostics) {
+				t.Fatalf("unexpected diagnostics: %v", response.Diagnostics)
+			}
+
+			var meta map[string]any
+			if len(response.PlannedPrivate) > 0 {
+				if err := json.Unmarshal(response.PlannedPrivate, &meta); err != nil {
+					t.Fatalf("decoding planned private state: %s", err)
+				}
+			}
+			timeouts, _ := meta[schema.TimeoutKey].(map[string]any)
+
+			for key, want := range testCase.want {
+				if got := schema.DefaultTimeout(timeouts[key]); got == nil || *got != want {
+					t.Errorf("%s timeout = %v, want %s", key, got, want)
+				}
+			}
+		})
+	}
+}
+
+type operationTimeoutsMockClient struct {
+	mockClient
+	defaults conns.OperationTimeouts
+}
+
+func (c operationTimeoutsMockClient) OperationTimeouts(context.Context, string, string) conns.OperationTimeouts {
+	return c.defaults
+}
+
+func (c operationTimeoutsMockClient) ServicePackages(context.Context) iter.Seq[conns.ServicePackage] {
+	return slices.Values([]conns.ServicePackage{&operationTimeoutsMockService{}})
+}
+
+type operationTimeoutsMockService struct {
+	mockService
+}
+
+func (s *operationTimeoutsMockService) SDKResources(context.Context) []*inttypes.ServicePackageSDKResource {
+	return []*inttypes.ServicePackageSDKResource{
+		{
+			TypeName: "aws_test",
+		},
+	}
+}
+
+func testDynamicValue(t *testing.T, typ tftypes.Type, value tftypes.Value) *tfprotov5.DynamicValue {
+	t.Helper()
+
+	v, err := tfprotov5.NewDynamicValue(typ, value)
+	if err != nil {
+		t.Fatalf("creating dynamic value: %s", err)
+	}
+
+	return &v
+}
@@ -7,8 +7,10 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -439,3 +441,67 @@ func popEnv(env []string) {
 		os.Setenv(k, v)
 	}
 }
+
+func TestValidateAssumeRoleChain(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		assumeRoles     []awsbase.AssumeRole
+		withWebIdentity bool
+		expectedPaths   []cty.Path
+	}{
+		"no assume_role": {},
+		"single hop": {
+			assumeRoles: []awsbase.AssumeRole{
+				{RoleARN: "arn:aws:iam::111111111111:role/one", Duration: 12 * time.Hour},
+			},
+		},
+		"single hop with web identity": {
+			assumeRoles: []awsbase.AssumeRole{
+				{RoleARN: "arn:aws:iam::111111111111:role/one", Duration: 2 * time.Hour},
+			},
+			withWebIdentity: true,
+			expectedPaths: []cty.Path{
+				cty.GetAttrPath("assume_role").IndexInt(0).GetAttr("duration"),
+			},
+		},
+		"multiple hops within limit": {
+			assumeRoles: []awsbase.AssumeRole{
+				{RoleARN: "arn:aws:iam::111111111111:role/one", Duration: 12 * time.Hour},
+				{RoleARN: "arn:aws:iam::222222222222:role/two", Duration: 1 * time.Hour},
+				{RoleARN: "arn:aws:iam::333333333333:role/three"},
+			},
+		},
+		"multiple hops exceeding limit": {
+			assumeRoles: []awsbase.AssumeRole{
+				{RoleARN: "arn:aws:iam::111111111111:role/one", Duration: 12 * time.Hour},
+				{RoleARN: "arn:aws:iam::222222222222:role/two", Duration: 90 * time.Minute},
+				{RoleARN: "arn:aws:iam::333333333333:role/three", Duration: 2 * time.Hour},
+			},
+			expectedPaths: []cty.Path{
+				cty.GetAttrPath("assume_role").IndexInt(1).GetAttr("duration"),
+				cty.GetAttrPath("assume_role").IndexInt(2).GetAttr("duration"),
+			},
+		},
+	}
+
+	for name, testcase := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := validateAssumeRoleChain(cty.GetAttrPath("assume_role"), testcase.assumeRoles, testcase.withWebIdentity)
+
+			var paths []cty.Path
+			for _, d := range diags {
+				if d.Severity != diag.Error {
+					t.Errorf("unexpected severity %v", d.Severity)
+				}
+				paths = append(paths, d.AttributePath)
+			}
+
+			if diff := cmp.Diff(paths, testcase.expectedPaths, cmp.Comparer(func(a, b cty.Path) bool { return a.Equals(b) })); diff != "" {
+				t.Errorf("unexpected attribute paths difference: %s", diff)
+			}
+		})
+	}
+}
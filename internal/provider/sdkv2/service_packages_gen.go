@@ -29,7 +29,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/apprunner"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appstream"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/appsync"
-	"github.com/hashicorp/terraform-provider-aws/internal/service/arcregionswitch"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/arczonalshift"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/athena"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/auditmanager"
@@ -226,7 +225,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/s3outposts"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/s3tables"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/s3vectors"
-	"github.com/hashicorp/terraform-provider-aws/internal/service/sagemaker"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/savingsplans"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/scheduler"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/schemas"
@@ -270,7 +268,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/service/wellarchitected"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/workmail"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/workspaces"
-	"github.com/hashicorp/terraform-provider-aws/internal/service/workspacesweb"
 	"github.com/hashicorp/terraform-provider-aws/internal/service/xray"
 )
 
@@ -295,7 +292,6 @@ func servicePackages(ctx context.Context) []conns.ServicePackage {
 		apprunner.ServicePackage(ctx),
 		appstream.ServicePackage(ctx),
 		appsync.ServicePackage(ctx),
-		arcregionswitch.ServicePackage(ctx),
 		arczonalshift.ServicePackage(ctx),
 		athena.ServicePackage(ctx),
 		auditmanager.ServicePackage(ctx),
@@ -492,7 +488,6 @@ func servicePackages(ctx context.Context) []conns.ServicePackage {
 		s3outposts.ServicePackage(ctx),
 		s3tables.ServicePackage(ctx),
 		s3vectors.ServicePackage(ctx),
-		sagemaker.ServicePackage(ctx),
 		savingsplans.ServicePackage(ctx),
 		scheduler.ServicePackage(ctx),
 		schemas.ServicePackage(ctx),
@@ -536,7 +531,6 @@ func servicePackages(ctx context.Context) []conns.ServicePackage {
 		wellarchitected.ServicePackage(ctx),
 		workmail.ServicePackage(ctx),
 		workspaces.ServicePackage(ctx),
-		workspacesweb.ServicePackage(ctx),
 		xray.ServicePackage(ctx),
 	}
 
@@ -0,0 +1,446 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schemasnapshot
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// Change is a single difference between two snapshots.
+type Change struct {
+	// Kind is the kind of schema which changed, e.g. "resource" or "function".
+	Kind string
+	// Name is the type name of the resource, data source, etc., or the function name.
+	Name string
+	// Path is the dot-separated path to the changed attribute or block. It is empty for top-level changes.
+	Path string
+	// Message describes the change.
+	Message string
+	// Breaking is true if the change may break existing configurations or state.
+	Breaking bool
+}
+
+func (c Change) String() string {
+	var sb strings.Builder
+
+	if c.Breaking {
+		sb.WriteString("BREAKING: ")
+	}
+	fmt.Fprintf(&sb, "%s %s", c.Kind, c.Name)
+	if c.Path != "" {
+		fmt.Fprintf(&sb, ": %s", c.Path)
+	}
+	fmt.Fprintf(&sb, ": %s", c.Message)
+
+	return sb.String()
+}
+
+// Schema kinds reported in changes.
+const (
+	KindProvider          = "provider"
+	KindResource          = "resource"
+	KindDataSource        = "data source"
+	KindEphemeralResource = "ephemeral resource"
+	KindAction            = "action"
+	KindListResource      = "list resource"
+	KindFunction          = "function"
+)
+
+// Compare returns the differences between an old and a new snapshot, sorted by kind, name and path.
+func Compare(old, new *Snapshot) []Change {
+	c := &comparer{}
+
+	if old.Provider != nil && new.Provider != nil {
+		c.compareBlock(KindProvider, "aws", "", old.Provider.Block, new.Provider.Block)
+	}
+
+	c.compareSchemas(KindResource, old.Resources, new.Resources)
+	c.compareSchemas(KindDataSource, old.DataSources, new.DataSources)
+	c.compareSchemas(KindEphemeralResource, old.EphemeralResources, new.EphemeralResources)
+	c.compareSchemas(KindAction, old.Actions, new.Actions)
+	c.compareSchemas(KindListResource, old.ListResources, new.ListResources)
+	c.compareFunctions(old.Functions, new.Functions)
+
+	slices.SortStableFunc(c.changes, func(a, b Change) int {
+		return cmp.Or(
+			cmp.Compare(a.Kind, b.Kind),
+			cmp.Compare(a.Name, b.Name),
+			cmp.Compare(a.Path, b.Path),
+			cmp.Compare(a.Message, b.Message),
+		)
+	})
+
+	return c.changes
+}
+
+// HasBreakingChanges returns whether any of the changes is breaking.
+func HasBreakingChanges(changes []Change) bool {
+	return slices.ContainsFunc(changes, func(c Change) bool {
+		return c.Breaking
+	})
+}
+
+type comparer struct {
+	changes []Change
+}
+
+func (c *comparer) add(kind, name, path string, breaking bool, format string, a ...any) {
+	c.changes = append(c.changes, Change{
+		Kind:     kind,
+		Name:     name,
+		Path:     path,
+		Message:  fmt.Sprintf(format, a...),
+		Breaking: breaking,
+	})
+}
+
+func (c *comparer) compareSchemas(kind string, old, new map[string]*Schema) {
+	for _, name := range slices.Sorted(maps.Keys(old)) {
+		n, ok := new[name]
+		if !ok {
+			c.add(kind, name, "", true, "removed")
+			continue
+		}
+
+		o := old[name]
+		if kind == KindResource && n.Version < o.Version {
+			c.add(kind, name, "", true, "schema version decreased from %d to %d", o.Version, n.Version)
+		}
+
+		c.compareBlock(kind, name, "", o.Block, n.Block)
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(new)) {
+		if _, ok := old[name]; !ok {
+			c.add(kind, name, "", false, "added")
+		}
+	}
+}
+
+func (c *comparer) compareBlock(kind, name, path string, old, new *Block) {
+	if old == nil {
+		old = newBlock()
+	}
+	if new == nil {
+		new = newBlock()
+	}
+
+	c.compareAttributes(kind, name, path, old.Attributes, new.Attributes, old.Blocks, new.Blocks)
+
+	for _, k := range slices.Sorted(maps.Keys(old.Blocks)) {
+		p := joinPath(path, k)
+		o := old.Blocks[k]
+		n, ok := new.Blocks[k]
+		if !ok {
+			if _, ok := new.Attributes[k]; ok {
+				c.add(kind, name, p, true, "changed from block to attribute")
+			} else {
+				c.add(kind, name, p, true, "block removed")
+			}
+			continue
+		}
+
+		c.compareNestedBlock(kind, name, p, o, n)
+	}
+
+	for _, k := range slices.Sorted(maps.Keys(new.Blocks)) {
+		if _, ok := old.Blocks[k]; ok {
+			continue
+		}
+
+		p := joinPath(path, k)
+		if _, ok := old.Attributes[k]; ok {
+			c.add(kind, name, p, true, "changed from attribute to block")
+			continue
+		}
+
+		n := new.Blocks[k]
+		if n.MinItems > 0 {
+			c.add(kind, name, p, true, "required block added")
+		} else {
+			c.add(kind, name, p, false, "block added")
+		}
+	}
+}
+
+func (c *comparer) compareNestedBlock(kind, name, path string, old, new *NestedBlock) {
+	if old.Nesting != new.Nesting {
+		c.add(kind, name, path, true, "nesting mode changed from %s to %s", old.Nesting, new.Nesting)
+	}
+
+	if new.MinItems > old.MinItems {
+		c.add(kind, name, path, true, "min_items increased from %d to %d", old.MinItems, new.MinItems)
+	} else if new.MinItems < old.MinItems {
+		c.add(kind, name, path, false, "min_items decreased from %d to %d", old.MinItems, new.MinItems)
+	}
+
+	c.compareMaxItems(kind, name, path, old.MaxItems, new.MaxItems)
+	c.compareForceNew(kind, name, path, old.ForceNew, new.ForceNew)
+	c.compareDeprecated(kind, name, path, old.Deprecated, new.Deprecated)
+	c.compareValidators(kind, name, path, old.Validators, new.Validators)
+	c.compareBlock(kind, name, path, old.Block, new.Block)
+}
+
+// compareAttributes compares attributes.
+// Changes between attributes and blocks are reported by compareBlock.
+func (c *comparer) compareAttributes(kind, name, path string, old, new map[string]*Attribute, oldBlocks, newBlocks map[string]*NestedBlock) {
+	for _, k := range slices.Sorted(maps.Keys(old)) {
+		p := joinPath(path, k)
+		o := old[k]
+		n, ok := new[k]
+		if !ok {
+			if _, ok := newBlocks[k]; !ok {
+				c.add(kind, name, p, true, "attribute removed")
+			}
+			continue
+		}
+
+		c.compareAttribute(kind, name, p, o, n)
+	}
+
+	for _, k := range slices.Sorted(maps.Keys(new)) {
+		if _, ok := old[k]; ok {
+			continue
+		}
+		if _, ok := oldBlocks[k]; ok {
+			continue
+		}
+
+		p := joinPath(path, k)
+		if new[k].Required {
+			c.add(kind, name, p, true, "required attribute added")
+		} else {
+			c.add(kind, name, p, false, "attribute added")
+		}
+	}
+}
+
+func (c *comparer) compareAttribute(kind, name, path string, old, new *Attribute) {
+	if old.Type != new.Type {
+		c.add(kind, name, path, true, "type changed from %s to %s", typeOrNested(old), typeOrNested(new))
+	}
+
+	switch o, n := old.NestedType, new.NestedType; {
+	case o != nil && n != nil:
+		if o.Nesting != n.Nesting {
+			c.add(kind, name, path, true, "nesting mode changed from %s to %s", o.Nesting, n.Nesting)
+		}
+		c.compareAttributes(kind, name, path, o.Attributes, n.Attributes, nil, nil)
+	case o != nil || n != nil:
+		if old.Type == new.Type {
+			c.add(kind, name, path, true, "type changed from %s to %s", typeOrNested(old), typeOrNested(new))
+		}
+	}
+
+	switch {
+	case old.Optional && new.Required:
+		c.add(kind, name, path, true, "changed from Optional to Required")
+	case old.Required && new.Optional:
+		c.add(kind, name, path, false, "changed from Required to Optional")
+	case isConfigurable(old) && !isConfigurable(new):
+		c.add(kind, name, path, true, "no longer configurable")
+	case !isConfigurable(old) && new.Required:
+		c.add(kind, name, path, true, "changed from Computed to Required")
+	case !isConfigurable(old) && new.Optional:
+		c.add(kind, name, path, false, "changed from Computed to Optional")
+	}
+
+	if old.Optional && new.Optional && old.Computed && !new.Computed {
+		c.add(kind, name, path, true, "no longer Computed")
+	}
+
+	if !old.Sensitive && new.Sensitive {
+		c.add(kind, name, path, true, "marked Sensitive")
+	} else if old.Sensitive && !new.Sensitive {
+		c.add(kind, name, path, false, "no longer Sensitive")
+	}
+
+	if old.WriteOnly != new.WriteOnly {
+		c.add(kind, name, path, true, "WriteOnly changed from %t to %t", old.WriteOnly, new.WriteOnly)
+	}
+
+	c.compareForceNew(kind, name, path, old.ForceNew, new.ForceNew)
+
+	switch {
+	case old.Default == new.Default:
+	case old.Default == "":
+		c.add(kind, name, path, true, "default added (%s)", new.Default)
+	case new.Default == "":
+		c.add(kind, name, path, true, "default removed (was %s)", old.Default)
+	default:
+		c.add(kind, name, path, true, "default changed from %s to %s", old.Default, new.Default)
+	}
+
+	if new.MinItems > old.MinItems {
+		c.add(kind, name, path, true, "min_items increased from %d to %d", old.MinItems, new.MinItems)
+	} else if new.MinItems < old.MinItems {
+		c.add(kind, name, path, false, "min_items decreased from %d to %d", old.MinItems, new.MinItems)
+	}
+
+	c.compareMaxItems(kind, name, path, old.MaxItems, new.MaxItems)
+	c.compareDeprecated(kind, name, path, old.Deprecated, new.Deprecated)
+	c.compareValidators(kind, name, path, old.Validators, new.Validators)
+}
+
+func (c *comparer) compareForceNew(kind, name, path string, old, new bool) {
+	if !old && new {
+		c.add(kind, name, path, true, "ForceNew added")
+	} else if old && !new {
+		c.add(kind, name, path, false, "ForceNew removed")
+	}
+}
+
+func (c *comparer) compareMaxItems(kind, name, path string, old, new int) {
+	switch {
+	case old == new:
+	case new != 0 && (old == 0 || new < old):
+		c.add(kind, name, path, true, "max_items decreased from %s to %d", maxItemsString(old), new)
+	default:
+		c.add(kind, name, path, false, "max_items increased from %d to %s", old, maxItemsString(new))
+	}
+}
+
+func (c *comparer) compareDeprecated(kind, name, path string, old, new string) {
+	if old == "" && new != "" {
+		c.add(kind, name, path, false, "deprecated: %s", new)
+	}
+}
+
+var oneOfRegexp = regexp.MustCompile(`^value must be one of: \[(.*)\]$`)
+
+// compareValidators classifies validation changes.
+// Any validator which did not previously exist tightens validation, unless it is an enumeration which is a
+// superset of a previous enumeration. Removing validators relaxes validation.
+func (c *comparer) compareValidators(kind, name, path string, old, new []string) {
+	var added, removed []string
+
+	for _, v := range new {
+		if !slices.Contains(old, v) {
+			added = append(added, v)
+		}
+	}
+	for _, v := range old {
+		if !slices.Contains(new, v) {
+			removed = append(removed, v)
+		}
+	}
+
+	for _, a := range added {
+		relaxed := false
+
+		if m := oneOfRegexp.FindStringSubmatch(a); m != nil {
+			newValues := strings.Fields(m[1])
+			for i, r := range removed {
+				if m := oneOfRegexp.FindStringSubmatch(r); m != nil {
+					if oldValues := strings.Fields(m[1]); isSubset(oldValues, newValues) {
+						relaxed = true
+						removed = slices.Delete(removed, i, i+1)
+						break
+					}
+				}
+			}
+		}
+
+		if relaxed {
+			c.add(kind, name, path, false, "validation relaxed: %s", a)
+		} else {
+			c.add(kind, name, path, true, "validation tightened: %s", a)
+		}
+	}
+
+	for _, r := range removed {
+		c.add(kind, name, path, false, "validation removed: %s", r)
+	}
+}
+
+func (c *comparer) compareFunctions(old, new map[string]*Function) {
+	for _, name := range slices.Sorted(maps.Keys(old)) {
+		o := old[name]
+		n, ok := new[name]
+		if !ok {
+			c.add(KindFunction, name, "", true, "removed")
+			continue
+		}
+
+		if len(o.Parameters) != len(n.Parameters) {
+			c.add(KindFunction, name, "", true, "number of parameters changed from %d to %d", len(o.Parameters), len(n.Parameters))
+		} else {
+			for i := range o.Parameters {
+				c.compareParameter(name, fmt.Sprintf("parameter %d", i+1), o.Parameters[i], n.Parameters[i])
+			}
+		}
+
+		switch {
+		case o.VariadicParameter != nil && n.VariadicParameter != nil:
+			c.compareParameter(name, "variadic parameter", o.VariadicParameter, n.VariadicParameter)
+		case o.VariadicParameter != nil:
+			c.add(KindFunction, name, "", true, "variadic parameter removed")
+		case n.VariadicParameter != nil:
+			c.add(KindFunction, name, "", false, "variadic parameter added")
+		}
+
+		if o.Return != n.Return {
+			c.add(KindFunction, name, "", true, "return type changed from %s to %s", o.Return, n.Return)
+		}
+
+		c.compareDeprecated(KindFunction, name, "", o.Deprecated, n.Deprecated)
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(new)) {
+		if _, ok := old[name]; !ok {
+			c.add(KindFunction, name, "", false, "added")
+		}
+	}
+}
+
+func (c *comparer) compareParameter(name, path string, old, new *Parameter) {
+	if old.Type != new.Type {
+		c.add(KindFunction, name, path, true, "type changed from %s to %s", old.Type, new.Type)
+	}
+
+	if old.AllowNullValue && !new.AllowNullValue {
+		c.add(KindFunction, name, path, true, "null value no longer allowed")
+	} else if !old.AllowNullValue && new.AllowNullValue {
+		c.add(KindFunction, name, path, false, "null value allowed")
+	}
+}
+
+func isConfigurable(a *Attribute) bool {
+	return a.Required || a.Optional
+}
+
+func typeOrNested(a *Attribute) string {
+	if a.NestedType != nil {
+		return fmt.Sprintf("nested %s", a.NestedType.Nesting)
+	}
+	return a.Type
+}
+
+func maxItemsString(n int) string {
+	if n == 0 {
+		return "unlimited"
+	}
+	return fmt.Sprintf("%d", n)
+}
+
+func isSubset(a, b []string) bool {
+	for _, v := range a {
+		if !slices.Contains(b, v) {
+			return false
+		}
+	}
+	return true
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,300 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schemasnapshot_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/schemasnapshot"
+)
+
+func testResourceSnapshot(attributes map[string]*schemasnapshot.Attribute, blocks map[string]*schemasnapshot.NestedBlock) *schemasnapshot.Snapshot {
+	return &schemasnapshot.Snapshot{
+		FormatVersion: schemasnapshot.FormatVersion,
+		Resources: map[string]*schemasnapshot.Schema{
+			"aws_test": {
+				Block: &schemasnapshot.Block{
+					Attributes: attributes,
+					Blocks:     blocks,
+				},
+			},
+		},
+	}
+}
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		old      *schemasnapshot.Snapshot
+		new      *schemasnapshot.Snapshot
+		expected []string
+	}
+	tests := map[string]testCase{
+		"no changes": {
+			old: testResourceSnapshot(map[string]*schemasnapshot.Attribute{
+				"name": {Type: "string", Required: true},
+			}, nil),
+			new: testResourceSnapshot(map[string]*schemasnapshot.Attribute{
+				"name": {Type: "string", Required: true},
+			}, nil),
+		},
+		"resource removed": {
+			old: testResourceSnapshot(nil, nil),
+			new: &schemasnapshot.Snapshot{},
+			expected: []string{
+				"BREAKING: resource aws_test: removed",
+			},
+		},
+		"resource added": {
+			old: &schemasnapshot.Snapshot{},
+			new: testResourceSnapshot(nil, nil),
+			expected: []string{
+				"resource aws_test: added",
+			},
+		},
+		"attribute removed": {
+			old: testResourceSnapshot(map[string]*schemasnapshot.Attribute{
+				"name": {Type: "string", Required: true},
+			}, nil),
+			new: testResourceSnapshot(nil, nil),
+			expected: []string{
+				"BREAKING: resource aws_test: name: attribute removed",
+			},
+		},
+		"attributes added": {
+			old: testResourceSnapshot(nil, nil),
+			new: testResourceSnapshot(map[string]*schemasnapshot.Attribute{
+				"name":        {Type: "string", Required: true},
+				"description": {Type: "string", Optional: true},
+			}, nil),
+			expected: []string{
+				"resource aws_test: description: attribute added",
+				"BREAKING: resource aws_test: name: required attribute added",
+			},
+		},
+		"Optional to Required": {
+			old: testResourceSnapshot(map[string]*schemasnapshot.Attribute{
+				"name": {Type: "string", Optional: true},
+			}, nil),
+			new: testResourceSnapshot(map[string]*schemasnapshot.Attribute{
+				"name": {Type: "string", Required: true},
+			}, nil),
+			expected: []string{
+				"BREAKING: resource aws_test: name: changed from Optional to Required",
+			},
+		},
+		"Required to Optional": {
+			old: testResourceSnapshot(map[string]*schemasnapshot.Attribute{
+				"name": {Type: "string", Required: true},
+			}, nil),
+			new: testResourceSnapshot(map[string]*schemasnapshot.Attribute{
+				"name": {Type: "string", Optional: true},
+			}, nil),
+			expected: []string{
+				"resource aws_test: name: changed from Required to Optional",
+			},
+		},
+		"no longer configurable": {
+			old: testResourceSnapshot(map[string]*schemasnapshot.Attribute{
+				"name": {Type: "string", Optional: true, Computed: true},
+			}, nil),
+			new: testResourceSnapshot(map[string]*schemasnapshot.Attribute{
+				"name": {Type: "string", Computed: true},
+			}, nil),
+			expected: []string{
+				"BREAKING: resource aws_test: name: no longer configurable",
+			},
+		},
+		"type change": {
+			old: testResourceSnapshot(map[string]*schemasnapshot.Attribute{
+				"names": {Type: "list(string)", Optional: true},
+			}, nil),
+			new: testResourceSnapshot(map[string]*schemasnapshot.Attribute{
+				"names": {Type: "set(string)", Optional: true},
+			}, nil),
+			expected: []string{
+				"BREAKING: resource aws_test: names: type changed from list(string) to set(string)",
+			},
+		},
+		"ForceNew added": {
+			old: testResourceSnapshot(map[string]*schemasnapshot.Attribute{
+				"name": {Type: "string", Required: true},
+			}, nil),
+			new: testResourceSnapshot(map[string]*schemasnapshot.Attribute{
+				"name": {Type: "string", Required: true, ForceNew: true},
+			}, nil),
+			expected: []string{
+				"BREAKING: resource aws_test: name: ForceNew added",
+			},
+		},
+		"ForceNew removed": {
+			old: testResourceSnapshot(map[string]*schemasnapshot.Attribute{
+				"name": {Type: "string", Required: true, ForceNew: true},
+			}, nil),
+			new: testResourceSnapshot(map[string]*schemasnapshot.Attribute{
+				"name": {Type: "string", Required: true},
+			}, nil),
+			expected: []string{
+				"resource aws_test: name: ForceNew removed",
+			},
+		},
+		"default changed": {
+			old: testResourceSnapshot(map[string]*schemasnapshot.Attribute{
+				"port": {Type: "number", Optional: true, Default: "80"},
+			}, nil),
+			new: testResourceSnapshot(map[string]*schemasnapshot.Attribute{
+				"port": {Type: "number", Optional: true, Default: "443"},
+			}, nil),
+			expected: []string{
+				"BREAKING: resource aws_test: port: default changed from 80 to 443",
+			},
+		},
+		"validation tightened": {
+			old: testResourceSnapshot(map[string]*schemasnapshot.Attribute{
+				"name": {Type: "string", Required: true},
+			}, nil),
+			new: testResourceSnapshot(map[string]*schemasnapshot.Attribute{
+				"name": {Type: "string", Required: true, Validators: []string{"string length must be between 1 and 64"}},
+			}, nil),
+			expected: []string{
+				"BREAKING: resource aws_test: name: validation tightened: string length must be between 1 and 64",
+			},
+		},
+		"enumeration extended": {
+			old: testResourceSnapshot(map[string]*schemasnapshot.Attribute{
+				"mode": {Type: "string", Required: true, Validators: []string{`value must be one of: ["A" "B"]`}},
+			}, nil),
+			new: testResourceSnapshot(map[string]*schemasnapshot.Attribute{
+				"mode": {Type: "string", Required: true, Validators: []string{`value must be one of: ["A" "B" "C"]`}},
+			}, nil),
+			expected: []string{
+				`resource aws_test: mode: validation relaxed: value must be one of: ["A" "B" "C"]`,
+			},
+		},
+		"enumeration reduced": {
+			old: testResourceSnapshot(map[string]*schemasnapshot.Attribute{
+				"mode": {Type: "string", Required: true, Validators: []string{`value must be one of: ["A" "B"]`}},
+			}, nil),
+			new: testResourceSnapshot(map[string]*schemasnapshot.Attribute{
+				"mode": {Type: "string", Required: true, Validators: []string{`value must be one of: ["A"]`}},
+			}, nil),
+			expected: []string{
+				`resource aws_test: mode: validation removed: value must be one of: ["A" "B"]`,
+				`BREAKING: resource aws_test: mode: validation tightened: value must be one of: ["A"]`,
+			},
+		},
+		"nested block changes": {
+			old: testResourceSnapshot(nil, map[string]*schemasnapshot.NestedBlock{
+				"rule": {
+					Nesting: schemasnapshot.NestingList,
+					Block: &schemasnapshot.Block{
+						Attributes: map[string]*schemasnapshot.Attribute{
+							"id": {Type: "string", Optional: true},
+						},
+					},
+				},
+			}),
+			new: testResourceSnapshot(nil, map[string]*schemasnapshot.NestedBlock{
+				"rule": {
+					Nesting:  schemasnapshot.NestingSet,
+					MaxItems: 1,
+					Block: &schemasnapshot.Block{
+						Attributes: map[string]*schemasnapshot.Attribute{
+							"id": {Type: "number", Optional: true},
+						},
+					},
+				},
+			}),
+			expected: []string{
+				"BREAKING: resource aws_test: rule: max_items decreased from unlimited to 1",
+				"BREAKING: resource aws_test: rule: nesting mode changed from list to set",
+				"BREAKING: resource aws_test: rule.id: type changed from string to number",
+			},
+		},
+		"block to attribute": {
+			old: testResourceSnapshot(nil, map[string]*schemasnapshot.NestedBlock{
+				"rule": {
+					Nesting: schemasnapshot.NestingList,
+				},
+			}),
+			new: testResourceSnapshot(map[string]*schemasnapshot.Attribute{
+				"rule": {Type: "list(object({id=string}))", Optional: true},
+			}, nil),
+			expected: []string{
+				"BREAKING: resource aws_test: rule: changed from block to attribute",
+			},
+		},
+		"function changes": {
+			old: &schemasnapshot.Snapshot{
+				Functions: map[string]*schemasnapshot.Function{
+					"arn_parse": {
+						Parameters: []*schemasnapshot.Parameter{{Name: "arn", Type: "string"}},
+						Return:     "object({account_id=string})",
+					},
+					"user_agent": {
+						Return: "string",
+					},
+				},
+			},
+			new: &schemasnapshot.Snapshot{
+				Functions: map[string]*schemasnapshot.Function{
+					"arn_parse": {
+						Parameters: []*schemasnapshot.Parameter{{Name: "arn", Type: "string", AllowNullValue: true}},
+						Return:     "object({account_id=string,region=string})",
+					},
+				},
+			},
+			expected: []string{
+				"BREAKING: function arn_parse: return type changed from object({account_id=string}) to object({account_id=string,region=string})",
+				"function arn_parse: parameter 1: null value allowed",
+				"BREAKING: function user_agent: removed",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			for _, change := range schemasnapshot.Compare(test.old, test.new) {
+				got = append(got, change.String())
+			}
+
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	expected := testResourceSnapshot(map[string]*schemasnapshot.Attribute{
+		"name": {Type: "string", Required: true, ForceNew: true},
+		"tags": {Type: "map(string)", Optional: true},
+	}, nil)
+
+	var buf bytes.Buffer
+	if err := expected.Write(&buf); err != nil {
+		t.Fatalf("writing snapshot: %s", err)
+	}
+
+	got, err := schemasnapshot.Read(&buf)
+	if err != nil {
+		t.Fatalf("reading snapshot: %s", err)
+	}
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	if changes := schemasnapshot.Compare(expected, got); len(changes) != 0 {
+		t.Errorf("unexpected changes: %v", changes)
+	}
+}
@@ -0,0 +1,380 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schemasnapshot
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// AddFramework adds the schemas of a Terraform Plugin Framework provider to the snapshot.
+// The provider need not be configured.
+func (s *Snapshot) AddFramework(ctx context.Context, p provider.Provider) error {
+	var metadataResponse provider.MetadataResponse
+	p.Metadata(ctx, provider.MetadataRequest{}, &metadataResponse)
+	providerTypeName := metadataResponse.TypeName
+
+	for _, f := range p.Resources(ctx) {
+		r := f()
+
+		var metadataResponse resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: providerTypeName}, &metadataResponse)
+		typeName := metadataResponse.TypeName
+
+		var schemaResponse resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+		if err := diagsError(schemaResponse.Diagnostics); err != nil {
+			return fmt.Errorf("resource type %q schema: %w", typeName, err)
+		}
+
+		if _, ok := s.Resources[typeName]; ok {
+			return fmt.Errorf("duplicate resource type %q", typeName)
+		}
+		s.Resources[typeName] = frameworkSchema(ctx, schemaResponse.Schema)
+	}
+
+	for _, f := range p.DataSources(ctx) {
+		d := f()
+
+		var metadataResponse datasource.MetadataResponse
+		d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: providerTypeName}, &metadataResponse)
+		typeName := metadataResponse.TypeName
+
+		var schemaResponse datasource.SchemaResponse
+		d.Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+		if err := diagsError(schemaResponse.Diagnostics); err != nil {
+			return fmt.Errorf("data source type %q schema: %w", typeName, err)
+		}
+
+		if _, ok := s.DataSources[typeName]; ok {
+			return fmt.Errorf("duplicate data source type %q", typeName)
+		}
+		s.DataSources[typeName] = frameworkSchema(ctx, schemaResponse.Schema)
+	}
+
+	if p, ok := p.(provider.ProviderWithEphemeralResources); ok {
+		for _, f := range p.EphemeralResources(ctx) {
+			e := f()
+
+			var metadataResponse ephemeral.MetadataResponse
+			e.Metadata(ctx, ephemeral.MetadataRequest{ProviderTypeName: providerTypeName}, &metadataResponse)
+			typeName := metadataResponse.TypeName
+
+			var schemaResponse ephemeral.SchemaResponse
+			e.Schema(ctx, ephemeral.SchemaRequest{}, &schemaResponse)
+			if err := diagsError(schemaResponse.Diagnostics); err != nil {
+				return fmt.Errorf("ephemeral resource type %q schema: %w", typeName, err)
+			}
+
+			s.EphemeralResources[typeName] = frameworkSchema(ctx, schemaResponse.Schema)
+		}
+	}
+
+	if p, ok := p.(provider.ProviderWithActions); ok {
+		for _, f := range p.Actions(ctx) {
+			a := f()
+
+			var metadataResponse action.MetadataResponse
+			a.Metadata(ctx, action.MetadataRequest{ProviderTypeName: providerTypeName}, &metadataResponse)
+			typeName := metadataResponse.TypeName
+
+			var schemaResponse action.SchemaResponse
+			a.Schema(ctx, action.SchemaRequest{}, &schemaResponse)
+			if err := diagsError(schemaResponse.Diagnostics); err != nil {
+				return fmt.Errorf("action type %q schema: %w", typeName, err)
+			}
+
+			s.Actions[typeName] = frameworkSchema(ctx, schemaResponse.Schema)
+		}
+	}
+
+	if p, ok := p.(provider.ProviderWithListResources); ok {
+		for _, f := range p.ListResources(ctx) {
+			l := f()
+
+			var metadataResponse resource.MetadataResponse
+			l.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: providerTypeName}, &metadataResponse)
+			typeName := metadataResponse.TypeName
+
+			var schemaResponse list.ListResourceSchemaResponse
+			l.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &schemaResponse)
+			if err := diagsError(schemaResponse.Diagnostics); err != nil {
+				return fmt.Errorf("list resource type %q schema: %w", typeName, err)
+			}
+
+			s.ListResources[typeName] = frameworkSchema(ctx, schemaResponse.Schema)
+		}
+	}
+
+	if p, ok := p.(provider.ProviderWithFunctions); ok {
+		for _, f := range p.Functions(ctx) {
+			fn := f()
+
+			var metadataResponse function.MetadataResponse
+			fn.Metadata(ctx, function.MetadataRequest{}, &metadataResponse)
+			name := metadataResponse.Name
+
+			var definitionResponse function.DefinitionResponse
+			fn.Definition(ctx, function.DefinitionRequest{}, &definitionResponse)
+			if err := diagsError(definitionResponse.Diagnostics); err != nil {
+				return fmt.Errorf("function %q definition: %w", name, err)
+			}
+
+			s.Functions[name] = frameworkFunction(ctx, definitionResponse.Definition)
+		}
+	}
+
+	return nil
+}
+
+func diagsError(diags diag.Diagnostics) error {
+	if !diags.HasError() {
+		return nil
+	}
+
+	var errs []string
+	for _, d := range diags.Errors() {
+		errs = append(errs, d.Summary()+": "+d.Detail())
+	}
+
+	return fmt.Errorf("%s", strings.Join(errs, "; "))
+}
+
+// frameworkAttribute is the subset of the Plugin Framework's attribute interface used in snapshots.
+// All resource, data source, ephemeral resource, action and list resource schema attributes implement it.
+type frameworkAttribute interface {
+	GetDeprecationMessage() string
+	GetType() attr.Type
+	IsComputed() bool
+	IsOptional() bool
+	IsRequired() bool
+	IsSensitive() bool
+	IsWriteOnly() bool
+}
+
+// frameworkBlock is the subset of the Plugin Framework's block interface used in snapshots.
+type frameworkBlock interface {
+	GetDeprecationMessage() string
+}
+
+type describer interface {
+	Description(context.Context) string
+}
+
+// The Plugin Framework's schema interfaces return types from an internal package,
+// so nested objects are traversed using reflection.
+
+func frameworkSchema(ctx context.Context, schema any) *Schema {
+	v := reflect.ValueOf(schema)
+
+	return &Schema{
+		Version: v.MethodByName("GetVersion").Call(nil)[0].Int(),
+		Block:   frameworkBlockFromObject(ctx, v),
+	}
+}
+
+func frameworkBlockFromObject(ctx context.Context, v reflect.Value) *Block {
+	block := newBlock()
+
+	if m := v.MethodByName("GetAttributes"); m.IsValid() {
+		iter := m.Call(nil)[0].MapRange()
+		for iter.Next() {
+			if a, ok := iter.Value().Interface().(frameworkAttribute); ok {
+				block.Attributes[iter.Key().String()] = frameworkAttributeFromValue(ctx, a)
+			}
+		}
+	}
+
+	if m := v.MethodByName("GetBlocks"); m.IsValid() {
+		iter := m.Call(nil)[0].MapRange()
+		for iter.Next() {
+			if b, ok := iter.Value().Interface().(frameworkBlock); ok {
+				block.Blocks[iter.Key().String()] = frameworkNestedBlockFromValue(ctx, b)
+			}
+		}
+	}
+
+	return block
+}
+
+func frameworkAttributeFromValue(ctx context.Context, a frameworkAttribute) *Attribute {
+	attribute := &Attribute{
+		Required:   a.IsRequired(),
+		Optional:   a.IsOptional(),
+		Computed:   a.IsComputed(),
+		Sensitive:  a.IsSensitive(),
+		WriteOnly:  a.IsWriteOnly(),
+		Deprecated: a.GetDeprecationMessage(),
+	}
+
+	v := reflect.ValueOf(a)
+	if m := v.MethodByName("GetNestedObject"); m.IsValid() {
+		attribute.NestedType = &NestedAttribute{
+			Nesting:    attributeNestingMode(v.MethodByName("GetNestingMode").Call(nil)[0].Uint()),
+			Attributes: frameworkBlockFromObject(ctx, m.Call(nil)[0]).Attributes,
+		}
+	} else {
+		attribute.Type = tfType(a.GetType().TerraformType(ctx))
+	}
+
+	attribute.ForceNew, attribute.Default, attribute.Validators = frameworkBehaviors(ctx, v)
+
+	return attribute
+}
+
+func frameworkNestedBlockFromValue(ctx context.Context, b frameworkBlock) *NestedBlock {
+	v := reflect.ValueOf(b)
+
+	nestedBlock := &NestedBlock{
+		Nesting:    blockNestingMode(v.MethodByName("GetNestingMode").Call(nil)[0].Uint()),
+		Deprecated: b.GetDeprecationMessage(),
+		Block:      frameworkBlockFromObject(ctx, v.MethodByName("GetNestedObject").Call(nil)[0]),
+	}
+
+	nestedBlock.ForceNew, _, nestedBlock.Validators = frameworkBehaviors(ctx, v)
+
+	return nestedBlock
+}
+
+// frameworkBehaviors returns whether the attribute or block requires replacement on change, its default
+// and the descriptions of its validators.
+// Behaviors are discovered by calling the type-specific methods (e.g. `StringPlanModifiers`).
+func frameworkBehaviors(ctx context.Context, v reflect.Value) (bool, string, []string) {
+	var forceNew bool
+	var defaultValue string
+	var validators []string
+
+	t := v.Type()
+	for i := range t.NumMethod() {
+		method := t.Method(i)
+		if method.Type.NumIn() != 1 || method.Type.NumOut() != 1 {
+			continue
+		}
+
+		switch name := method.Name; {
+		case strings.HasSuffix(name, "PlanModifiers"):
+			for _, m := range sliceValues(v.Method(i).Call(nil)[0]) {
+				if isRequiresReplace(ctx, m) {
+					forceNew = true
+				}
+			}
+		case strings.HasSuffix(name, "DefaultValue"):
+			if d, ok := v.Method(i).Call(nil)[0].Interface().(describer); ok && d != nil {
+				defaultValue = d.Description(ctx)
+			}
+		case strings.HasSuffix(name, "Validators"):
+			for _, m := range sliceValues(v.Method(i).Call(nil)[0]) {
+				if d, ok := m.(describer); ok {
+					validators = append(validators, d.Description(ctx))
+				}
+			}
+		}
+	}
+
+	slices.Sort(validators)
+
+	return forceNew, defaultValue, validators
+}
+
+func sliceValues(v reflect.Value) []any {
+	if v.Kind() != reflect.Slice {
+		return nil
+	}
+
+	values := make([]any, 0, v.Len())
+	for i := range v.Len() {
+		values = append(values, v.Index(i).Interface())
+	}
+
+	return values
+}
+
+// isRequiresReplace returns whether a plan modifier is one of the Plugin Framework's RequiresReplace variants
+// or a modifier which describes itself as such.
+func isRequiresReplace(ctx context.Context, m any) bool {
+	if m == nil {
+		return false
+	}
+
+	if t := reflect.TypeOf(m); strings.Contains(strings.ToLower(t.Name()), "requiresreplace") {
+		return true
+	}
+
+	if d, ok := m.(describer); ok {
+		return strings.Contains(d.Description(ctx), "destroy and recreate")
+	}
+
+	return false
+}
+
+func attributeNestingMode(mode uint64) string {
+	// Values of the Plugin Framework's internal fwschema.NestingMode.
+	switch mode {
+	case 1:
+		return NestingSingle
+	case 2:
+		return NestingList
+	case 3:
+		return NestingSet
+	case 4:
+		return NestingMap
+	default:
+		return ""
+	}
+}
+
+func blockNestingMode(mode uint64) string {
+	// Values of the Plugin Framework's internal fwschema.BlockNestingMode.
+	switch mode {
+	case 1:
+		return NestingList
+	case 2:
+		return NestingSet
+	case 3:
+		return NestingSingle
+	default:
+		return ""
+	}
+}
+
+func frameworkFunction(ctx context.Context, definition function.Definition) *Function {
+	fn := &Function{
+		Deprecated: definition.DeprecationMessage,
+	}
+
+	for _, parameter := range definition.Parameters {
+		fn.Parameters = append(fn.Parameters, frameworkParameter(ctx, parameter))
+	}
+
+	if definition.VariadicParameter != nil {
+		fn.VariadicParameter = frameworkParameter(ctx, definition.VariadicParameter)
+	}
+
+	if definition.Return != nil {
+		fn.Return = tfType(definition.Return.GetType().TerraformType(ctx))
+	}
+
+	return fn
+}
+
+func frameworkParameter(ctx context.Context, parameter function.Parameter) *Parameter {
+	return &Parameter{
+		Name:           parameter.GetName(),
+		Type:           tfType(parameter.GetType().TerraformType(ctx)),
+		AllowNullValue: parameter.GetAllowNullValue(),
+	}
+}
@@ -0,0 +1,199 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schemasnapshot
+
+import (
+	"fmt"
+	"path"
+	"reflect"
+	"regexp"
+	"runtime"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// AddSDKv2 adds the schemas of a Terraform Plugin SDKv2 provider to the snapshot.
+func (s *Snapshot) AddSDKv2(p *schema.Provider) error {
+	s.Provider = &Schema{
+		Block: sdkv2Block(p.Schema),
+	}
+
+	for typeName, r := range p.ResourcesMap {
+		if _, ok := s.Resources[typeName]; ok {
+			return fmt.Errorf("duplicate resource type %q", typeName)
+		}
+		s.Resources[typeName] = sdkv2Schema(r)
+	}
+
+	for typeName, r := range p.DataSourcesMap {
+		if _, ok := s.DataSources[typeName]; ok {
+			return fmt.Errorf("duplicate data source type %q", typeName)
+		}
+		s.DataSources[typeName] = sdkv2Schema(r)
+	}
+
+	return nil
+}
+
+func sdkv2Schema(r *schema.Resource) *Schema {
+	return &Schema{
+		Version: int64(r.SchemaVersion),
+		Block:   sdkv2Block(r.SchemaMap()),
+	}
+}
+
+func sdkv2Block(m map[string]*schema.Schema) *Block {
+	block := newBlock()
+
+	for name, v := range m {
+		if elem, ok := v.Elem.(*schema.Resource); ok && sdkv2IsBlock(v) {
+			block.Blocks[name] = &NestedBlock{
+				Nesting:    sdkv2Nesting(v.Type),
+				MinItems:   v.MinItems,
+				MaxItems:   v.MaxItems,
+				ForceNew:   v.ForceNew,
+				Deprecated: v.Deprecated,
+				Validators: sdkv2Validators(v),
+				Block:      sdkv2Block(elem.SchemaMap()),
+			}
+			continue
+		}
+
+		attribute := &Attribute{
+			Type:       sdkv2Type(v),
+			Required:   v.Required,
+			Optional:   v.Optional,
+			Computed:   v.Computed,
+			Sensitive:  v.Sensitive,
+			WriteOnly:  v.WriteOnly,
+			ForceNew:   v.ForceNew,
+			Deprecated: v.Deprecated,
+			MinItems:   v.MinItems,
+			MaxItems:   v.MaxItems,
+			Validators: sdkv2Validators(v),
+		}
+		if v.Default != nil {
+			attribute.Default = fmt.Sprintf("%v", v.Default)
+		}
+		block.Attributes[name] = attribute
+	}
+
+	return block
+}
+
+// sdkv2IsBlock returns whether the specified schema, which has a nested Resource element, is represented as a block.
+// This follows the same rules as the Plugin SDK's core schema conversion.
+func sdkv2IsBlock(v *schema.Schema) bool {
+	switch v.ConfigMode {
+	case schema.SchemaConfigModeAttr:
+		return false
+	case schema.SchemaConfigModeBlock:
+		return true
+	}
+
+	switch v.Type {
+	case schema.TypeList, schema.TypeSet:
+	default:
+		return false
+	}
+
+	return !v.Computed || v.Optional
+}
+
+func sdkv2Nesting(t schema.ValueType) string {
+	switch t {
+	case schema.TypeSet:
+		return NestingSet
+	case schema.TypeMap:
+		return NestingMap
+	default:
+		return NestingList
+	}
+}
+
+func sdkv2Type(v *schema.Schema) string {
+	switch v.Type {
+	case schema.TypeBool:
+		return typeBool
+	case schema.TypeInt, schema.TypeFloat:
+		return typeNumber
+	case schema.TypeString:
+		return typeString
+	case schema.TypeList, schema.TypeSet:
+		return fmt.Sprintf("%s(%s)", sdkv2Nesting(v.Type), sdkv2ElemType(v.Elem))
+	case schema.TypeMap:
+		// Maps with a nested Resource element are always treated as map(string).
+		if _, ok := v.Elem.(*schema.Resource); ok {
+			return fmt.Sprintf("map(%s)", typeString)
+		}
+		return fmt.Sprintf("map(%s)", sdkv2ElemType(v.Elem))
+	default:
+		return typeDynamic
+	}
+}
+
+func sdkv2ElemType(elem any) string {
+	switch elem := elem.(type) {
+	case *schema.Schema:
+		return sdkv2Type(elem)
+	case *schema.Resource:
+		m := elem.SchemaMap()
+		attributeTypes := make(map[string]string, len(m))
+		for name, v := range m {
+			attributeTypes[name] = sdkv2Type(v)
+		}
+		return objectType(attributeTypes)
+	default:
+		// The SDK defaults to string elements.
+		return typeString
+	}
+}
+
+var closureSuffixRegexp = regexp.MustCompile(`(\.func\d+)+$`)
+
+// sdkv2Validators returns the names of the schema's validation functions.
+// Closures are reported by the name of the function which returned them. The arguments they captured
+// (e.g. the values passed to validation.StringInSlice) cannot be recovered, so changes to them are not detected.
+func sdkv2Validators(v *schema.Schema) []string {
+	var validators []string
+
+	for _, f := range []any{v.ValidateFunc, v.ValidateDiagFunc} {
+		if name := funcName(f); name != "" {
+			validators = append(validators, name)
+		}
+	}
+
+	for _, constraint := range []struct {
+		name string
+		keys []string
+	}{
+		{"AtLeastOneOf", v.AtLeastOneOf},
+		{"ConflictsWith", v.ConflictsWith},
+		{"ExactlyOneOf", v.ExactlyOneOf},
+		{"RequiredWith", v.RequiredWith},
+	} {
+		if len(constraint.keys) > 0 {
+			validators = append(validators, fmt.Sprintf("%s(%v)", constraint.name, slices.Sorted(slices.Values(constraint.keys))))
+		}
+	}
+
+	slices.Sort(validators)
+
+	return validators
+}
+
+func funcName(f any) string {
+	v := reflect.ValueOf(f)
+	if v.Kind() != reflect.Func || v.IsNil() {
+		return ""
+	}
+
+	fn := runtime.FuncForPC(v.Pointer())
+	if fn == nil {
+		return ""
+	}
+
+	return closureSuffixRegexp.ReplaceAllString(path.Base(fn.Name()), "")
+}
@@ -0,0 +1,153 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package schemasnapshot serializes the provider's schemas (Terraform Plugin SDKv2
+// and Terraform Plugin Framework) to a canonical JSON document and compares two
+// such documents, classifying each difference as breaking or non-breaking.
+//
+// Snapshots capture more than the protocol schema: they include properties which
+// affect practitioners but are not visible over the wire, such as ForceNew
+// (RequiresReplace), defaults and validation.
+package schemasnapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// FormatVersion is the snapshot document format version.
+// It is incremented when the document structure changes incompatibly.
+const FormatVersion = 1
+
+// Snapshot is a canonical representation of all of the provider's schemas.
+type Snapshot struct {
+	FormatVersion      int                  `json:"format_version"`
+	Provider           *Schema              `json:"provider,omitempty"`
+	Resources          map[string]*Schema   `json:"resources,omitempty"`
+	DataSources        map[string]*Schema   `json:"data_sources,omitempty"`
+	EphemeralResources map[string]*Schema   `json:"ephemeral_resources,omitempty"`
+	Actions            map[string]*Schema   `json:"actions,omitempty"`
+	ListResources      map[string]*Schema   `json:"list_resources,omitempty"`
+	Functions          map[string]*Function `json:"functions,omitempty"`
+}
+
+// Schema is the schema of a single provider, resource, data source, ephemeral resource, action or list resource.
+type Schema struct {
+	Version int64  `json:"version,omitempty"`
+	Block   *Block `json:"block"`
+}
+
+// Block is a set of attributes and nested blocks.
+type Block struct {
+	Attributes map[string]*Attribute   `json:"attributes,omitempty"`
+	Blocks     map[string]*NestedBlock `json:"blocks,omitempty"`
+}
+
+// Attribute describes a single attribute.
+// Type is the attribute's Terraform type in HCL type constraint syntax (e.g. `list(string)`)
+// and is empty for nested attributes.
+type Attribute struct {
+	Type       string           `json:"type,omitempty"`
+	NestedType *NestedAttribute `json:"nested_type,omitempty"`
+	Required   bool             `json:"required,omitempty"`
+	Optional   bool             `json:"optional,omitempty"`
+	Computed   bool             `json:"computed,omitempty"`
+	Sensitive  bool             `json:"sensitive,omitempty"`
+	WriteOnly  bool             `json:"write_only,omitempty"`
+	ForceNew   bool             `json:"force_new,omitempty"`
+	Default    string           `json:"default,omitempty"`
+	Deprecated string           `json:"deprecated,omitempty"`
+	MinItems   int              `json:"min_items,omitempty"`
+	MaxItems   int              `json:"max_items,omitempty"`
+	Validators []string         `json:"validators,omitempty"`
+}
+
+// NestedAttribute describes the object nested within a nested attribute.
+type NestedAttribute struct {
+	Nesting    string                `json:"nesting"`
+	Attributes map[string]*Attribute `json:"attributes,omitempty"`
+}
+
+// NestedBlock describes a nested block.
+type NestedBlock struct {
+	Nesting    string   `json:"nesting"`
+	MinItems   int      `json:"min_items,omitempty"`
+	MaxItems   int      `json:"max_items,omitempty"`
+	ForceNew   bool     `json:"force_new,omitempty"`
+	Deprecated string   `json:"deprecated,omitempty"`
+	Validators []string `json:"validators,omitempty"`
+	Block      *Block   `json:"block"`
+}
+
+// Function describes a provider-defined function's signature.
+type Function struct {
+	Parameters        []*Parameter `json:"parameters,omitempty"`
+	VariadicParameter *Parameter   `json:"variadic_parameter,omitempty"`
+	Return            string       `json:"return"`
+	Deprecated        string       `json:"deprecated,omitempty"`
+}
+
+// Parameter describes a single function parameter.
+type Parameter struct {
+	Name           string `json:"name"`
+	Type           string `json:"type"`
+	AllowNullValue bool   `json:"allow_null_value,omitempty"`
+}
+
+// Nesting modes for nested attributes and blocks.
+const (
+	NestingList   = "list"
+	NestingMap    = "map"
+	NestingSet    = "set"
+	NestingSingle = "single"
+)
+
+// New returns a new, empty snapshot.
+func New() *Snapshot {
+	return &Snapshot{
+		FormatVersion:      FormatVersion,
+		Resources:          make(map[string]*Schema),
+		DataSources:        make(map[string]*Schema),
+		EphemeralResources: make(map[string]*Schema),
+		Actions:            make(map[string]*Schema),
+		ListResources:      make(map[string]*Schema),
+		Functions:          make(map[string]*Function),
+	}
+}
+
+func newBlock() *Block {
+	return &Block{
+		Attributes: make(map[string]*Attribute),
+		Blocks:     make(map[string]*NestedBlock),
+	}
+}
+
+// Write writes the snapshot to w as indented JSON.
+// Object keys are sorted, so the output is stable for a given set of schemas.
+func (s *Snapshot) Write(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(s); err != nil {
+		return fmt.Errorf("encoding schema snapshot: %w", err)
+	}
+
+	return nil
+}
+
+// Read reads a snapshot previously written by Write.
+func Read(r io.Reader) (*Snapshot, error) {
+	var s Snapshot
+
+	if err := json.NewDecoder(r).Decode(&s); err != nil {
+		return nil, fmt.Errorf("decoding schema snapshot: %w", err)
+	}
+
+	if s.FormatVersion != FormatVersion {
+		return nil, fmt.Errorf("unsupported schema snapshot format version %d, expected %d", s.FormatVersion, FormatVersion)
+	}
+
+	return &s, nil
+}
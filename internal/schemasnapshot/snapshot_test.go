@@ -0,0 +1,274 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schemasnapshot_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkschema "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/schemasnapshot"
+)
+
+func TestSnapshotAddSDKv2(t *testing.T) {
+	t.Parallel()
+
+	p := &sdkschema.Provider{
+		Schema: map[string]*sdkschema.Schema{
+			"region": {
+				Type:     sdkschema.TypeString,
+				Optional: true,
+			},
+		},
+		ResourcesMap: map[string]*sdkschema.Resource{
+			"aws_test": {
+				SchemaVersion: 1,
+				Schema: map[string]*sdkschema.Schema{
+					attrARN: {
+						Type:     sdkschema.TypeString,
+						Computed: true,
+					},
+					"name": {
+						Type:         sdkschema.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringLenBetween(1, 64),
+					},
+					"port": {
+						Type:     sdkschema.TypeInt,
+						Optional: true,
+						Default:  80,
+					},
+					"rule": {
+						Type:     sdkschema.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &sdkschema.Resource{
+							Schema: map[string]*sdkschema.Schema{
+								"ids": {
+									Type:     sdkschema.TypeSet,
+									Optional: true,
+									Elem:     &sdkschema.Schema{Type: sdkschema.TypeString},
+								},
+							},
+						},
+					},
+					"status": {
+						Type:     sdkschema.TypeList,
+						Computed: true,
+						Elem: &sdkschema.Resource{
+							Schema: map[string]*sdkschema.Schema{
+								"code": {
+									Type:     sdkschema.TypeString,
+									Computed: true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	s := schemasnapshot.New()
+	if err := s.AddSDKv2(p); err != nil {
+		t.Fatalf("adding SDKv2 provider: %s", err)
+	}
+
+	expected := &schemasnapshot.Schema{
+		Version: 1,
+		Block: &schemasnapshot.Block{
+			Attributes: map[string]*schemasnapshot.Attribute{
+				attrARN:  {Type: "string", Computed: true},
+				"name":   {Type: "string", Required: true, ForceNew: true, Validators: []string{"validation.StringLenBetween"}},
+				"port":   {Type: "number", Optional: true, Default: "80"},
+				"status": {Type: "list(object({code=string}))", Computed: true},
+			},
+			Blocks: map[string]*schemasnapshot.NestedBlock{
+				"rule": {
+					Nesting:  schemasnapshot.NestingList,
+					MaxItems: 1,
+					Block: &schemasnapshot.Block{
+						Attributes: map[string]*schemasnapshot.Attribute{
+							"ids": {Type: "set(string)", Optional: true},
+						},
+						Blocks: map[string]*schemasnapshot.NestedBlock{},
+					},
+				},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(s.Resources["aws_test"], expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	if got, want := s.Provider.Block.Attributes["region"], (&schemasnapshot.Attribute{Type: "string", Optional: true}); !cmp.Equal(got, want) {
+		t.Errorf("provider region attribute = %v, want %v", got, want)
+	}
+}
+
+const attrARN = "arn"
+
+func TestSnapshotAddFramework(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	s := schemasnapshot.New()
+	if err := s.AddFramework(ctx, &testProvider{}); err != nil {
+		t.Fatalf("adding Framework provider: %s", err)
+	}
+
+	expected := &schemasnapshot.Schema{
+		Block: &schemasnapshot.Block{
+			Attributes: map[string]*schemasnapshot.Attribute{
+				"mode": {
+					Type:       "string",
+					Optional:   true,
+					Computed:   true,
+					Default:    "value defaults to A",
+					Validators: []string{`value must be one of: ["A" "B"]`},
+				},
+				"name": {Type: "string", Required: true, ForceNew: true},
+				"tags": {Type: "map(string)", Optional: true},
+			},
+			Blocks: map[string]*schemasnapshot.NestedBlock{
+				"rule": {
+					Nesting: schemasnapshot.NestingList,
+					Block: &schemasnapshot.Block{
+						Attributes: map[string]*schemasnapshot.Attribute{
+							"id": {Type: "string", Required: true},
+						},
+						Blocks: map[string]*schemasnapshot.NestedBlock{},
+					},
+				},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(s.Resources["aws_test"], expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	expectedFunction := &schemasnapshot.Function{
+		Parameters: []*schemasnapshot.Parameter{{Name: "value", Type: "string"}},
+		Return:     "list(string)",
+	}
+
+	if diff := cmp.Diff(s.Functions["split"], expectedFunction); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+var (
+	_ provider.ProviderWithFunctions = (*testProvider)(nil)
+)
+
+type testProvider struct{}
+
+func (*testProvider) Metadata(_ context.Context, _ provider.MetadataRequest, response *provider.MetadataResponse) {
+	response.TypeName = "aws"
+}
+
+func (*testProvider) Schema(context.Context, provider.SchemaRequest, *provider.SchemaResponse) {}
+
+func (*testProvider) Configure(context.Context, provider.ConfigureRequest, *provider.ConfigureResponse) {
+}
+
+func (*testProvider) DataSources(context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (*testProvider) Resources(context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		func() resource.Resource { return &testResource{} },
+	}
+}
+
+func (*testProvider) Functions(context.Context) []func() function.Function {
+	return []func() function.Function{
+		func() function.Function { return &testFunction{} },
+	}
+}
+
+type testResource struct{}
+
+func (*testResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_test"
+}
+
+func (*testResource) Schema(_ context.Context, _ resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"mode": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("A"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("A", "B"),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tags": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"rule": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (*testResource) Create(context.Context, resource.CreateRequest, *resource.CreateResponse) {}
+
+func (*testResource) Read(context.Context, resource.ReadRequest, *resource.ReadResponse) {}
+
+func (*testResource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {}
+
+func (*testResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {}
+
+type testFunction struct{}
+
+func (*testFunction) Metadata(_ context.Context, _ function.MetadataRequest, response *function.MetadataResponse) {
+	response.Name = "split"
+}
+
+func (*testFunction) Definition(_ context.Context, _ function.DefinitionRequest, response *function.DefinitionResponse) {
+	response.Definition = function.Definition{
+		Parameters: []function.Parameter{
+			function.StringParameter{Name: "value"},
+		},
+		Return: function.ListReturn{ElementType: types.StringType},
+	}
+}
+
+func (*testFunction) Run(context.Context, function.RunRequest, *function.RunResponse) {}
@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package schemasnapshot
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+const (
+	typeBool    = "bool"
+	typeDynamic = "dynamic"
+	typeNumber  = "number"
+	typeString  = "string"
+)
+
+// objectType returns an object type constraint with attributes in lexical order.
+func objectType(attributeTypes map[string]string) string {
+	var sb strings.Builder
+
+	sb.WriteString("object({")
+	for i, name := range slices.Sorted(maps.Keys(attributeTypes)) {
+		if i > 0 {
+			sb.WriteString(",")
+		}
+		fmt.Fprintf(&sb, "%s=%s", name, attributeTypes[name])
+	}
+	sb.WriteString("})")
+
+	return sb.String()
+}
+
+// tfType returns the HCL type constraint for a terraform-plugin-go type.
+func tfType(t tftypes.Type) string {
+	switch t := t.(type) {
+	case nil:
+		return ""
+	case tftypes.List:
+		return fmt.Sprintf("list(%s)", tfType(t.ElementType))
+	case tftypes.Set:
+		return fmt.Sprintf("set(%s)", tfType(t.ElementType))
+	case tftypes.Map:
+		return fmt.Sprintf("map(%s)", tfType(t.ElementType))
+	case tftypes.Object:
+		attributeTypes := make(map[string]string, len(t.AttributeTypes))
+		for name, v := range t.AttributeTypes {
+			attributeTypes[name] = tfType(v)
+		}
+		return objectType(attributeTypes)
+	case tftypes.Tuple:
+		elementTypes := make([]string, len(t.ElementTypes))
+		for i, v := range t.ElementTypes {
+			elementTypes[i] = tfType(v)
+		}
+		return fmt.Sprintf("tuple([%s])", strings.Join(elementTypes, ","))
+	}
+
+	switch {
+	case t.Is(tftypes.Bool):
+		return typeBool
+	case t.Is(tftypes.Number):
+		return typeNumber
+	case t.Is(tftypes.String):
+		return typeString
+	default:
+		return typeDynamic
+	}
+}
@@ -98,6 +98,7 @@ func (d *primaryContactDataSOurce) Read(ctx context.Context, request datasource.
 }
 
 type primaryContactDataSourceModel struct {
+	framework.WithAssumeRoleModel
 	AccountID        types.String `tfsdk:"account_id"`
 	AddressLine1     types.String `tfsdk:"address_line_1"`
 	AddressLine2     types.String `tfsdk:"address_line_2"`
@@ -94,6 +94,7 @@ func (d *dataSourceRegions) Read(ctx context.Context, req datasource.ReadRequest
 }
 
 type dataSourceRegionsModel struct {
+	framework.WithAssumeRoleModel
 	AccountID               types.String                                       `tfsdk:"account_id"`
 	RegionOptStatusContains fwtypes.ListOfStringEnum[awstypes.RegionOptStatus] `tfsdk:"region_opt_status_contains"`
 	Regions                 fwtypes.ListNestedObjectValueOf[regionsModel]      `tfsdk:"regions"`
@@ -481,6 +481,7 @@ func findExportByARN(ctx context.Context, conn *bcmdataexports.Client, exportArn
 }
 
 type exportResourceModel struct {
+	framework.WithAssumeRoleModel
 	ARN      types.String                                `tfsdk:"arn"`
 	Export   fwtypes.ListNestedObjectValueOf[exportData] `tfsdk:"export"`
 	ID       types.String                                `tfsdk:"id"`
@@ -58,6 +58,7 @@ func (d *billingServiceAccountDataSource) Read(ctx context.Context, request data
 }
 
 type billingServiceAccountDataSourceModel struct {
+	framework.WithAssumeRoleModel
 	ARN types.String `tfsdk:"arn"`
 	ID  types.String `tfsdk:"id"`
 }
@@ -469,6 +469,7 @@ func waitViewDeleted(ctx context.Context, conn *billing.Client, arn string, time
 }
 
 type resourceViewModel struct {
+	framework.WithAssumeRoleModel
 	ARN                         types.String                                               `tfsdk:"arn"`
 	BillingViewType             fwtypes.StringEnum[awstypes.BillingViewType]               `tfsdk:"billing_view_type"`
 	CreatedAt                   timetypes.RFC3339                                          `tfsdk:"created_at"`
@@ -99,6 +99,7 @@ func findViews(ctx context.Context, conn *billing.Client, input *billing.ListBil
 }
 
 type dataSourceViewsModel struct {
+	framework.WithAssumeRoleModel
 	BillingViewTypes fwtypes.ListOfStringEnum[awstypes.BillingViewType]          `tfsdk:"billing_view_types"`
 	BillingView      fwtypes.ListNestedObjectValueOf[dataSourceBillingViewModel] `tfsdk:"billing_view"`
 }
@@ -6,6 +6,8 @@ package cloudformation
 import (
 	"context"
 	"errors"
+	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -15,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func findChangeSetByTwoPartKey(ctx context.Context, conn *cloudformation.Client, stackID, changeSetName string) (*cloudformation.DescribeChangeSetOutput, error) {
@@ -81,3 +84,87 @@ func waitChangeSetCreated(ctx context.Context, conn *cloudformation.Client, stac
 
 	return nil, err
 }
+
+// previewStackChanges creates a change set with the specified input and returns its resource changes.
+// The change set is deleted before returning.
+func previewStackChanges(ctx context.Context, conn *cloudformation.Client, input *cloudformation.CreateChangeSetInput) ([]awstypes.ResourceChange, error) {
+	output, err := conn.CreateChangeSet(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stackID, changeSetID := aws.ToString(output.StackId), aws.ToString(output.Id)
+	defer func() {
+		input := cloudformation.DeleteChangeSetInput{
+			ChangeSetName: aws.String(changeSetID),
+		}
+
+		if _, err := conn.DeleteChangeSet(ctx, &input); err != nil {
+			log.Printf("[WARN] deleting CloudFormation Change Set (%s): %s", changeSetID, err)
+		}
+	}()
+
+	changeSet, err := waitChangeSetCreated(ctx, conn, stackID, changeSetID)
+
+	if changeSet != nil && changeSetHasNoChanges(changeSet) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return findChangeSetResourceChanges(ctx, conn, &cloudformation.DescribeChangeSetInput{
+		ChangeSetName: aws.String(changeSetID),
+		StackName:     aws.String(stackID),
+	})
+}
+
+// changeSetHasNoChanges returns whether the change set failed to create because it would not change the stack.
+func changeSetHasNoChanges(output *cloudformation.DescribeChangeSetOutput) bool {
+	if output.Status != awstypes.ChangeSetStatusFailed || output.ExecutionStatus != awstypes.ExecutionStatusUnavailable || len(output.Changes) > 0 {
+		return false
+	}
+
+	reason := aws.ToString(output.StatusReason)
+
+	return strings.Contains(reason, "didn't contain changes") || strings.Contains(reason, "No updates are to be performed")
+}
+
+func findChangeSetResourceChanges(ctx context.Context, conn *cloudformation.Client, input *cloudformation.DescribeChangeSetInput) ([]awstypes.ResourceChange, error) {
+	var output []awstypes.ResourceChange
+
+	pages := cloudformation.NewDescribeChangeSetPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, change := range page.Changes {
+			if change.ResourceChange != nil {
+				output = append(output, *change.ResourceChange)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func flattenResourceChanges(apiObjects []awstypes.ResourceChange) []any {
+	tfList := make([]any, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]any{
+			names.AttrAction:       string(apiObject.Action),
+			"logical_resource_id":  aws.ToString(apiObject.LogicalResourceId),
+			"physical_resource_id": aws.ToString(apiObject.PhysicalResourceId),
+			"replacement":          string(apiObject.Replacement),
+			names.AttrResourceType: aws.ToString(apiObject.ResourceType),
+		})
+	}
+
+	return tfList
+}
@@ -11,6 +11,7 @@ var (
 	ResourceStackInstances   = resourceStackInstances
 	ResourceType             = resourceType
 
+	DecodeTemplate                          = decodeTemplate
 	FindStackInstanceByFourPartKey          = findStackInstanceByFourPartKey
 	FindStackInstanceSummariesByFourPartKey = findStackInstanceSummariesByFourPartKey
 	FindStackSetByName                      = findStackSetByName
@@ -19,4 +20,5 @@ var (
 	StackSetInstanceResourceIDPartCount     = stackSetInstanceResourceIDPartCount
 	StackInstancesResourceIDPartCount       = stackInstancesResourceIDPartCount
 	TypeVersionARNToTypeARNAndVersionID     = typeVersionARNToTypeARNAndVersionID
+	ValidateTemplate                        = validateTemplate
 )
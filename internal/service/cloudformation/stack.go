@@ -64,11 +64,43 @@ func resourceStack() *schema.Resource {
 					ValidateDiagFunc: enum.Validate[awstypes.Capability](),
 				},
 			},
+			"detect_drift": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"disable_rollback": {
 				Type:     schema.TypeBool,
 				Optional: true,
 				ForceNew: true,
 			},
+			"drift_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"drifted_resources": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"logical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"physical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrResourceType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"stack_resource_drift_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			names.AttrIAMRoleARN: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -110,10 +142,42 @@ func resourceStack() *schema.Resource {
 					return json
 				},
 			},
+			"planned_changes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAction: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"logical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"physical_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"replacement": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrResourceType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"policy_url": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"preview_changes": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"template_body": {
@@ -139,6 +203,8 @@ func resourceStack() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			customdiff.ComputedIf("outputs", stackHasActualChanges),
+			customizeDiffValidateTemplate,
+			customizeDiffPreviewChanges,
 		),
 	}
 }
@@ -272,6 +338,27 @@ func resourceStackRead(ctx context.Context, d *schema.ResourceData, meta any) di
 	}
 	d.Set("timeout_in_minutes", stack.TimeoutInMinutes)
 
+	if d.Get("detect_drift").(bool) {
+		// Drift detection is not supported while an operation is in progress.
+		if status := string(stack.StackStatus); strings.HasSuffix(status, "_COMPLETE") || strings.HasSuffix(status, "_FAILED") {
+			output, drifts, err := detectStackDrift(ctx, conn, d.Id())
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "detecting CloudFormation Stack (%s) drift: %s", d.Id(), err)
+			}
+
+			d.Set("drift_status", output.StackDriftStatus)
+			if err := d.Set("drifted_resources", flattenStackResourceDrifts(drifts)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting drifted_resources: %s", err)
+			}
+		}
+	} else {
+		d.Set("drift_status", nil)
+		d.Set("drifted_resources", nil)
+	}
+	// planned_changes is only set in the plan for a stack update.
+	d.Set("planned_changes", nil)
+
 	setTagsOut(ctx, stack.Tags)
 
 	return diags
@@ -679,6 +766,10 @@ func stackHasActualChanges(ctx context.Context, d *schema.ResourceDiff, meta any
 		if attr.ForceNew {
 			continue
 		}
+		// Attributes that only affect Terraform's behavior.
+		if k == "detect_drift" || k == "preview_changes" {
+			continue
+		}
 		if attr.Computed && !attr.Optional {
 			continue
 		}
@@ -697,3 +788,87 @@ func stackHasActualChanges(ctx context.Context, d *schema.ResourceDiff, meta any
 	}
 	return false
 }
+
+// customizeDiffValidateTemplate performs offline checks of template_body against the parameters.
+func customizeDiffValidateTemplate(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	// template_body is read from the stack even when the template is specified by template_url.
+	if v := d.GetRawConfig().GetAttr("template_body"); !v.IsKnown() || v.IsNull() {
+		return nil
+	}
+
+	rawParameters := d.GetRawConfig().GetAttr(names.AttrParameters)
+	if !rawParameters.IsWhollyKnown() {
+		return nil
+	}
+
+	template, err := decodeTemplate(d.Get("template_body").(string))
+	if err != nil {
+		return fmt.Errorf("decoding template_body: %w", err)
+	}
+
+	var configuredParameters []string
+	if !rawParameters.IsNull() {
+		for k := range rawParameters.AsValueMap() {
+			configuredParameters = append(configuredParameters, k)
+		}
+	}
+
+	if err := validateTemplate(template, flex.ExpandStringValueMap(d.Get(names.AttrParameters).(map[string]any)), configuredParameters); err != nil {
+		return fmt.Errorf("validating template_body: %w", err)
+	}
+
+	return nil
+}
+
+// customizeDiffPreviewChanges sets planned_changes to the resource changes in a change set for the planned stack update.
+// Planning therefore creates, and then deletes, a change set on the stack. This is opt-in via preview_changes.
+func customizeDiffPreviewChanges(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() == "" || !d.Get("preview_changes").(bool) || !stackHasActualChanges(ctx, d, meta) {
+		return nil
+	}
+
+	if !d.GetRawPlan().IsWhollyKnown() {
+		return d.SetNewComputed("planned_changes")
+	}
+
+	conn := meta.(*conns.AWSClient).CloudFormationClient(ctx)
+
+	input := cloudformation.CreateChangeSetInput{
+		ChangeSetName: aws.String(id.PrefixedUniqueId("terraform-plan-")),
+		ChangeSetType: awstypes.ChangeSetTypeUpdate,
+		Description:   aws.String("Terraform plan preview"),
+		StackName:     aws.String(d.Id()),
+		Tags:          svcTags(tftags.New(ctx, d.Get(names.AttrTagsAll).(map[string]any)).IgnoreAWS()),
+	}
+
+	if v, ok := d.GetOk("capabilities"); ok {
+		input.Capabilities = flex.ExpandStringyValueSet[awstypes.Capability](v.(*schema.Set))
+	}
+	if v, ok := d.GetOk(names.AttrIAMRoleARN); ok {
+		input.RoleARN = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("notification_arns"); ok {
+		input.NotificationARNs = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+	if v, ok := d.GetOk(names.AttrParameters); ok {
+		input.Parameters = expandParameters(v.(map[string]any))
+	}
+	// As for UpdateStack, template_url takes precedence.
+	if v, ok := d.GetOk("template_url"); ok {
+		input.TemplateURL = aws.String(v.(string))
+	} else if v, ok := d.GetOk("template_body"); ok {
+		template, err := verify.NormalizeJSONOrYAMLString(v)
+		if err != nil {
+			return err
+		}
+		input.TemplateBody = aws.String(template)
+	}
+
+	changes, err := previewStackChanges(ctx, conn, &input)
+
+	if err != nil {
+		return fmt.Errorf("previewing CloudFormation Stack (%s) changes: %w", d.Id(), err)
+	}
+
+	return d.SetNew("planned_changes", flattenResourceChanges(changes))
+}
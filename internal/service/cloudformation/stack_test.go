@@ -9,8 +9,12 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
@@ -575,6 +579,99 @@ func TestAccCloudFormationStack_templateUpdate(t *testing.T) {
 	})
 }
 
+func TestAccCloudFormationStack_detectDrift(t *testing.T) {
+	ctx := acctest.Context(t)
+	var stack awstypes.Stack
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStackDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackConfig_detectDrift(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStackExists(ctx, t, resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "detect_drift", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "drift_status", string(awstypes.StackDriftStatusInSync)),
+					resource.TestCheckResourceAttr(resourceName, "drifted_resources.#", "0"),
+					testAccCheckStackVPCTagUpdated(ctx, t, resourceName),
+				),
+			},
+			{
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "drift_status", string(awstypes.StackDriftStatusDrifted)),
+					resource.TestCheckResourceAttr(resourceName, "drifted_resources.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "drifted_resources.0.logical_resource_id", "MyVPC"),
+					resource.TestCheckResourceAttrPair(resourceName, "drifted_resources.0.physical_resource_id", resourceName, "outputs.VpcID"),
+					resource.TestCheckResourceAttr(resourceName, "drifted_resources.0.resource_type", "AWS::EC2::VPC"),
+					resource.TestCheckResourceAttr(resourceName, "drifted_resources.0.stack_resource_drift_status", string(awstypes.StackResourceDriftStatusModified)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFormationStack_previewChanges(t *testing.T) {
+	ctx := acctest.Context(t)
+	var stack awstypes.Stack
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_cloudformation_stack.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFormationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStackDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStackConfig_previewChanges(rName, "Primary_CF_VPC"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStackExists(ctx, t, resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.#", "0"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+			},
+			{
+				Config: testAccStackConfig_previewChanges(rName, "Updated_CF_VPC"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStackExists(ctx, t, resourceName, &stack),
+					resource.TestCheckResourceAttr(resourceName, "planned_changes.#", "0"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("planned_changes"), knownvalue.ListExact([]knownvalue.Check{
+							knownvalue.ObjectPartial(map[string]knownvalue.Check{
+								names.AttrAction:       knownvalue.StringExact(string(awstypes.ChangeActionModify)),
+								"logical_resource_id":  knownvalue.StringExact("MyVPC"),
+								"replacement":          knownvalue.StringExact(string(awstypes.ReplacementFalse)),
+								names.AttrResourceType: knownvalue.StringExact("AWS::EC2::VPC"),
+							}),
+						})),
+					},
+				},
+			},
+			{
+				Config: testAccStackConfig_previewChanges(rName, "Updated_CF_VPC"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+				},
+			},
+		},
+	})
+}
+
 func testAccCheckStackExists(ctx context.Context, t *testing.T, n string, v *awstypes.Stack) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -596,6 +693,28 @@ func testAccCheckStackExists(ctx context.Context, t *testing.T, n string, v *aws
 	}
 }
 
+func testAccCheckStackVPCTagUpdated(ctx context.Context, t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).EC2Client(ctx)
+
+		input := ec2.CreateTagsInput{
+			Resources: []string{rs.Primary.Attributes["outputs.VpcID"]},
+			Tags: []ec2types.Tag{{
+				Key:   aws.String("Name"),
+				Value: aws.String("Drifted_CF_VPC"),
+			}},
+		}
+		_, err := conn.CreateTags(ctx, &input)
+
+		return err
+	}
+}
+
 func testAccCheckStackDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.ProviderMeta(ctx, t).CloudFormationClient(ctx)
@@ -656,6 +775,62 @@ STACK
 `, rName)
 }
 
+func testAccStackConfig_detectDrift(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack" "test" {
+  name         = %[1]q
+  detect_drift = true
+
+  template_body = <<STACK
+{
+  "Resources" : {
+    "MyVPC": {
+      "Type" : "AWS::EC2::VPC",
+      "Properties" : {
+        "CidrBlock" : "10.0.0.0/16",
+        "Tags" : [
+          {"Key": "Name", "Value": "Primary_CF_VPC"}
+        ]
+      }
+    }
+  },
+  "Outputs" : {
+    "VpcID" : {
+      "Description": "The VPC ID",
+      "Value" : { "Ref" : "MyVPC" }
+    }
+  }
+}
+STACK
+}
+`, rName)
+}
+
+func testAccStackConfig_previewChanges(rName, tagValue string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudformation_stack" "test" {
+  name            = %[1]q
+  preview_changes = true
+
+  template_body = <<STACK
+{
+  "Resources" : {
+    "MyVPC": {
+      "Type" : "AWS::EC2::VPC",
+      "Properties" : {
+        "CidrBlock" : "10.0.0.0/16",
+        "Tags" : [
+          {"Key": "Name", "Value": %[2]q}
+        ]
+      }
+    }
+  }
+}
+STACK
+}
+`, rName, tagValue)
+}
+
 func testAccStackConfig_creationFailure(rName, onFailure string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudformation_stack" "test" {
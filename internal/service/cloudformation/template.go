@@ -0,0 +1,223 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudformation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	tfyaml "github.com/hashicorp/terraform-provider-aws/internal/yaml"
+)
+
+const (
+	templateSectionConditions = "Conditions"
+	templateSectionOutputs    = "Outputs"
+	templateSectionParameters = "Parameters"
+	templateSectionResources  = "Resources"
+	templateSectionTransform  = "Transform"
+)
+
+var (
+	// See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/resources-section-structure.html.
+	templateResourceTypeRegexp = regexache.MustCompile(`^([0-9A-Za-z]{2,64}::[0-9A-Za-z]{2,64}::[0-9A-Za-z]{2,64}(::MODULE)?|Custom::[0-9A-Za-z_@-]{1,60})$`)
+	// Variables in Fn::Sub strings. `${!Literal}` is not a variable.
+	templateSubVariableRegexp = regexache.MustCompile(`\$\{([^!}][^}]*)\}`)
+)
+
+// decodeTemplate decodes a JSON or YAML template.
+// YAML short form intrinsic functions, e.g. `!Ref`, are decoded to their full form, e.g. `{"Ref": ...}`.
+func decodeTemplate(body string) (map[string]any, error) {
+	var v any
+
+	if strings.HasPrefix(strings.TrimSpace(body), "{") {
+		if err := json.Unmarshal([]byte(body), &v); err != nil {
+			return nil, err
+		}
+	} else {
+		var err error
+		if v, err = tfyaml.DecodeFromStringWithTags(body, templateIntrinsicFunction); err != nil {
+			return nil, err
+		}
+	}
+
+	template, ok := v.(map[string]any)
+	if !ok {
+		return nil, errors.New("template is not an object")
+	}
+
+	return template, nil
+}
+
+// templateIntrinsicFunction returns the full form of a YAML short form intrinsic or condition function.
+// See https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/intrinsic-function-reference.html.
+func templateIntrinsicFunction(tag string, v any) (any, error) {
+	name := strings.TrimPrefix(tag, "!")
+
+	switch name {
+	case "Condition", "Ref":
+		return map[string]any{name: v}, nil
+	case "GetAtt":
+		// The short form of Fn::GetAtt is `logicalNameOfResource.attributeName`.
+		if s, ok := v.(string); ok {
+			if resource, attribute, ok := strings.Cut(s, "."); ok {
+				v = []any{resource, attribute}
+			}
+		}
+		fallthrough
+	case "And", "Base64", "Cidr", "Equals", "FindInMap", "GetAZs", "If", "ImportValue", "Join", "Length", "Not", "Or", "Select", "Split", "Sub", "ToJsonString", "Transform":
+		return map[string]any{"Fn::" + name: v}, nil
+	default:
+		return nil, fmt.Errorf("unsupported tag: %s", tag)
+	}
+}
+
+// validateTemplate performs offline checks of a decoded template against the parameter values to be supplied:
+//   - The template declares at least one resource and each resource has a syntactically valid type
+//   - Each supplied parameter is declared and each declared parameter without a default value is supplied
+//   - Each resource, parameter or pseudo parameter referenced by Ref, Fn::GetAtt or Fn::Sub is declared
+//
+// References are not checked in templates with transforms, e.g. AWS::Serverless, as macros can add resources and parameters.
+// configuredParameters are the names of the parameters set in configuration. They are checked against the template's declarations
+// while parameters, which may include parameter values previously read from the stack, satisfy its required parameters.
+func validateTemplate(template map[string]any, parameters map[string]string, configuredParameters []string) error {
+	var errs []error
+
+	resources, _ := template[templateSectionResources].(map[string]any)
+	if len(resources) == 0 {
+		errs = append(errs, errors.New("template declares no resources"))
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(resources)) {
+		resource, ok := resources[name].(map[string]any)
+		if !ok {
+			errs = append(errs, fmt.Errorf("resource %s is not an object", name))
+			continue
+		}
+
+		switch typ, ok := resource["Type"].(string); {
+		case !ok:
+			errs = append(errs, fmt.Errorf("resource %s has no Type", name))
+		case !templateResourceTypeRegexp.MatchString(typ):
+			errs = append(errs, fmt.Errorf("resource %s has invalid Type: %s", name, typ))
+		}
+	}
+
+	declaredParameters, _ := template[templateSectionParameters].(map[string]any)
+
+	for _, name := range slices.Sorted(slices.Values(configuredParameters)) {
+		if _, ok := declaredParameters[name]; !ok {
+			errs = append(errs, fmt.Errorf("parameter %s is not declared in the template", name))
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(declaredParameters)) {
+		if parameter, ok := declaredParameters[name].(map[string]any); ok {
+			if _, ok := parameter["Default"]; ok {
+				continue
+			}
+		}
+
+		if _, ok := parameters[name]; !ok {
+			errs = append(errs, fmt.Errorf("parameter %s has no default value and no value is supplied", name))
+		}
+	}
+
+	if _, ok := template[templateSectionTransform]; ok {
+		return errors.Join(errs...)
+	}
+
+	isDeclared := func(name string) bool {
+		_, isResource := resources[name]
+		_, isParameter := declaredParameters[name]
+
+		return isResource || isParameter || strings.HasPrefix(name, "AWS::")
+	}
+	isResource := func(name string) bool {
+		_, ok := resources[name]
+
+		return ok
+	}
+
+	var refErrs []error
+	for _, section := range []string{templateSectionConditions, templateSectionOutputs, templateSectionResources} {
+		walkTemplateFunctions(template[section], func(function string, v any) {
+			switch function {
+			case "Ref":
+				if name, ok := v.(string); ok && !isDeclared(name) {
+					refErrs = append(refErrs, fmt.Errorf("undeclared resource or parameter referenced by Ref: %s", name))
+				}
+			case "Fn::GetAtt":
+				if s, ok := v.([]any); ok && len(s) > 0 {
+					if name, ok := s[0].(string); ok && !isResource(name) {
+						refErrs = append(refErrs, fmt.Errorf("undeclared resource referenced by Fn::GetAtt: %s", name))
+					}
+				}
+			case "Fn::Sub":
+				var (
+					s         string
+					variables map[string]any
+				)
+				switch v := v.(type) {
+				case string:
+					s = v
+				case []any:
+					if len(v) > 0 {
+						s, _ = v[0].(string)
+					}
+					if len(v) > 1 {
+						variables, _ = v[1].(map[string]any)
+					}
+				}
+
+				for _, match := range templateSubVariableRegexp.FindAllStringSubmatch(s, -1) {
+					name := strings.TrimSpace(match[1])
+					if _, ok := variables[name]; ok {
+						continue
+					}
+
+					if resource, _, ok := strings.Cut(name, "."); ok {
+						if !isResource(resource) {
+							refErrs = append(refErrs, fmt.Errorf("undeclared resource referenced by Fn::Sub: %s", name))
+						}
+					} else if !isDeclared(name) {
+						refErrs = append(refErrs, fmt.Errorf("undeclared resource or parameter referenced by Fn::Sub: %s", name))
+					}
+				}
+			}
+		})
+	}
+
+	// Map iteration order is random, so sort the reference errors.
+	slices.SortFunc(refErrs, func(a, b error) int { return strings.Compare(a.Error(), b.Error()) })
+	errs = append(errs, slices.CompactFunc(refErrs, func(a, b error) bool { return a.Error() == b.Error() })...)
+
+	return errors.Join(errs...)
+}
+
+// walkTemplateFunctions calls f for each intrinsic function, i.e. single key object, in v.
+func walkTemplateFunctions(v any, f func(function string, v any)) {
+	switch v := v.(type) {
+	case map[string]any:
+		if len(v) == 1 {
+			for k, v := range v {
+				if k == "Ref" || k == "Condition" || strings.HasPrefix(k, "Fn::") {
+					f(k, v)
+				}
+			}
+		}
+
+		for _, v := range v {
+			walkTemplateFunctions(v, f)
+		}
+	case []any:
+		for _, v := range v {
+			walkTemplateFunctions(v, f)
+		}
+	}
+}
@@ -0,0 +1,238 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudformation_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/google/go-cmp/cmp"
+	tfcloudformation "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
+)
+
+func TestDecodeTemplate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName      string
+		Input         string
+		ExpectedError *regexp.Regexp
+		Expected      map[string]any
+	}{
+		{
+			TestName: "JSON",
+			Input:    `{"Resources":{"Bucket":{"Type":"AWS::S3::Bucket","Properties":{"BucketName":{"Ref":"Name"}}}}}`,
+			Expected: map[string]any{
+				"Resources": map[string]any{
+					"Bucket": map[string]any{
+						"Type": "AWS::S3::Bucket",
+						"Properties": map[string]any{
+							"BucketName": map[string]any{"Ref": "Name"},
+						},
+					},
+				},
+			},
+		},
+		{
+			TestName:      "invalid JSON",
+			Input:         `{"Resources":`,
+			ExpectedError: regexache.MustCompile(`unexpected end of JSON input`),
+		},
+		{
+			TestName: "YAML short form",
+			Input: `
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+    Properties:
+      BucketName: !Sub "${AWS::StackName}-bucket"
+      Tags:
+        - Key: Name
+          Value: !If [IsProd, !Ref Name, !GetAtt Other.Arn]
+`,
+			Expected: map[string]any{
+				"Resources": map[string]any{
+					"Bucket": map[string]any{
+						"Type": "AWS::S3::Bucket",
+						"Properties": map[string]any{
+							"BucketName": map[string]any{"Fn::Sub": "${AWS::StackName}-bucket"},
+							"Tags": []any{
+								map[string]any{
+									"Key": "Name",
+									"Value": map[string]any{"Fn::If": []any{
+										"IsProd",
+										map[string]any{"Ref": "Name"},
+										map[string]any{"Fn::GetAtt": []any{"Other", "Arn"}},
+									}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			TestName:      "unsupported tag",
+			Input:         `Resources: !Foo bar`,
+			ExpectedError: regexache.MustCompile(`unsupported tag: !Foo`),
+		},
+		{
+			TestName:      "not an object",
+			Input:         `- a`,
+			ExpectedError: regexache.MustCompile(`template is not an object`),
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfcloudformation.DecodeTemplate(testCase.Input)
+
+			if err == nil && testCase.ExpectedError != nil {
+				t.Fatalf("expected error %s, got no error", testCase.ExpectedError.String())
+			}
+
+			if err != nil && testCase.ExpectedError == nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if err != nil && !testCase.ExpectedError.MatchString(err.Error()) {
+				t.Fatalf("expected error %s, got: %s", testCase.ExpectedError.String(), err)
+			}
+
+			if diff := cmp.Diff(got, testCase.Expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateTemplate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName             string
+		Template             string
+		Parameters           map[string]string
+		ConfiguredParameters []string
+		ExpectedError        string
+	}{
+		{
+			TestName: "valid",
+			Template: `
+Parameters:
+  Name:
+    Type: String
+  Suffix:
+    Type: String
+    Default: x
+Conditions:
+  HasName: !Not [!Equals [!Ref Name, ""]]
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+    Properties:
+      BucketName: !Sub "${Name}-${Suffix}-${AWS::Region}-${!Literal}"
+  Policy:
+    Type: AWS::S3::BucketPolicy
+    Properties:
+      Bucket: !Ref Bucket
+      PolicyDocument: !Sub
+        - "${Arn}/*"
+        - Arn: !GetAtt Bucket.Arn
+  Custom:
+    Type: Custom::Thing
+Outputs:
+  Arn:
+    Value: !Sub "${Bucket.Arn}"
+`,
+			Parameters:           map[string]string{"Name": "a"},
+			ConfiguredParameters: []string{"Name"},
+		},
+		{
+			TestName:      "no resources",
+			Template:      `Parameters: {}`,
+			ExpectedError: "template declares no resources",
+		},
+		{
+			TestName: "invalid resource types",
+			Template: `
+Resources:
+  A:
+    Type: AWS::S3
+  B:
+    Properties: {}
+  C: x
+`,
+			ExpectedError: "resource A has invalid Type: AWS::S3\nresource B has no Type\nresource C is not an object",
+		},
+		{
+			TestName: "parameters",
+			Template: `
+Parameters:
+  Required:
+    Type: String
+  Previous:
+    Type: String
+Resources:
+  Topic:
+    Type: AWS::SNS::Topic
+`,
+			Parameters:           map[string]string{"Previous": "a", "Undeclared": "b"},
+			ConfiguredParameters: []string{"Undeclared"},
+			ExpectedError:        "parameter Undeclared is not declared in the template\nparameter Required has no default value and no value is supplied",
+		},
+		{
+			TestName: "references",
+			Template: `
+Resources:
+  Topic:
+    Type: AWS::SNS::Topic
+    Properties:
+      TopicName: !Ref Missing
+      DisplayName: !Sub "${Topic.TopicName}-${Other.Arn}-${Missing}"
+      KmsMasterKeyId: !GetAtt Key.Arn
+Outputs:
+  Arn:
+    Value: !Ref Missing
+`,
+			ExpectedError: "undeclared resource or parameter referenced by Fn::Sub: Missing\nundeclared resource or parameter referenced by Ref: Missing\nundeclared resource referenced by Fn::GetAtt: Key\nundeclared resource referenced by Fn::Sub: Other.Arn",
+		},
+		{
+			TestName: "transform",
+			Template: `
+Transform: AWS::Serverless-2016-10-31
+Resources:
+  Function:
+    Type: AWS::Serverless::Function
+    Properties:
+      Role: !GetAtt GeneratedRole.Arn
+`,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			template, err := tfcloudformation.DecodeTemplate(testCase.Template)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = tfcloudformation.ValidateTemplate(template, testCase.Parameters, testCase.ConfiguredParameters)
+
+			var got string
+			if err != nil {
+				got = err.Error()
+			}
+
+			if got != testCase.ExpectedError {
+				t.Errorf("got error %q, expected %q", got, testCase.ExpectedError)
+			}
+		})
+	}
+}
@@ -302,6 +302,7 @@ func waitAnycastIPListDeployed(ctx context.Context, conn *cloudfront.Client, id
 }
 
 type anycastIPListResourceModel struct {
+	framework.WithAssumeRoleModel
 	AnycastIPs fwtypes.ListOfString `tfsdk:"anycast_ips"`
 	ARN        types.String         `tfsdk:"arn"`
 	ETag       types.String         `tfsdk:"etag"`
@@ -410,6 +410,7 @@ func waitConnectionFunctionPublished(ctx context.Context, conn *cloudfront.Clien
 }
 
 type connectionFunctionResourceModel struct {
+	framework.WithAssumeRoleModel
 	ConnectionFunctionARN    types.String                                         `tfsdk:"connection_function_arn"`
 	ConnectionFunctionCode   types.String                                         `tfsdk:"connection_function_code"`
 	ConnectionFunctionConfig fwtypes.ListNestedObjectValueOf[functionConfigModel] `tfsdk:"connection_function_config"`
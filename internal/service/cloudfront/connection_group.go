@@ -116,6 +116,7 @@ func (r *connectionGroupResource) Schema(ctx context.Context, req resource.Schem
 }
 
 type connectionGroupResourceModel struct {
+	framework.WithAssumeRoleModel
 	AnycastIPListID   types.String      `tfsdk:"anycast_ip_list_id"`
 	ARN               types.String      `tfsdk:"arn"`
 	Enabled           types.Bool        `tfsdk:"enabled"`
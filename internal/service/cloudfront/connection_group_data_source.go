@@ -131,6 +131,7 @@ func (d *connectionGroupDataSource) Read(ctx context.Context, request datasource
 }
 
 type connectionGroupDataSourceModel struct {
+	framework.WithAssumeRoleModel
 	AnycastIPListID  types.String      `tfsdk:"anycast_ip_list_id"`
 	ARN              types.String      `tfsdk:"arn"`
 	Enabled          types.Bool        `tfsdk:"enabled"`
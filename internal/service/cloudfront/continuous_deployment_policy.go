@@ -386,6 +386,7 @@ func continuousDeploymentPolicyARN(ctx context.Context, c *conns.AWSClient, id s
 }
 
 type continuousDeploymentPolicyResourceModel struct {
+	framework.WithAssumeRoleModel
 	ARN                         types.String                                                      `tfsdk:"arn"`
 	Enabled                     types.Bool                                                        `tfsdk:"enabled"`
 	ETag                        types.String                                                      `tfsdk:"etag"`
@@ -43,6 +43,7 @@ type createInvalidationAction struct {
 }
 
 type createInvalidationModel struct {
+	framework.WithAssumeRoleModel
 	DistributionID  types.String         `tfsdk:"distribution_id"`
 	Paths           fwtypes.ListOfString `tfsdk:"paths"`
 	CallerReference types.String         `tfsdk:"caller_reference"`
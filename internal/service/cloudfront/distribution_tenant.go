@@ -210,6 +210,7 @@ func (r *distributionTenantResource) Schema(ctx context.Context, req resource.Sc
 }
 
 type distributionTenantResourceModel struct {
+	framework.WithAssumeRoleModel
 	ARN                       types.String                                                    `tfsdk:"arn"`
 	ConnectionGroupID         types.String                                                    `tfsdk:"connection_group_id"`
 	Customizations            fwtypes.ListNestedObjectValueOf[customizationsModel]            `tfsdk:"customizations"`
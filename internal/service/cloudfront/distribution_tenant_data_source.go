@@ -161,6 +161,7 @@ func (d *distributionTenantDataSource) Read(ctx context.Context, request datasou
 }
 
 type distributionTenantDataSourceModel struct {
+	framework.WithAssumeRoleModel
 	ARN                       types.String                                                    `tfsdk:"arn"`
 	ConnectionGroupID         types.String                                                    `tfsdk:"connection_group_id"`
 	Customizations            fwtypes.ListNestedObjectValueOf[customizationsModel]            `tfsdk:"customizations"`
@@ -306,6 +306,7 @@ func waitKeyValueStoreCreated(ctx context.Context, conn *cloudfront.Client, name
 }
 
 type keyValueStoreResourceModel struct {
+	framework.WithAssumeRoleModel
 	ARN              types.String      `tfsdk:"arn"`
 	Comment          types.String      `tfsdk:"comment"`
 	ETag             types.String      `tfsdk:"etag"`
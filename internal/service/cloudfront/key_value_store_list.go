@@ -94,7 +94,9 @@ func (r *listResourceKeyValueStore) List(ctx context.Context, request list.ListR
 	}
 }
 
-type listKeyValueStoreModel struct{}
+type listKeyValueStoreModel struct {
+	framework.WithAssumeRoleModel
+}
 
 func listKeyValueStores(ctx context.Context, conn *cloudfront.Client, input *cloudfront.ListKeyValueStoresInput) iter.Seq2[awstypes.KeyValueStore, error] {
 	return func(yield func(awstypes.KeyValueStore, error) bool) {
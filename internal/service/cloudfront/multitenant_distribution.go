@@ -1027,6 +1027,7 @@ func disableMultiTenantDistribution(ctx context.Context, conn *cloudfront.Client
 }
 
 type multiTenantDistributionResourceModel struct {
+	framework.WithAssumeRoleModel
 	ActiveTrustedKeyGroups        fwtypes.ListNestedObjectValueOf[activeTrustedKeyGroupsModel] `tfsdk:"active_trusted_key_groups" autoflex:",xmlwrapper=Items,omitempty"`
 	ARN                           types.String                                                 `tfsdk:"arn"`
 	CacheBehavior                 fwtypes.ListNestedObjectValueOf[cacheBehaviorModel]          `tfsdk:"cache_behavior" autoflex:",xmlwrapper=Items,omitempty"`
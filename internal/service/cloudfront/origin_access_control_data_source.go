@@ -94,6 +94,7 @@ func (d *originAccessControlDataSource) Read(ctx context.Context, request dataso
 }
 
 type originAccessControlDataSourceModel struct {
+	framework.WithAssumeRoleModel
 	ARN                           types.String `tfsdk:"arn"`
 	Description                   types.String `tfsdk:"description"`
 	Etag                          types.String `tfsdk:"etag"`
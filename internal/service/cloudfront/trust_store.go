@@ -354,6 +354,7 @@ func findTrustStore(ctx context.Context, conn *cloudfront.Client, input *cloudfr
 }
 
 type trustStoreResourceModel struct {
+	framework.WithAssumeRoleModel
 	ARN                        types.String                                                     `tfsdk:"arn"`
 	CACertificatesBundleSource fwtypes.ListNestedObjectValueOf[caCertificatesBundleSourceModel] `tfsdk:"ca_certificates_bundle_source"`
 	Etag                       types.String                                                     `tfsdk:"etag"`
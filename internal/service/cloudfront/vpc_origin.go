@@ -399,6 +399,7 @@ func waitVPCOriginDeleted(ctx context.Context, conn *cloudfront.Client, id strin
 }
 
 type vpcOriginResourceModel struct {
+	framework.WithAssumeRoleModel
 	ARN                     types.String                                                  `tfsdk:"arn"`
 	ETag                    types.String                                                  `tfsdk:"etag"`
 	ID                      types.String                                                  `tfsdk:"id"`
@@ -309,6 +309,7 @@ func findETagByARN(ctx context.Context, conn *cloudfrontkeyvaluestore.Client, ar
 }
 
 type keyResourceModel struct {
+	framework.WithAssumeRoleModel
 	ID               types.String `tfsdk:"id"`
 	Key              types.String `tfsdk:"key"`
 	KvsARN           fwtypes.ARN  `tfsdk:"key_value_store_arn"`
@@ -338,6 +338,7 @@ type resourceKeyValuePairModel struct {
 }
 
 type keysExclusiveResourceModel struct {
+	framework.WithAssumeRoleModel
 	ResourceKeyValuePair fwtypes.SetNestedObjectValueOf[resourceKeyValuePairModel] `tfsdk:"resource_key_value_pair"`
 	KvsARN               fwtypes.ARN                                               `tfsdk:"key_value_store_arn"`
 	MaximumBatchSize     types.Int64                                               `tfsdk:"max_batch_size"`
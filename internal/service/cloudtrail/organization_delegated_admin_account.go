@@ -187,6 +187,7 @@ func (r *organizationDelegatedAdminAccountResource) Delete(ctx context.Context,
 }
 
 type organizationDelegatedAdminAccountResourceModel struct {
+	framework.WithAssumeRoleModel
 	AccountID        types.String `tfsdk:"account_id"`
 	ARN              types.String `tfsdk:"arn"`
 	Email            types.String `tfsdk:"email"`
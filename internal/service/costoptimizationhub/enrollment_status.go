@@ -250,6 +250,7 @@ func findEnrollmentStatus(ctx context.Context, conn *costoptimizationhub.Client)
 }
 
 type enrollmentStatusResourceModel struct {
+	framework.WithAssumeRoleModel
 	ID                    types.String `tfsdk:"id"`
 	Status                types.String `tfsdk:"status"`
 	IncludeMemberAccounts types.Bool   `tfsdk:"include_member_accounts"`
@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/guardrails"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func (p *servicePackage) BackupBeforeDestroy(context.Context) map[string]conns.BackupBeforeDestroyFunc {
+	return map[string]conns.BackupBeforeDestroyFunc{
+		"aws_dynamodb_table": backupTableBeforeDestroy,
+	}
+}
+
+// backupTableBeforeDestroy creates an on-demand backup of a DynamoDB table.
+// On-demand backups cannot be tagged on creation, so they are tagged once available.
+func backupTableBeforeDestroy(ctx context.Context, d conns.BackupResourceData, meta any, config *guardrails.BackupBeforeDestroyConfig) (string, error) {
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	tableName := d.Get(names.AttrName).(string)
+	input := dynamodb.CreateBackupInput{
+		BackupName: aws.String(config.Name(tableName)),
+		TableName:  aws.String(tableName),
+	}
+	output, err := conn.CreateBackup(ctx, &input)
+
+	if errs.IsA[*awstypes.TableNotFoundException](err) {
+		return "", nil
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("creating DynamoDB Table (%s) backup: %w", tableName, err)
+	}
+
+	arn := aws.ToString(output.BackupDetails.BackupArn)
+
+	if _, err := waitBackupAvailable(ctx, conn, arn, config.Timeout); err != nil {
+		return "", fmt.Errorf("waiting for DynamoDB Backup (%s) create: %w", arn, err)
+	}
+
+	if err := createTags(ctx, conn, arn, svcTags(tftags.New(ctx, config.Tags("aws_dynamodb_table", d.Id())))); err != nil {
+		return "", fmt.Errorf("setting DynamoDB Backup (%s) tags: %w", arn, err)
+	}
+
+	return arn, nil
+}
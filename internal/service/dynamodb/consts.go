@@ -8,3 +8,19 @@ import "time"
 const (
 	propagationTimeout = 2 * time.Minute
 )
+
+type tableItemsFormat string
+
+const (
+	tableItemsFormatCSV          tableItemsFormat = "CSV"
+	tableItemsFormatDynamoDBJSON tableItemsFormat = "DYNAMODB_JSON"
+	tableItemsFormatJSONLines    tableItemsFormat = "JSON_LINES"
+)
+
+func (tableItemsFormat) Values() []tableItemsFormat {
+	return []tableItemsFormat{
+		tableItemsFormatCSV,
+		tableItemsFormatDynamoDBJSON,
+		tableItemsFormatJSONLines,
+	}
+}
@@ -11,6 +11,7 @@ var (
 	ResourceTable                       = resourceTable
 	ResourceTableExport                 = resourceTableExport
 	ResourceTableItem                   = resourceTableItem
+	ResourceTableItems                  = newTableItemsResource
 	ResourceTableReplica                = resourceTableReplica
 	ResourceTag                         = resourceTag
 	ResourceResourcePolicy              = newResourcePolicyResource
@@ -19,6 +20,7 @@ var (
 	ARNForNewRegion                              = arnForNewRegion
 	ContributorInsightsParseResourceID           = contributorInsightsParseResourceID
 	ExpandTableItemAttributes                    = expandTableItemAttributes
+	ExpandTableItemKeys                          = expandTableItemKeys
 	ExpandTableItemQueryKey                      = expandTableItemQueryKey
 	FindContributorInsightsByTwoPartKey          = findContributorInsightsByTwoPartKey
 	FindGlobalTableByName                        = findGlobalTableByName
@@ -28,10 +30,14 @@ var (
 	FindTableByName                              = findTableByName
 	FindTableExportByARN                         = findTableExportByARN
 	FindTableItemByTwoPartKey                    = findTableItemByTwoPartKey
+	FindTableItemsByKeys                         = findTableItemsByKeys
 	FindTag                                      = findTag
 	FlattenTableItemAttributes                   = flattenTableItemAttributes
 	ListTags                                     = listTags
+	ParseTableItems                              = parseTableItems
 	RegionFromARN                                = regionFromARN
+	TableItemHash                                = tableItemHash
+	TableItemKey                                 = tableItemKey
 	ReplicaForRegion                             = replicaForRegion
 	TableNameFromARN                             = tableNameFromARN
 	TableReplicaParseResourceID                  = tableReplicaParseResourceID
@@ -40,4 +46,10 @@ var (
 
 const (
 	GlobalSecondaryIndexExperimentalFlagEnvVar = globalSecondaryIndexExperimentalFlagEnvVar
+
+	TableItemsFormatCSV          = tableItemsFormatCSV
+	TableItemsFormatDynamoDBJSON = tableItemsFormatDynamoDBJSON
+	TableItemsFormatJSONLines    = tableItemsFormatJSONLines
 )
+
+type TableItemsFormat = tableItemsFormat
@@ -128,3 +128,27 @@ func findImportByARN(ctx context.Context, conn *dynamodb.Client, arn string) (*a
 
 	return output.ImportTableDescription, nil
 }
+
+func findBackupByARN(ctx context.Context, conn *dynamodb.Client, arn string) (*awstypes.BackupDescription, error) {
+	input := &dynamodb.DescribeBackupInput{
+		BackupArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeBackup(ctx, input)
+
+	if errs.IsA[*awstypes.BackupNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.BackupDescription == nil || output.BackupDescription.BackupDetails == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return output.BackupDescription, nil
+}
@@ -238,3 +238,19 @@ func statusSSE(conn *dynamodb.Client, tableName string, optFns ...func(*dynamodb
 		return output, string(output.SSEDescription.Status), nil
 	}
 }
+
+func statusBackup(conn *dynamodb.Client, arn string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findBackupByARN(ctx, conn, arn)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.BackupDetails.BackupStatus), nil
+	}
+}
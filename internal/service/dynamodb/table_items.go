@@ -0,0 +1,545 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/backoff"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_dynamodb_table_items", name="Table Items")
+func newTableItemsResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &tableItemsResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultReadTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	// See https://docs.aws.amazon.com/amazondynamodb/latest/APIReference/API_BatchGetItem.html.
+	batchGetItemMaxKeys = 100
+	// See https://docs.aws.amazon.com/amazondynamodb/latest/APIReference/API_BatchWriteItem.html.
+	batchWriteItemMaxItems = 25
+)
+
+type tableItemsResource struct {
+	framework.ResourceWithModel[tableItemsResourceModel]
+	framework.WithTimeouts
+}
+
+func (r *tableItemsResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"attribute_types": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Map{
+					mapvalidator.ValueStringsAre(stringvalidator.OneOf(dataTypeDescriptorBinary, dataTypeDescriptorBoolean, dataTypeDescriptorNumber, dataTypeDescriptorString)),
+				},
+			},
+			"format": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[tableItemsFormat](),
+				Optional:   true,
+				Computed:   true,
+				Default:    stringdefault.StaticString(string(tableItemsFormatDynamoDBJSON)),
+			},
+			"hash_key": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"item_count": schema.Int64Attribute{
+				Computed: true,
+			},
+			"items": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"range_key": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrSource: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			names.AttrTableName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *tableItemsResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Destroy.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan tableItemsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.Source.IsUnknown() || plan.Format.IsUnknown() || plan.AttributeTypes.IsUnknown() || plan.HashKey.IsUnknown() || plan.RangeKey.IsUnknown() {
+		return
+	}
+
+	if !plan.AttributeTypes.IsNull() && plan.Format.ValueEnum() != tableItemsFormatCSV {
+		response.Diagnostics.AddAttributeError(path.Root("attribute_types"), "Invalid Attribute Combination", fmt.Sprintf("attribute_types can only be set when format is %s", tableItemsFormatCSV))
+		return
+	}
+
+	// Compute the desired items from the source file so that the plan shows
+	// the keys of the items to be written or deleted.
+	items, err := readTableItemsSource(ctx, plan)
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root(names.AttrSource), "reading source file", err.Error())
+		return
+	}
+
+	hashes := tableItemHashes(items)
+
+	var oldHashes map[string]string
+	if !request.State.Raw.IsNull() {
+		var state tableItemsResourceModel
+		response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		oldHashes = fwflex.ExpandFrameworkStringValueMap(ctx, state.Items)
+	}
+
+	puts, deletes := diffTableItems(oldHashes, hashes)
+	if n, m := len(puts), len(deletes); n > 0 || m > 0 {
+		response.Diagnostics.AddWarning(
+			"DynamoDB Table Items changes",
+			fmt.Sprintf("%d item(s) will be written to and %d item(s) deleted from DynamoDB Table (%s).", n, m, plan.TableName.ValueString()),
+		)
+	}
+
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("item_count"), int64(len(hashes)))...)
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("items"), fwflex.FlattenFrameworkStringValueMapLegacy(ctx, hashes))...)
+}
+
+func (r *tableItemsResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data tableItemsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DynamoDBClient(ctx)
+
+	tableName := data.TableName.ValueString()
+	items, err := readTableItemsSource(ctx, data)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading source file (%s)", data.Source.ValueString()), err.Error())
+		return
+	}
+
+	if err := checkTableItemsPlanned(ctx, &data, items); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating DynamoDB Table (%s) Items", tableName), err.Error())
+		return
+	}
+
+	if err := putTableItems(ctx, conn, tableName, slices.Collect(maps.Values(items)), r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating DynamoDB Table (%s) Items", tableName), err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *tableItemsResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data tableItemsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DynamoDBClient(ctx)
+
+	tableName := data.TableName.ValueString()
+	oldHashes := fwflex.ExpandFrameworkStringValueMap(ctx, data.Items)
+	keys, err := expandTableItemKeys(slices.Collect(maps.Keys(oldHashes)))
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DynamoDB Table (%s) Items", tableName), err.Error())
+		return
+	}
+
+	items, err := findTableItemsByKeys(ctx, conn, tableName, keys, r.ReadTimeout(ctx, data.Timeouts))
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DynamoDB Table (%s) Items", tableName), err.Error())
+		return
+	}
+
+	// Items deleted outside Terraform are removed from state and items changed outside Terraform
+	// have their hash updated, so that the next plan writes them again.
+	hashes := make(map[string]string, len(items))
+	for _, item := range items {
+		key, err := tableItemKey(item, data.HashKey.ValueString(), data.RangeKey.ValueString())
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading DynamoDB Table (%s) Items", tableName), err.Error())
+			return
+		}
+
+		hash, err := tableItemHash(item)
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading DynamoDB Table (%s) Items", tableName), err.Error())
+			return
+		}
+
+		hashes[key] = hash
+	}
+
+	data.ItemCount = types.Int64Value(int64(len(hashes)))
+	data.Items = fwflex.FlattenFrameworkStringValueMapLegacy(ctx, hashes)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *tableItemsResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state tableItemsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DynamoDBClient(ctx)
+
+	tableName := plan.TableName.ValueString()
+	items, err := readTableItemsSource(ctx, plan)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading source file (%s)", plan.Source.ValueString()), err.Error())
+		return
+	}
+
+	if err := checkTableItemsPlanned(ctx, &plan, items); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating DynamoDB Table (%s) Items", tableName), err.Error())
+		return
+	}
+
+	puts, deletes := diffTableItems(fwflex.ExpandFrameworkStringValueMap(ctx, state.Items), tableItemHashes(items))
+	timeout := r.UpdateTimeout(ctx, plan.Timeouts)
+
+	if err := putTableItems(ctx, conn, tableName, tableItemsForKeys(items, puts), timeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating DynamoDB Table (%s) Items", tableName), err.Error())
+		return
+	}
+
+	if err := deleteTableItems(ctx, conn, tableName, deletes, timeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating DynamoDB Table (%s) Items", tableName), err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *tableItemsResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data tableItemsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DynamoDBClient(ctx)
+
+	tableName := data.TableName.ValueString()
+	keys := slices.Collect(maps.Keys(fwflex.ExpandFrameworkStringValueMap(ctx, data.Items)))
+	err := deleteTableItems(ctx, conn, tableName, keys, r.DeleteTimeout(ctx, data.Timeouts))
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting DynamoDB Table (%s) Items", tableName), err.Error())
+		return
+	}
+}
+
+// readTableItemsSource returns the items in the source file, by key.
+func readTableItemsSource(_ context.Context, data tableItemsResourceModel) (map[string]map[string]awstypes.AttributeValue, error) {
+	f, err := os.Open(data.Source.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var attributeTypes map[string]string
+	if !data.AttributeTypes.IsNull() {
+		attributeTypes = make(map[string]string)
+		for k, v := range data.AttributeTypes.Elements() {
+			attributeTypes[k] = v.(types.String).ValueString()
+		}
+	}
+
+	items, err := parseTableItems(f, data.Format.ValueEnum(), attributeTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	hashKey, rangeKey := data.HashKey.ValueString(), data.RangeKey.ValueString()
+	m := make(map[string]map[string]awstypes.AttributeValue, len(items))
+	for i, item := range items {
+		key, err := tableItemKey(item, hashKey, rangeKey)
+		if err != nil {
+			return nil, fmt.Errorf("item %d: %w", i, err)
+		}
+
+		if _, ok := m[key]; ok {
+			return nil, fmt.Errorf("item %d: duplicate key %s", i, key)
+		}
+
+		m[key] = item
+	}
+
+	return m, nil
+}
+
+func tableItemHashes(items map[string]map[string]awstypes.AttributeValue) map[string]string {
+	hashes := make(map[string]string, len(items))
+
+	for key, item := range items {
+		// Items that cannot be hashed fail validation when they are parsed.
+		hashes[key], _ = tableItemHash(item)
+	}
+
+	return hashes
+}
+
+// checkTableItemsPlanned returns an error if the items differ from the planned items, i.e. the source file changed after planning.
+// Items not known at plan time are set from the source file.
+func checkTableItemsPlanned(ctx context.Context, data *tableItemsResourceModel, items map[string]map[string]awstypes.AttributeValue) error {
+	hashes := tableItemHashes(items)
+
+	if data.Items.IsUnknown() {
+		data.ItemCount = types.Int64Value(int64(len(hashes)))
+		data.Items = fwflex.FlattenFrameworkStringValueMapLegacy(ctx, hashes)
+
+		return nil
+	}
+
+	if !maps.Equal(fwflex.ExpandFrameworkStringValueMap(ctx, data.Items), hashes) {
+		return fmt.Errorf("source file (%s) changed after planning", data.Source.ValueString())
+	}
+
+	return nil
+}
+
+// diffTableItems returns the keys of the items that must be written and deleted to go from old to new.
+func diffTableItems(old, new map[string]string) ([]string, []string) {
+	var puts, deletes []string
+
+	for key, hash := range new {
+		if v, ok := old[key]; !ok || v != hash {
+			puts = append(puts, key)
+		}
+	}
+
+	for key := range old {
+		if _, ok := new[key]; !ok {
+			deletes = append(deletes, key)
+		}
+	}
+
+	slices.Sort(puts)
+	slices.Sort(deletes)
+
+	return puts, deletes
+}
+
+func tableItemsForKeys(items map[string]map[string]awstypes.AttributeValue, keys []string) []map[string]awstypes.AttributeValue {
+	s := make([]map[string]awstypes.AttributeValue, 0, len(keys))
+
+	for _, key := range keys {
+		s = append(s, items[key])
+	}
+
+	return s
+}
+
+func expandTableItemKeys(keys []string) ([]map[string]awstypes.AttributeValue, error) {
+	s := make([]map[string]awstypes.AttributeValue, 0, len(keys))
+
+	for _, key := range keys {
+		v, err := expandTableItemAttributes(key)
+		if err != nil {
+			return nil, fmt.Errorf("item key (%s): %w", key, err)
+		}
+
+		s = append(s, v)
+	}
+
+	return s, nil
+}
+
+func putTableItems(ctx context.Context, conn *dynamodb.Client, tableName string, items []map[string]awstypes.AttributeValue, timeout time.Duration) error {
+	requests := make([]awstypes.WriteRequest, 0, len(items))
+
+	for _, item := range items {
+		requests = append(requests, awstypes.WriteRequest{
+			PutRequest: &awstypes.PutRequest{
+				Item: item,
+			},
+		})
+	}
+
+	return batchWriteTableItems(ctx, conn, tableName, requests, timeout)
+}
+
+func deleteTableItems(ctx context.Context, conn *dynamodb.Client, tableName string, keys []string, timeout time.Duration) error {
+	queryKeys, err := expandTableItemKeys(keys)
+	if err != nil {
+		return err
+	}
+
+	requests := make([]awstypes.WriteRequest, 0, len(queryKeys))
+	for _, key := range queryKeys {
+		requests = append(requests, awstypes.WriteRequest{
+			DeleteRequest: &awstypes.DeleteRequest{
+				Key: key,
+			},
+		})
+	}
+
+	return batchWriteTableItems(ctx, conn, tableName, requests, timeout)
+}
+
+// batchWriteTableItems writes the requests in batches, retrying unprocessed items with exponential backoff.
+func batchWriteTableItems(ctx context.Context, conn *dynamodb.Client, tableName string, requests []awstypes.WriteRequest, timeout time.Duration) error {
+	l := backoff.NewLoop(timeout)
+
+	for chunk := range slices.Chunk(requests, batchWriteItemMaxItems) {
+		input := dynamodb.BatchWriteItemInput{
+			RequestItems: map[string][]awstypes.WriteRequest{
+				tableName: chunk,
+			},
+		}
+
+		for l.Reset(); len(input.RequestItems) > 0; {
+			if !l.Continue(ctx) {
+				return fmt.Errorf("%d item(s) unprocessed after %s", len(input.RequestItems[tableName]), timeout)
+			}
+
+			output, err := conn.BatchWriteItem(ctx, &input)
+
+			if err != nil {
+				return err
+			}
+
+			input.RequestItems = output.UnprocessedItems
+		}
+	}
+
+	return nil
+}
+
+// findTableItemsByKeys reads the items in batches, retrying unprocessed keys with exponential backoff.
+func findTableItemsByKeys(ctx context.Context, conn *dynamodb.Client, tableName string, keys []map[string]awstypes.AttributeValue, timeout time.Duration) ([]map[string]awstypes.AttributeValue, error) {
+	var items []map[string]awstypes.AttributeValue
+	l := backoff.NewLoop(timeout)
+
+	for chunk := range slices.Chunk(keys, batchGetItemMaxKeys) {
+		input := dynamodb.BatchGetItemInput{
+			RequestItems: map[string]awstypes.KeysAndAttributes{
+				tableName: {
+					ConsistentRead: aws.Bool(true),
+					Keys:           chunk,
+				},
+			},
+		}
+
+		for l.Reset(); len(input.RequestItems) > 0; {
+			if !l.Continue(ctx) {
+				return nil, fmt.Errorf("%d key(s) unprocessed after %s", len(input.RequestItems[tableName].Keys), timeout)
+			}
+
+			output, err := conn.BatchGetItem(ctx, &input)
+
+			if err != nil {
+				return nil, err
+			}
+
+			items = append(items, output.Responses[tableName]...)
+			input.RequestItems = output.UnprocessedKeys
+		}
+	}
+
+	return items, nil
+}
+
+type tableItemsResourceModel struct {
+	framework.WithRegionModel
+	AttributeTypes fwtypes.MapOfString                  `tfsdk:"attribute_types"`
+	Format         fwtypes.StringEnum[tableItemsFormat] `tfsdk:"format"`
+	HashKey        types.String                         `tfsdk:"hash_key"`
+	ItemCount      types.Int64                          `tfsdk:"item_count"`
+	Items          types.Map                            `tfsdk:"items"`
+	RangeKey       types.String                         `tfsdk:"range_key"`
+	Source         types.String                         `tfsdk:"source"`
+	TableName      types.String                         `tfsdk:"table_name"`
+	Timeouts       timeouts.Value                       `tfsdk:"timeouts"`
+}
@@ -0,0 +1,341 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	inttypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// parseTableItems reads items from a source file in the specified format.
+//
+// DYNAMODB_JSON sources contain items in DynamoDB JSON, either as a JSON array or as a stream of JSON objects,
+// optionally wrapped in an "Item" object as in DynamoDB table exports.
+// JSON_LINES sources contain one plain JSON object per line. Strings, numbers, Booleans, nulls, arrays and objects
+// are stored as the S, N, BOOL, NULL, L and M data types.
+// CSV sources have a header row of attribute names. Values are stored as the data type set in attributeTypes,
+// S by default. Empty values are omitted.
+func parseTableItems(r io.Reader, format tableItemsFormat, attributeTypes map[string]string) ([]map[string]awstypes.AttributeValue, error) {
+	switch format {
+	case tableItemsFormatCSV:
+		return parseTableItemsCSV(r, attributeTypes)
+	case tableItemsFormatJSONLines:
+		return parseTableItemsJSONLines(r)
+	default:
+		return parseTableItemsDynamoDBJSON(r)
+	}
+}
+
+func parseTableItemsDynamoDBJSON(r io.Reader) ([]map[string]awstypes.AttributeValue, error) {
+	decoder := json.NewDecoder(r)
+
+	var raws []map[string]any
+	for {
+		var v any
+		err := decoder.Decode(&v)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("decoding JSON: %w", err)
+		}
+
+		switch v := v.(type) {
+		case []any:
+			for i, v := range v {
+				m, ok := v.(map[string]any)
+				if !ok {
+					return nil, fmt.Errorf("item %d: unexpected JSON type %T", i, v)
+				}
+				raws = append(raws, m)
+			}
+		case map[string]any:
+			raws = append(raws, v)
+		default:
+			return nil, fmt.Errorf("unexpected JSON type %T", v)
+		}
+	}
+
+	items := make([]map[string]awstypes.AttributeValue, 0, len(raws))
+	for i, raw := range raws {
+		if v, ok := raw["Item"].(map[string]any); ok && len(raw) == 1 {
+			raw = v
+		}
+
+		item, err := tfmaps.ApplyToAllValuesWithError(raw, attributeFromRaw)
+		if err != nil {
+			return nil, fmt.Errorf("item %d: %w", i, err)
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+const (
+	// tableItemsMaxLineSize is the maximum length of a JSON_LINES line, comfortably above the maximum item size of 400 KB.
+	// See https://docs.aws.amazon.com/amazondynamodb/latest/developerguide/ServiceQuotas.html#limits-items.
+	tableItemsMaxLineSize = 1024 * 1024
+)
+
+func parseTableItemsJSONLines(r io.Reader) ([]map[string]awstypes.AttributeValue, error) {
+	var items []map[string]awstypes.AttributeValue
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, tableItemsMaxLineSize)
+	for line := 1; scanner.Scan(); line++ {
+		text := bytes.TrimSpace(scanner.Bytes())
+		if len(text) == 0 {
+			continue
+		}
+
+		decoder := json.NewDecoder(bytes.NewReader(text))
+		decoder.UseNumber()
+
+		var m map[string]any
+		if err := decoder.Decode(&m); err != nil {
+			return nil, fmt.Errorf("line %d: decoding JSON: %w", line, err)
+		}
+
+		item, err := tfmaps.ApplyToAllValuesWithError(m, attributeFromPlainJSON)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		items = append(items, item)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func attributeFromPlainJSON(v any) (awstypes.AttributeValue, error) {
+	switch v := v.(type) {
+	case nil:
+		return &awstypes.AttributeValueMemberNULL{Value: true}, nil
+	case bool:
+		return &awstypes.AttributeValueMemberBOOL{Value: v}, nil
+	case json.Number:
+		return &awstypes.AttributeValueMemberN{Value: v.String()}, nil
+	case string:
+		return &awstypes.AttributeValueMemberS{Value: v}, nil
+	case []any:
+		l := make([]awstypes.AttributeValue, 0, len(v))
+		for _, v := range v {
+			av, err := attributeFromPlainJSON(v)
+			if err != nil {
+				return nil, err
+			}
+			l = append(l, av)
+		}
+		return &awstypes.AttributeValueMemberL{Value: l}, nil
+	case map[string]any:
+		m, err := tfmaps.ApplyToAllValuesWithError(v, attributeFromPlainJSON)
+		if err != nil {
+			return nil, err
+		}
+		return &awstypes.AttributeValueMemberM{Value: m}, nil
+	default:
+		return nil, fmt.Errorf("unexpected JSON type: %T", v)
+	}
+}
+
+func parseTableItemsCSV(r io.Reader, attributeTypes map[string]string) ([]map[string]awstypes.AttributeValue, error) {
+	reader := csv.NewReader(r)
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading CSV header: %w", err)
+	}
+
+	for name := range attributeTypes {
+		if !slices.Contains(header, name) {
+			return nil, fmt.Errorf("attribute type set for unknown column %q", name)
+		}
+	}
+
+	var items []map[string]awstypes.AttributeValue
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading CSV: %w", err)
+		}
+
+		line, _ := reader.FieldPos(0)
+		item := make(map[string]awstypes.AttributeValue, len(record))
+		for i, value := range record {
+			if value == "" {
+				continue
+			}
+
+			name := header[i]
+			av, err := attributeFromCSV(value, attributeTypes[name])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: column %q: %w", line, name, err)
+			}
+			item[name] = av
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+func attributeFromCSV(value, dataType string) (awstypes.AttributeValue, error) {
+	switch dataType {
+	case "", dataTypeDescriptorString:
+		return &awstypes.AttributeValueMemberS{Value: value}, nil
+	case dataTypeDescriptorNumber:
+		if _, ok := new(big.Float).SetString(value); !ok {
+			return nil, fmt.Errorf("invalid number: %q", value)
+		}
+		return &awstypes.AttributeValueMemberN{Value: value}, nil
+	case dataTypeDescriptorBinary:
+		v, err := inttypes.Base64Decode(value)
+		if err != nil {
+			return nil, err
+		}
+		return &awstypes.AttributeValueMemberB{Value: v}, nil
+	case dataTypeDescriptorBoolean:
+		v, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, err
+		}
+		return &awstypes.AttributeValueMemberBOOL{Value: v}, nil
+	default:
+		return nil, fmt.Errorf("unsupported data type: %s", dataType)
+	}
+}
+
+// tableItemKey returns the item's normalized primary key attributes in DynamoDB JSON, which identifies the item in state.
+func tableItemKey(item map[string]awstypes.AttributeValue, hashKey, rangeKey string) (string, error) {
+	if _, ok := item[hashKey]; !ok {
+		return "", fmt.Errorf("missing hash key attribute %q", hashKey)
+	}
+	if _, ok := item[rangeKey]; rangeKey != "" && !ok {
+		return "", fmt.Errorf("missing range key attribute %q", rangeKey)
+	}
+
+	key, err := tfmaps.ApplyToAllValuesWithError(expandTableItemQueryKey(item, hashKey, rangeKey), normalizeTableItemAttribute)
+	if err != nil {
+		return "", err
+	}
+
+	v, err := flattenTableItemAttributes(key)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(v), nil
+}
+
+// tableItemHash returns a digest of the item's content.
+// Numbers and sets are normalized so that the digest of an item read from DynamoDB matches that of the item as written.
+func tableItemHash(item map[string]awstypes.AttributeValue) (string, error) {
+	v, err := tfmaps.ApplyToAllValuesWithError(item, normalizeTableItemAttribute)
+	if err != nil {
+		return "", err
+	}
+
+	s, err := flattenTableItemAttributes(v)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256([]byte(s))
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func normalizeTableItemAttribute(a awstypes.AttributeValue) (awstypes.AttributeValue, error) {
+	switch a := a.(type) {
+	case *awstypes.AttributeValueMemberBS:
+		v := slices.Clone(a.Value)
+		slices.SortFunc(v, bytes.Compare)
+		return &awstypes.AttributeValueMemberBS{Value: v}, nil
+	case *awstypes.AttributeValueMemberL:
+		v := make([]awstypes.AttributeValue, 0, len(a.Value))
+		for _, a := range a.Value {
+			a, err := normalizeTableItemAttribute(a)
+			if err != nil {
+				return nil, err
+			}
+			v = append(v, a)
+		}
+		return &awstypes.AttributeValueMemberL{Value: v}, nil
+	case *awstypes.AttributeValueMemberM:
+		v, err := tfmaps.ApplyToAllValuesWithError(a.Value, normalizeTableItemAttribute)
+		if err != nil {
+			return nil, err
+		}
+		return &awstypes.AttributeValueMemberM{Value: v}, nil
+	case *awstypes.AttributeValueMemberN:
+		v, err := normalizeTableItemNumber(a.Value)
+		if err != nil {
+			return nil, err
+		}
+		return &awstypes.AttributeValueMemberN{Value: v}, nil
+	case *awstypes.AttributeValueMemberNS:
+		v := make([]string, 0, len(a.Value))
+		for _, n := range a.Value {
+			n, err := normalizeTableItemNumber(n)
+			if err != nil {
+				return nil, err
+			}
+			v = append(v, n)
+		}
+		slices.Sort(v)
+		return &awstypes.AttributeValueMemberNS{Value: v}, nil
+	case *awstypes.AttributeValueMemberSS:
+		v := slices.Clone(a.Value)
+		slices.Sort(v)
+		return &awstypes.AttributeValueMemberSS{Value: v}, nil
+	default:
+		return a, nil
+	}
+}
+
+// normalizeTableItemNumber returns the number without insignificant zeros or exponent, as DynamoDB returns numbers.
+func normalizeTableItemNumber(s string) (string, error) {
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(s))
+	if !ok {
+		return "", fmt.Errorf("invalid number: %q", s)
+	}
+
+	if r.IsInt() {
+		return r.Num().String(), nil
+	}
+
+	// DynamoDB numbers have up to 38 digits of precision.
+	v := r.FloatString(38)
+	v = strings.TrimRight(v, "0")
+
+	return v, nil
+}
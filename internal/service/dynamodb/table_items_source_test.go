@@ -0,0 +1,232 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb_test
+
+import (
+	"strings"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/google/go-cmp/cmp"
+	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+)
+
+func TestParseTableItems(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		content        string
+		format         tfdynamodb.TableItemsFormat
+		attributeTypes map[string]string
+		expected       []string
+		expectError    bool
+	}
+	tests := map[string]testCase{
+		"DynamoDB JSON array": {
+			content: `[{"id":{"S":"a"},"n":{"N":"1"}},{"id":{"S":"b"},"ss":{"SS":["x","y"]}}]`,
+			format:  tfdynamodb.TableItemsFormatDynamoDBJSON,
+			expected: []string{
+				`{"id":{"S":"a"},"n":{"N":"1"}}`,
+				`{"id":{"S":"b"},"ss":{"SS":["x","y"]}}`,
+			},
+		},
+		"DynamoDB JSON stream": {
+			content: "{\"Item\":{\"id\":{\"S\":\"a\"}}}\n{\"Item\":{\"id\":{\"S\":\"b\"},\"m\":{\"M\":{\"k\":{\"BOOL\":true}}}}}\n",
+			format:  tfdynamodb.TableItemsFormatDynamoDBJSON,
+			expected: []string{
+				`{"id":{"S":"a"}}`,
+				`{"id":{"S":"b"},"m":{"M":{"k":{"BOOL":true}}}}`,
+			},
+		},
+		"DynamoDB JSON invalid": {
+			content:     `[{"id":{"X":"a"}}]`,
+			format:      tfdynamodb.TableItemsFormatDynamoDBJSON,
+			expectError: true,
+		},
+		"JSON lines": {
+			content: "{\"id\":\"a\",\"n\":1.50,\"ok\":true,\"l\":[1,\"x\"],\"m\":{\"k\":null}}\n\n{\"id\":\"b\"}\n",
+			format:  tfdynamodb.TableItemsFormatJSONLines,
+			expected: []string{
+				`{"id":{"S":"a"},"l":{"L":[{"N":"1"},{"S":"x"}]},"m":{"M":{"k":{"NULL":true}}},"n":{"N":"1.50"},"ok":{"BOOL":true}}`,
+				`{"id":{"S":"b"}}`,
+			},
+		},
+		"JSON lines not an object": {
+			content:     "[1,2]\n",
+			format:      tfdynamodb.TableItemsFormatJSONLines,
+			expectError: true,
+		},
+		"CSV": {
+			content: "id,n,ok,note\na,1,true,first\nb,2,false,\n",
+			format:  tfdynamodb.TableItemsFormatCSV,
+			attributeTypes: map[string]string{
+				"n":  "N",
+				"ok": "BOOL",
+			},
+			expected: []string{
+				`{"id":{"S":"a"},"n":{"N":"1"},"note":{"S":"first"},"ok":{"BOOL":true}}`,
+				`{"id":{"S":"b"},"n":{"N":"2"},"ok":{"BOOL":false}}`,
+			},
+		},
+		"CSV invalid number": {
+			content: "id,n\na,one\n",
+			format:  tfdynamodb.TableItemsFormatCSV,
+			attributeTypes: map[string]string{
+				"n": "N",
+			},
+			expectError: true,
+		},
+		"CSV unknown column": {
+			content: "id\na\n",
+			format:  tfdynamodb.TableItemsFormatCSV,
+			attributeTypes: map[string]string{
+				"n": "N",
+			},
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			items, err := tfdynamodb.ParseTableItems(strings.NewReader(test.content), test.format, test.attributeTypes)
+
+			if got, want := err != nil, test.expectError; got != want {
+				t.Fatalf("ParseTableItems() err %t, want %t: %v", got, want, err)
+			}
+			if err != nil {
+				return
+			}
+
+			var got []string
+			for _, item := range items {
+				v, err := tfdynamodb.FlattenTableItemAttributes(item)
+				if err != nil {
+					t.Fatal(err)
+				}
+				got = append(got, strings.TrimSpace(v))
+			}
+
+			if diff := cmp.Diff(got, test.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTableItemKey(t *testing.T) {
+	t.Parallel()
+
+	item := map[string]awstypes.AttributeValue{
+		"id":   &awstypes.AttributeValueMemberS{Value: "a"},
+		"sort": &awstypes.AttributeValueMemberN{Value: "1"},
+		"data": &awstypes.AttributeValueMemberS{Value: "x"},
+	}
+
+	type testCase struct {
+		hashKey     string
+		rangeKey    string
+		expected    string
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"hash key": {
+			hashKey:  "id",
+			expected: `{"id":{"S":"a"}}`,
+		},
+		"hash and range key": {
+			hashKey:  "id",
+			rangeKey: "sort",
+			expected: `{"id":{"S":"a"},"sort":{"N":"1"}}`,
+		},
+		"missing hash key": {
+			hashKey:     "pk",
+			expectError: true,
+		},
+		"missing range key": {
+			hashKey:     "id",
+			rangeKey:    "sk",
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfdynamodb.TableItemKey(item, test.hashKey, test.rangeKey)
+
+			if got, want := err != nil, test.expectError; got != want {
+				t.Fatalf("TableItemKey() err %t, want %t: %v", got, want, err)
+			}
+			if err == nil && got != test.expected {
+				t.Errorf("got %s, expected %s", got, test.expected)
+			}
+		})
+	}
+}
+
+func TestTableItemHash(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		a, b  string
+		equal bool
+	}
+	tests := map[string]testCase{
+		"identical": {
+			a:     `{"id":{"S":"a"}}`,
+			b:     `{"id":{"S":"a"}}`,
+			equal: true,
+		},
+		"number formatting": {
+			a:     `{"id":{"S":"a"},"n":{"N":"1.50"},"m":{"M":{"e":{"N":"1e2"}}}}`,
+			b:     `{"id":{"S":"a"},"n":{"N":"1.5"},"m":{"M":{"e":{"N":"100"}}}}`,
+			equal: true,
+		},
+		"set order": {
+			a:     `{"id":{"S":"a"},"ss":{"SS":["y","x"]},"ns":{"NS":["2","1.0"]}}`,
+			b:     `{"id":{"S":"a"},"ss":{"SS":["x","y"]},"ns":{"NS":["1","2"]}}`,
+			equal: true,
+		},
+		"list order": {
+			a: `{"id":{"S":"a"},"l":{"L":[{"S":"x"},{"S":"y"}]}}`,
+			b: `{"id":{"S":"a"},"l":{"L":[{"S":"y"},{"S":"x"}]}}`,
+		},
+		"different value": {
+			a: `{"id":{"S":"a"},"v":{"S":"x"}}`,
+			b: `{"id":{"S":"a"},"v":{"S":"y"}}`,
+		},
+		"different type": {
+			a: `{"id":{"S":"a"},"v":{"S":"1"}}`,
+			b: `{"id":{"S":"a"},"v":{"N":"1"}}`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var hashes []string
+			for _, v := range []string{test.a, test.b} {
+				item, err := tfdynamodb.ExpandTableItemAttributes(v)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				hash, err := tfdynamodb.TableItemHash(item)
+				if err != nil {
+					t.Fatal(err)
+				}
+
+				hashes = append(hashes, hash)
+			}
+
+			if got, want := hashes[0] == hashes[1], test.equal; got != want {
+				t.Errorf("hashes equal %t, expected %t", got, want)
+			}
+		})
+	}
+}
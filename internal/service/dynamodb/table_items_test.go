@@ -0,0 +1,364 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfknownvalue "github.com/hashicorp/terraform-provider-aws/internal/acctest/knownvalue"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDynamoDBTableItems_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+	source := testAccTableItemsCreateSource(t, `{"id":"a","n":1}
+{"id":"b","n":2}
+{"id":"c","n":3}
+`)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemsExists(ctx, t, resourceName),
+					testAccCheckTableItemCount(ctx, t, rName, 3),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("format"), tfknownvalue.StringExact(tfdynamodb.TableItemsFormatJSONLines)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("hash_key"), knownvalue.StringExact("id")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("item_count"), knownvalue.Int64Exact(3)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("items"), knownvalue.MapSizeExact(3)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("items").AtMapKey(`{"id":{"S":"a"}}`), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTableName), knownvalue.StringExact(rName)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+	source := testAccTableItemsCreateSource(t, `{"id":"a","n":1}
+{"id":"b","n":2}
+`)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemsExists(ctx, t, resourceName),
+					testAccCheckTableItemsDisappears(ctx, t, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_disappears_Table(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+	source := testAccTableItemsCreateSource(t, `{"id":"a","n":1}
+`)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemsExists(ctx, t, resourceName),
+					acctest.CheckSDKResourceDisappears(ctx, t, tfdynamodb.ResourceTable(), "aws_dynamodb_table.test"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+	source := testAccTableItemsCreateSource(t, `{"id":"a","n":1}
+{"id":"b","n":2}
+`)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemsExists(ctx, t, resourceName),
+					testAccCheckTableItemCount(ctx, t, rName, 2),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("item_count"), knownvalue.Int64Exact(2)),
+				},
+			},
+			{
+				// Item "a" changed, item "b" removed and item "c" added.
+				PreConfig: func() {
+					testAccTableItemsWriteSource(t, source, `{"id":"a","n":10}
+{"id":"c","n":3}
+`)
+				},
+				Config: testAccTableItemsConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemsExists(ctx, t, resourceName),
+					testAccCheckTableItemCount(ctx, t, rName, 2),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("item_count"), knownvalue.Int64Exact(2)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("items").AtMapKey(`{"id":{"S":"a"}}`), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("items").AtMapKey(`{"id":{"S":"c"}}`), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_csv(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+	source := testAccTableItemsCreateSource(t, `id,n,label
+a,1,first
+b,2,
+`)
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_csv(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemsExists(ctx, t, resourceName),
+					testAccCheckTableItemCount(ctx, t, rName, 2),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("attribute_types"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"n": knownvalue.StringExact("N"),
+					})),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("item_count"), knownvalue.Int64Exact(2)),
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckTableItemsDestroy(ctx context.Context, t *testing.T) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.ProviderMeta(ctx, t).DynamoDBClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_dynamodb_table_items" {
+				continue
+			}
+
+			keys, err := tfdynamodb.ExpandTableItemKeys(testAccTableItemsKeys(rs))
+			if err != nil {
+				return err
+			}
+
+			items, err := tfdynamodb.FindTableItemsByKeys(ctx, conn, rs.Primary.Attributes[names.AttrTableName], keys, 5*time.Minute)
+
+			if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if n := len(items); n > 0 {
+				return fmt.Errorf("%d DynamoDB Table (%s) Items still exist", n, rs.Primary.Attributes[names.AttrTableName])
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTableItemsExists(ctx context.Context, t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).DynamoDBClient(ctx)
+
+		keys, err := tfdynamodb.ExpandTableItemKeys(testAccTableItemsKeys(rs))
+		if err != nil {
+			return err
+		}
+
+		items, err := tfdynamodb.FindTableItemsByKeys(ctx, conn, rs.Primary.Attributes[names.AttrTableName], keys, 5*time.Minute)
+
+		if err != nil {
+			return err
+		}
+
+		if got, want := len(items), len(keys); got != want {
+			return fmt.Errorf("DynamoDB Table (%s) Items: found %d of %d", rs.Primary.Attributes[names.AttrTableName], got, want)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckTableItemsDisappears deletes the managed items out of band.
+func testAccCheckTableItemsDisappears(ctx context.Context, t *testing.T, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.ProviderMeta(ctx, t).DynamoDBClient(ctx)
+
+		keys, err := tfdynamodb.ExpandTableItemKeys(testAccTableItemsKeys(rs))
+		if err != nil {
+			return err
+		}
+
+		for _, key := range keys {
+			input := dynamodb.DeleteItemInput{
+				Key:       key,
+				TableName: aws.String(rs.Primary.Attributes[names.AttrTableName]),
+			}
+
+			if _, err := conn.DeleteItem(ctx, &input); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// testAccTableItemsKeys returns the item keys recorded in the resource's `items` attribute.
+func testAccTableItemsKeys(rs *terraform.ResourceState) []string {
+	var keys []string
+
+	for k := range rs.Primary.Attributes {
+		if key, ok := strings.CutPrefix(k, "items."); ok && key != "%" {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+func testAccTableItemsCreateSource(t *testing.T, content string) string {
+	t.Helper()
+
+	filename := filepath.Join(t.TempDir(), "items")
+	testAccTableItemsWriteSource(t, filename, content)
+
+	return filename
+}
+
+func testAccTableItemsWriteSource(t *testing.T, filename, content string) {
+	t.Helper()
+
+	if err := os.WriteFile(filename, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccTableItemsConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "id"
+
+  attribute {
+    name = "id"
+    type = "S"
+  }
+}
+`, rName)
+}
+
+func testAccTableItemsConfig_basic(rName, source string) string {
+	return acctest.ConfigCompose(testAccTableItemsConfig_base(rName), fmt.Sprintf(`
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+  source     = %[1]q
+  format     = "JSON_LINES"
+}
+`, source))
+}
+
+func testAccTableItemsConfig_csv(rName, source string) string {
+	return acctest.ConfigCompose(testAccTableItemsConfig_base(rName), fmt.Sprintf(`
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+  hash_key   = aws_dynamodb_table.test.hash_key
+  source     = %[1]q
+  format     = "CSV"
+
+  attribute_types = {
+    n = "N"
+  }
+}
+`, source))
+}
@@ -291,3 +291,21 @@ func waitReplicaSSEUpdated(ctx context.Context, conn *dynamodb.Client, region, t
 
 	return nil, err
 }
+
+func waitBackupAvailable(ctx context.Context, conn *dynamodb.Client, arn string, timeout time.Duration) (*awstypes.BackupDescription, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.BackupStatusCreating),
+		Target:     enum.Slice(awstypes.BackupStatusAvailable),
+		Refresh:    statusBackup(conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.BackupDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/guardrails"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func (p *servicePackage) BackupBeforeDestroy(context.Context) map[string]conns.BackupBeforeDestroyFunc {
+	return map[string]conns.BackupBeforeDestroyFunc{
+		"aws_ebs_volume": backupEBSVolumeBeforeDestroy,
+	}
+}
+
+func backupEBSVolumeBeforeDestroy(ctx context.Context, d conns.BackupResourceData, meta any, config *guardrails.BackupBeforeDestroyConfig) (string, error) {
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	input := ec2.CreateSnapshotInput{
+		Description:       aws.String(config.Name(d.Id())),
+		TagSpecifications: tagSpecificationsFromKeyValue(tftags.New(ctx, config.Tags("aws_ebs_volume", d.Id())), string(awstypes.ResourceTypeSnapshot)),
+		VolumeId:          aws.String(d.Id()),
+	}
+	outputRaw, err := tfresource.RetryWhenAWSErrMessageContains(ctx, 1*time.Minute,
+		func(ctx context.Context) (any, error) {
+			return conn.CreateSnapshot(ctx, &input)
+		},
+		errCodeSnapshotCreationPerVolumeRateExceeded, "The maximum per volume CreateSnapshot request rate has been exceeded")
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVolumeNotFound) {
+		return "", nil
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("creating EBS Snapshot (%s): %w", d.Id(), err)
+	}
+
+	snapshotID := aws.ToString(outputRaw.(*ec2.CreateSnapshotOutput).SnapshotId)
+
+	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, config.Timeout,
+		func(ctx context.Context) (any, error) {
+			return waitSnapshotCompleted(ctx, conn, snapshotID, config.Timeout)
+		},
+		errCodeResourceNotReady)
+
+	if err != nil {
+		return "", fmt.Errorf("waiting for EBS Snapshot (%s) create: %w", snapshotID, err)
+	}
+
+	return snapshotID, nil
+}
@@ -170,6 +170,7 @@ func (d *lifecyclePolicyDocumentDataSource) Read(ctx context.Context, request da
 }
 
 type lifecyclePolicyDocumentDataSourceModel struct {
+	framework.WithAssumeRoleModel
 	JSON  types.String                                                 `tfsdk:"json"`
 	Rules fwtypes.ListNestedObjectValueOf[lifecyclePolicyDocumentRule] `tfsdk:"rule"`
 }
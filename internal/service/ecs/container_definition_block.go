@@ -0,0 +1,735 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Defaults applied by the ECS API to container definitions.
+// See https://docs.aws.amazon.com/AmazonECS/latest/developerguide/task_definition_parameters.html#container_definitions.
+const (
+	containerDefinitionHealthCheckIntervalDefault = 30
+	containerDefinitionHealthCheckRetriesDefault  = 3
+	containerDefinitionHealthCheckTimeoutDefault  = 5
+)
+
+// containerDefinitionSchema returns the schema for the structured "container_definition" block,
+// an alternative to the JSON-encoded "container_definitions" attribute.
+func containerDefinitionSchema() *schema.Schema {
+	secretSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			ForceNew: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					names.AttrName: {
+						Type:     schema.TypeString,
+						Required: true,
+						ForceNew: true,
+					},
+					"value_from": {
+						Type:     schema.TypeString,
+						Required: true,
+						ForceNew: true,
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		ForceNew: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"command": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"cpu": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"dependency": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrCondition: {
+								Type:             schema.TypeString,
+								Required:         true,
+								ForceNew:         true,
+								ValidateDiagFunc: enum.Validate[awstypes.ContainerCondition](),
+							},
+							"container_name": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"entry_point": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				names.AttrEnvironment: {
+					Type:     schema.TypeSet,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrName: {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+							names.AttrValue: {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"essential": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+					Default:  true,
+				},
+				names.AttrHealthCheck: {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"command": {
+								Type:     schema.TypeList,
+								Required: true,
+								ForceNew: true,
+								MinItems: 1,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							names.AttrInterval: {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								Default:      containerDefinitionHealthCheckIntervalDefault,
+								ValidateFunc: validation.IntBetween(5, 300),
+							},
+							"retries": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								Default:      containerDefinitionHealthCheckRetriesDefault,
+								ValidateFunc: validation.IntBetween(1, 10),
+							},
+							"start_period": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IntBetween(0, 300),
+							},
+							names.AttrTimeout: {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								Default:      containerDefinitionHealthCheckTimeoutDefault,
+								ValidateFunc: validation.IntBetween(2, 120),
+							},
+						},
+					},
+				},
+				"image": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringLenBetween(1, 255),
+				},
+				"log_configuration": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"log_driver": {
+								Type:             schema.TypeString,
+								Required:         true,
+								ForceNew:         true,
+								ValidateDiagFunc: enum.Validate[awstypes.LogDriver](),
+							},
+							"options": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								ForceNew: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"secret_option": secretSchema(),
+						},
+					},
+				},
+				"memory": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(6),
+				},
+				"memory_reservation": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(6),
+				},
+				"mount_point": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"container_path": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+							"read_only": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								ForceNew: true,
+							},
+							"source_volume": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				names.AttrName: {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+					ValidateFunc: validation.All(
+						validation.StringLenBetween(1, 255),
+						validation.StringMatch(regexache.MustCompile("^[0-9A-Za-z_-]+$"), "must contain only alphanumerics, hyphens, and underscores"),
+					),
+				},
+				"port_mapping": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"app_protocol": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								ForceNew:         true,
+								ValidateDiagFunc: enum.Validate[awstypes.ApplicationProtocol](),
+							},
+							"container_port": {
+								Type:         schema.TypeInt,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IsPortNumber,
+							},
+							// The API sets the host port to the container port for "awsvpc" network mode
+							// and leaves it unset (dynamic) otherwise.
+							"host_port": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IsPortNumberOrZero,
+							},
+							names.AttrName: {
+								Type:     schema.TypeString,
+								Optional: true,
+								ForceNew: true,
+							},
+							names.AttrProtocol: {
+								Type:             schema.TypeString,
+								Optional:         true,
+								ForceNew:         true,
+								Default:          awstypes.TransportProtocolTcp,
+								ValidateDiagFunc: enum.Validate[awstypes.TransportProtocol](),
+							},
+						},
+					},
+				},
+				"privileged": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+				},
+				"readonly_root_filesystem": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+				},
+				"secret": secretSchema(),
+				"stop_timeout": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntBetween(0, 120),
+				},
+				"user": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+				"working_directory": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func expandContainerDefinitionBlocks(tfList []any) ([]awstypes.ContainerDefinition, error) {
+	if len(tfList) == 0 {
+		return nil, nil
+	}
+
+	var apiObjects []awstypes.ContainerDefinition
+	seen := make(map[string]struct{})
+
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("invalid container_definition supplied at index (%d)", i)
+		}
+
+		apiObject := expandContainerDefinitionBlock(tfMap)
+		name := aws.ToString(apiObject.Name)
+
+		if _, ok := seen[name]; ok {
+			return nil, fmt.Errorf("duplicate container_definition name (%s)", name)
+		}
+		seen[name] = struct{}{}
+
+		if apiObject.Memory != nil && apiObject.MemoryReservation != nil && aws.ToInt32(apiObject.MemoryReservation) > aws.ToInt32(apiObject.Memory) {
+			return nil, fmt.Errorf("container_definition (%s): memory_reservation (%d) must be less than or equal to memory (%d)", name, aws.ToInt32(apiObject.MemoryReservation), aws.ToInt32(apiObject.Memory))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	for _, apiObject := range apiObjects {
+		for _, dependency := range apiObject.DependsOn {
+			containerName := aws.ToString(dependency.ContainerName)
+
+			if containerName == aws.ToString(apiObject.Name) {
+				return nil, fmt.Errorf("container_definition (%s): dependency on itself", containerName)
+			}
+
+			if _, ok := seen[containerName]; !ok {
+				return nil, fmt.Errorf("container_definition (%s): dependency on unknown container (%s)", aws.ToString(apiObject.Name), containerName)
+			}
+		}
+	}
+
+	return apiObjects, nil
+}
+
+func expandContainerDefinitionBlock(tfMap map[string]any) awstypes.ContainerDefinition {
+	apiObject := awstypes.ContainerDefinition{
+		Essential: aws.Bool(tfMap["essential"].(bool)),
+		Image:     aws.String(tfMap["image"].(string)),
+		Name:      aws.String(tfMap[names.AttrName].(string)),
+	}
+
+	if v, ok := tfMap["command"].([]any); ok && len(v) > 0 {
+		apiObject.Command = flex.ExpandStringValueList(v)
+	}
+
+	if v, ok := tfMap["cpu"].(int); ok {
+		apiObject.Cpu = int32(v)
+	}
+
+	if v, ok := tfMap["dependency"].([]any); ok && len(v) > 0 {
+		apiObject.DependsOn = expandContainerDependencies(v)
+	}
+
+	if v, ok := tfMap["entry_point"].([]any); ok && len(v) > 0 {
+		apiObject.EntryPoint = flex.ExpandStringValueList(v)
+	}
+
+	if v, ok := tfMap[names.AttrEnvironment].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Environment = expandKeyValuePairs(v.List())
+	}
+
+	if v, ok := tfMap[names.AttrHealthCheck].([]any); ok && len(v) > 0 && v[0] != nil {
+		apiObject.HealthCheck = expandContainerHealthCheck(v[0].(map[string]any))
+	}
+
+	if v, ok := tfMap["log_configuration"].([]any); ok && len(v) > 0 && v[0] != nil {
+		apiObject.LogConfiguration = expandContainerLogConfiguration(v[0].(map[string]any))
+	}
+
+	if v, ok := tfMap["memory"].(int); ok && v != 0 {
+		apiObject.Memory = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["memory_reservation"].(int); ok && v != 0 {
+		apiObject.MemoryReservation = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["mount_point"].([]any); ok && len(v) > 0 {
+		apiObject.MountPoints = expandContainerMountPoints(v)
+	}
+
+	if v, ok := tfMap["port_mapping"].([]any); ok && len(v) > 0 {
+		apiObject.PortMappings = expandContainerPortMappings(v)
+	}
+
+	if v, ok := tfMap["privileged"].(bool); ok && v {
+		apiObject.Privileged = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["readonly_root_filesystem"].(bool); ok && v {
+		apiObject.ReadonlyRootFilesystem = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["secret"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.Secrets = expandContainerSecrets(v.List())
+	}
+
+	if v, ok := tfMap["stop_timeout"].(int); ok && v != 0 {
+		apiObject.StopTimeout = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["user"].(string); ok && v != "" {
+		apiObject.User = aws.String(v)
+	}
+
+	if v, ok := tfMap["working_directory"].(string); ok && v != "" {
+		apiObject.WorkingDirectory = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandContainerDependencies(tfList []any) []awstypes.ContainerDependency {
+	var apiObjects []awstypes.ContainerDependency
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, awstypes.ContainerDependency{
+			Condition:     awstypes.ContainerCondition(tfMap[names.AttrCondition].(string)),
+			ContainerName: aws.String(tfMap["container_name"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandKeyValuePairs(tfList []any) []awstypes.KeyValuePair {
+	var apiObjects []awstypes.KeyValuePair
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, awstypes.KeyValuePair{
+			Name:  aws.String(tfMap[names.AttrName].(string)),
+			Value: aws.String(tfMap[names.AttrValue].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandContainerHealthCheck(tfMap map[string]any) *awstypes.HealthCheck {
+	apiObject := &awstypes.HealthCheck{
+		Command:  flex.ExpandStringValueList(tfMap["command"].([]any)),
+		Interval: aws.Int32(int32(tfMap[names.AttrInterval].(int))),
+		Retries:  aws.Int32(int32(tfMap["retries"].(int))),
+		Timeout:  aws.Int32(int32(tfMap[names.AttrTimeout].(int))),
+	}
+
+	if v, ok := tfMap["start_period"].(int); ok && v != 0 {
+		apiObject.StartPeriod = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func expandContainerLogConfiguration(tfMap map[string]any) *awstypes.LogConfiguration {
+	apiObject := &awstypes.LogConfiguration{
+		LogDriver: awstypes.LogDriver(tfMap["log_driver"].(string)),
+	}
+
+	if v, ok := tfMap["options"].(map[string]any); ok && len(v) > 0 {
+		apiObject.Options = flex.ExpandStringValueMap(v)
+	}
+
+	if v, ok := tfMap["secret_option"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SecretOptions = expandContainerSecrets(v.List())
+	}
+
+	return apiObject
+}
+
+func expandContainerMountPoints(tfList []any) []awstypes.MountPoint {
+	var apiObjects []awstypes.MountPoint
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, awstypes.MountPoint{
+			ContainerPath: aws.String(tfMap["container_path"].(string)),
+			ReadOnly:      aws.Bool(tfMap["read_only"].(bool)),
+			SourceVolume:  aws.String(tfMap["source_volume"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandContainerPortMappings(tfList []any) []awstypes.PortMapping {
+	var apiObjects []awstypes.PortMapping
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.PortMapping{
+			ContainerPort: aws.Int32(int32(tfMap["container_port"].(int))),
+		}
+
+		if v, ok := tfMap["app_protocol"].(string); ok && v != "" {
+			apiObject.AppProtocol = awstypes.ApplicationProtocol(v)
+		}
+
+		if v, ok := tfMap["host_port"].(int); ok && v != 0 {
+			apiObject.HostPort = aws.Int32(int32(v))
+		}
+
+		if v, ok := tfMap[names.AttrName].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		if v, ok := tfMap[names.AttrProtocol].(string); ok && v != "" {
+			apiObject.Protocol = awstypes.TransportProtocol(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandContainerSecrets(tfList []any) []awstypes.Secret {
+	var apiObjects []awstypes.Secret
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, awstypes.Secret{
+			Name:      aws.String(tfMap[names.AttrName].(string)),
+			ValueFrom: aws.String(tfMap["value_from"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenContainerDefinitionBlocks(apiObjects []awstypes.ContainerDefinition) []any {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []any
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, flattenContainerDefinitionBlock(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenContainerDefinitionBlock(apiObject awstypes.ContainerDefinition) map[string]any {
+	tfMap := map[string]any{
+		"command":                  apiObject.Command,
+		"cpu":                      apiObject.Cpu,
+		"dependency":               flattenContainerDependencies(apiObject.DependsOn),
+		"entry_point":              apiObject.EntryPoint,
+		names.AttrEnvironment:      flattenKeyValuePairs(apiObject.Environment),
+		"essential":                aws.ToBool(apiObject.Essential),
+		"image":                    aws.ToString(apiObject.Image),
+		"memory":                   aws.ToInt32(apiObject.Memory),
+		"memory_reservation":       aws.ToInt32(apiObject.MemoryReservation),
+		"mount_point":              flattenContainerMountPoints(apiObject.MountPoints),
+		names.AttrName:             aws.ToString(apiObject.Name),
+		"port_mapping":             flattenContainerPortMappings(apiObject.PortMappings),
+		"privileged":               aws.ToBool(apiObject.Privileged),
+		"readonly_root_filesystem": aws.ToBool(apiObject.ReadonlyRootFilesystem),
+		"secret":                   flattenContainerSecrets(apiObject.Secrets),
+		"stop_timeout":             aws.ToInt32(apiObject.StopTimeout),
+		"user":                     aws.ToString(apiObject.User),
+		"working_directory":        aws.ToString(apiObject.WorkingDirectory),
+	}
+
+	// Essential defaults to true.
+	if apiObject.Essential == nil {
+		tfMap["essential"] = true
+	}
+
+	if v := apiObject.HealthCheck; v != nil {
+		tfMap[names.AttrHealthCheck] = []any{flattenContainerHealthCheck(v)}
+	}
+
+	if v := apiObject.LogConfiguration; v != nil {
+		tfMap["log_configuration"] = []any{flattenContainerLogConfiguration(v)}
+	}
+
+	return tfMap
+}
+
+func flattenContainerDependencies(apiObjects []awstypes.ContainerDependency) []any {
+	var tfList []any
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]any{
+			names.AttrCondition: string(apiObject.Condition),
+			"container_name":    aws.ToString(apiObject.ContainerName),
+		})
+	}
+
+	return tfList
+}
+
+func flattenKeyValuePairs(apiObjects []awstypes.KeyValuePair) []any {
+	var tfList []any
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]any{
+			names.AttrName:  aws.ToString(apiObject.Name),
+			names.AttrValue: aws.ToString(apiObject.Value),
+		})
+	}
+
+	return tfList
+}
+
+func flattenContainerHealthCheck(apiObject *awstypes.HealthCheck) map[string]any {
+	tfMap := map[string]any{
+		"command":          apiObject.Command,
+		names.AttrInterval: containerDefinitionHealthCheckIntervalDefault,
+		"retries":          containerDefinitionHealthCheckRetriesDefault,
+		"start_period":     aws.ToInt32(apiObject.StartPeriod),
+		names.AttrTimeout:  containerDefinitionHealthCheckTimeoutDefault,
+	}
+
+	if v := apiObject.Interval; v != nil {
+		tfMap[names.AttrInterval] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.Retries; v != nil {
+		tfMap["retries"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.Timeout; v != nil {
+		tfMap[names.AttrTimeout] = aws.ToInt32(v)
+	}
+
+	return tfMap
+}
+
+func flattenContainerLogConfiguration(apiObject *awstypes.LogConfiguration) map[string]any {
+	return map[string]any{
+		"log_driver":    string(apiObject.LogDriver),
+		"options":       apiObject.Options,
+		"secret_option": flattenContainerSecrets(apiObject.SecretOptions),
+	}
+}
+
+func flattenContainerMountPoints(apiObjects []awstypes.MountPoint) []any {
+	var tfList []any
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]any{
+			"container_path": aws.ToString(apiObject.ContainerPath),
+			"read_only":      aws.ToBool(apiObject.ReadOnly),
+			"source_volume":  aws.ToString(apiObject.SourceVolume),
+		})
+	}
+
+	return tfList
+}
+
+func flattenContainerPortMappings(apiObjects []awstypes.PortMapping) []any {
+	var tfList []any
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]any{
+			"app_protocol":     string(apiObject.AppProtocol),
+			"container_port":   aws.ToInt32(apiObject.ContainerPort),
+			"host_port":        aws.ToInt32(apiObject.HostPort),
+			names.AttrName:     aws.ToString(apiObject.Name),
+			names.AttrProtocol: string(apiObject.Protocol),
+		}
+
+		// Protocol defaults to "tcp".
+		if apiObject.Protocol == "" {
+			tfMap[names.AttrProtocol] = string(awstypes.TransportProtocolTcp)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenContainerSecrets(apiObjects []awstypes.Secret) []any {
+	var tfList []any
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]any{
+			names.AttrName: aws.ToString(apiObject.Name),
+			"value_from":   aws.ToString(apiObject.ValueFrom),
+		})
+	}
+
+	return tfList
+}
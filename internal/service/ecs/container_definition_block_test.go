@@ -0,0 +1,187 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestExpandContainerDefinitionBlocks(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		config      []any
+		expected    string
+		expectedErr string
+	}
+	tests := map[string]testCase{
+		"basic": {
+			config: []any{
+				map[string]any{
+					names.AttrName: "web",
+					"image":        "nginx:latest",
+					"memory":       512,
+					"port_mapping": []any{
+						map[string]any{
+							"container_port": 80,
+						},
+					},
+					"environment": []any{
+						map[string]any{"name": "B", "value": "2"},
+						map[string]any{"name": "A", "value": "1"},
+					},
+					"health_check": []any{
+						map[string]any{
+							"command": []any{"CMD-SHELL", "curl -f http://localhost/ || exit 1"},
+						},
+					},
+				},
+			},
+			expected: `[{"environment":[{"name":"A","value":"1"},{"name":"B","value":"2"}],"essential":true,"healthCheck":{"command":["CMD-SHELL","curl -f http://localhost/ || exit 1"],"interval":30,"retries":3,"timeout":5},"image":"nginx:latest","memory":512,"name":"web","portMappings":[{"containerPort":80,"protocol":"tcp"}]}]`,
+		},
+		"dependencies": {
+			config: []any{
+				map[string]any{
+					names.AttrName: "app",
+					"image":        "app",
+					"dependency": []any{
+						map[string]any{"container_name": "init", "condition": "SUCCESS"},
+					},
+				},
+				map[string]any{
+					names.AttrName: "init",
+					"image":        "busybox",
+					"essential":    false,
+				},
+			},
+			expected: `[{"dependsOn":[{"condition":"SUCCESS","containerName":"init"}],"essential":true,"image":"app","name":"app"},{"essential":false,"image":"busybox","name":"init"}]`,
+		},
+		"duplicate name": {
+			config: []any{
+				map[string]any{names.AttrName: "app", "image": "app"},
+				map[string]any{names.AttrName: "app", "image": "app"},
+			},
+			expectedErr: "duplicate container_definition name (app)",
+		},
+		"unknown dependency": {
+			config: []any{
+				map[string]any{
+					names.AttrName: "app",
+					"image":        "app",
+					"dependency": []any{
+						map[string]any{"container_name": "sidecar", "condition": "START"},
+					},
+				},
+			},
+			expectedErr: "container_definition (app): dependency on unknown container (sidecar)",
+		},
+		"memory reservation exceeds memory": {
+			config: []any{
+				map[string]any{
+					names.AttrName:       "app",
+					"image":              "app",
+					"memory":             128,
+					"memory_reservation": 256,
+				},
+			},
+			expectedErr: "container_definition (app): memory_reservation (256) must be less than or equal to memory (128)",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			d := schema.TestResourceDataRaw(t, resourceTaskDefinition().Schema, map[string]any{
+				"container_definition": test.config,
+				names.AttrFamily:       "test",
+			})
+
+			apiObjects, err := expandContainerDefinitionBlocks(d.Get("container_definition").([]any))
+
+			if test.expectedErr != "" {
+				if err == nil {
+					t.Fatal("Expected error")
+				}
+				if err.Error() != test.expectedErr {
+					t.Fatalf("Expected message '%[1]s', got '%[2]s'", test.expectedErr, err.Error())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Environment is a set, so order it as Read does.
+			containerDefinitions(apiObjects).orderEnvironmentVariables()
+
+			got, err := flattenContainerDefinitions(apiObjects)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !tfjson.EqualStrings(got, test.expected) {
+				t.Errorf("got %s, expected %s", got, test.expected)
+			}
+		})
+	}
+}
+
+func TestFlattenContainerDefinitionBlocks_roundTrip(t *testing.T) {
+	t.Parallel()
+
+	config := []any{
+		map[string]any{
+			names.AttrName: "web",
+			"image":        "nginx:latest",
+			"port_mapping": []any{
+				map[string]any{"container_port": 8080, "host_port": 8080, "protocol": "udp"},
+			},
+			"log_configuration": []any{
+				map[string]any{
+					"log_driver": "awslogs",
+					"options":    map[string]any{"awslogs-group": "web"},
+				},
+			},
+			"secret": []any{
+				map[string]any{"name": "TOKEN", "value_from": "arn:aws:ssm:us-west-2:123456789012:parameter/token"},
+			},
+		},
+	}
+
+	d := schema.TestResourceDataRaw(t, resourceTaskDefinition().Schema, map[string]any{
+		"container_definition": config,
+		names.AttrFamily:       "test",
+	})
+
+	apiObjects, err := expandContainerDefinitionBlocks(d.Get("container_definition").([]any))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	before := d.Get("container_definition")
+	if err := d.Set("container_definition", flattenContainerDefinitionBlocks(apiObjects)); err != nil {
+		t.Fatal(err)
+	}
+	after := d.Get("container_definition")
+
+	got, err := expandContainerDefinitionBlocks(after.([]any))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := expandContainerDefinitionBlocks(before.([]any))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	gotJSON, _ := flattenContainerDefinitions(got)
+	wantJSON, _ := flattenContainerDefinitions(want)
+	if !tfjson.EqualStrings(gotJSON, wantJSON) {
+		t.Errorf("got %s, expected %s", gotJSON, wantJSON)
+	}
+}
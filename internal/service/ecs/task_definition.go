@@ -79,10 +79,13 @@ func resourceTaskDefinition() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"container_definition": containerDefinitionSchema(),
 			"container_definitions": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"container_definition", "container_definitions"},
 				StateFunc: func(v any) string {
 					// Sort the lists of environment variables as they are serialized to state, so we won't get
 					// spurious reorderings in plans (diff is suppressed if the environment variables haven't changed,
@@ -537,7 +540,13 @@ func resourceTaskDefinitionCreate(ctx context.Context, d *schema.ResourceData, m
 	conn := meta.(*conns.AWSClient).ECSClient(ctx)
 	partition := meta.(*conns.AWSClient).Partition(ctx)
 
-	definitions, err := expandContainerDefinitions(d.Get("container_definitions").(string))
+	var definitions []awstypes.ContainerDefinition
+	var err error
+	if v, ok := d.GetOk("container_definition"); ok && len(v.([]any)) > 0 {
+		definitions, err = expandContainerDefinitionBlocks(v.([]any))
+	} else {
+		definitions, err = expandContainerDefinitions(d.Get("container_definitions").(string))
+	}
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, err)
 	}
@@ -695,6 +704,11 @@ func resourceTaskDefinitionRead(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendErrorf(diags, "setting volume: %s", err)
 	}
 
+	// Flatten the structured form before sorting so that container order matches configuration.
+	if err := d.Set("container_definition", flattenContainerDefinitionBlocks(taskDefinition.ContainerDefinitions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting container_definition: %s", err)
+	}
+
 	// Sort the lists of environment variables as they come in, so we won't get spurious reorderings in plans
 	// (diff is suppressed if the environment variables haven't changed, but they still show in the plan if
 	// some other property changes).
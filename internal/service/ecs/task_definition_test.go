@@ -1610,6 +1610,101 @@ func TestAccECSTaskDefinition_containerDefinitionVersionConsistency_nullToEnable
 }
 
 // See https://github.com/hashicorp/terraform-provider-aws/issues/40801.
+func TestAccECSTaskDefinition_containerDefinitionBlock(t *testing.T) {
+	ctx := acctest.Context(t)
+	var def awstypes.TaskDefinition
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskDefinitionConfig_containerDefinitionBlock(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, t, resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.name", "app"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.image", "nginx:latest"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.memory", "128"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.essential", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.port_mapping.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.port_mapping.0.container_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.port_mapping.0.host_port", "8080"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.port_mapping.0.protocol", string(awstypes.TransportProtocolTcp)),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.environment.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "container_definition.0.environment.*", map[string]string{
+						names.AttrName:  "VARNAME",
+						names.AttrValue: "VARVAL",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.health_check.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.dependency.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.dependency.0.container_name", "init"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.dependency.0.condition", string(awstypes.ContainerConditionSuccess)),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.1.name", "init"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.1.essential", acctest.CtFalse),
+					acctest.CheckResourceAttrJMES(resourceName, "container_definitions", "length(@)", "2"),
+				),
+			},
+			{
+				Config: testAccTaskDefinitionConfig_containerDefinitionBlock(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+				},
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       acctest.AttrImportStateIdFunc(resourceName, names.AttrARN),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrSkipDestroy, "track_latest"},
+			},
+		},
+	})
+}
+
+func TestAccECSTaskDefinition_containerDefinitionBlockMigration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var def awstypes.TaskDefinition
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskDefinitionConfig_containerDefinitionJSON(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, t, resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "revision", "1"),
+				),
+			},
+			{
+				// Replacing the JSON document with equivalent blocks must not replace the task definition.
+				Config: testAccTaskDefinitionConfig_containerDefinitionBlockEquivalent(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, t, resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "revision", "1"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccECSTaskDefinition_DockerVolume_detectChangeInDriverOpts(t *testing.T) {
 	ctx := acctest.Context(t)
 	var def awstypes.TaskDefinition
@@ -3765,3 +3860,86 @@ TASK_DEFINITION
 }
 `, rName, enableFaultInjection)
 }
+
+func testAccTaskDefinitionConfig_containerDefinitionBlock(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definition {
+    name    = "app"
+    image   = "nginx:latest"
+    memory  = 128
+    command = ["nginx", "-g", "daemon off;"]
+
+    port_mapping {
+      container_port = 80
+      host_port      = 8080
+    }
+
+    environment {
+      name  = "VARNAME"
+      value = "VARVAL"
+    }
+
+    health_check {
+      command = ["CMD-SHELL", "curl -f http://localhost/ || exit 1"]
+    }
+
+    dependency {
+      container_name = "init"
+      condition      = "SUCCESS"
+    }
+  }
+
+  container_definition {
+    name      = "init"
+    image     = "busybox"
+    memory    = 64
+    essential = false
+    command   = ["sh", "-c", "echo ready"]
+  }
+}
+`, rName)
+}
+
+func testAccTaskDefinitionConfig_containerDefinitionJSON(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definitions = jsonencode([
+    {
+      name      = "app"
+      image     = "nginx:latest"
+      memory    = 128
+      essential = true
+      command   = ["nginx", "-g", "daemon off;"]
+      environment = [
+        { name = "VARNAME", value = "VARVAL" },
+      ]
+    },
+  ])
+}
+`, rName)
+}
+
+func testAccTaskDefinitionConfig_containerDefinitionBlockEquivalent(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definition {
+    name    = "app"
+    image   = "nginx:latest"
+    memory  = 128
+    command = ["nginx", "-g", "daemon off;"]
+
+    environment {
+      name  = "VARNAME"
+      value = "VARVAL"
+    }
+  }
+}
+`, rName)
+}
@@ -0,0 +1,127 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package efs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	backuptypes "github.com/aws/aws-sdk-go-v2/service/backup/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/guardrails"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func (p *servicePackage) BackupBeforeDestroy(context.Context) map[string]conns.BackupBeforeDestroyFunc {
+	return map[string]conns.BackupBeforeDestroyFunc{
+		"aws_efs_file_system": backupFileSystemBeforeDestroy,
+	}
+}
+
+// backupFileSystemBeforeDestroy backs up an EFS file system using an AWS Backup on-demand backup job.
+// The backup vault and IAM role are only validated when the provider is configured if EFS file systems are explicitly selected.
+func backupFileSystemBeforeDestroy(ctx context.Context, d conns.BackupResourceData, meta any, config *guardrails.BackupBeforeDestroyConfig) (string, error) {
+	if config.BackupVaultName == "" || config.IAMRoleARN == "" {
+		return "", errors.New("backup_before_destroy backup_vault_name and iam_role_arn must be configured to back up EFS file systems with AWS Backup")
+	}
+
+	c := meta.(*conns.AWSClient)
+
+	if _, err := findFileSystemByID(ctx, c.EFSClient(ctx), d.Id()); retry.NotFound(err) {
+		return "", nil
+	} else if err != nil {
+		return "", fmt.Errorf("reading EFS File System (%s): %w", d.Id(), err)
+	}
+
+	conn := c.BackupClient(ctx)
+	input := backup.StartBackupJobInput{
+		BackupVaultName:   aws.String(config.BackupVaultName),
+		IamRoleArn:        aws.String(config.IAMRoleARN),
+		RecoveryPointTags: config.Tags("aws_efs_file_system", d.Id()),
+		ResourceArn:       aws.String(d.Get(names.AttrARN).(string)),
+	}
+	output, err := conn.StartBackupJob(ctx, &input)
+
+	if err != nil {
+		return "", fmt.Errorf("starting Backup Job (%s): %w", d.Id(), err)
+	}
+
+	id := aws.ToString(output.BackupJobId)
+
+	job, err := waitBackupJobCompleted(ctx, conn, id, config.Timeout)
+
+	if err != nil {
+		return "", fmt.Errorf("waiting for Backup Job (%s) complete: %w", id, err)
+	}
+
+	return aws.ToString(job.RecoveryPointArn), nil
+}
+
+func findBackupJobByID(ctx context.Context, conn *backup.Client, id string) (*backup.DescribeBackupJobOutput, error) {
+	input := backup.DescribeBackupJobInput{
+		BackupJobId: aws.String(id),
+	}
+
+	output, err := conn.DescribeBackupJob(ctx, &input)
+
+	if errs.IsA[*backuptypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError: err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError()
+	}
+
+	return output, nil
+}
+
+func statusBackupJob(conn *backup.Client, id string) retry.StateRefreshFunc {
+	return func(ctx context.Context) (any, string, error) {
+		output, err := findBackupJobByID(ctx, conn, id)
+
+		if retry.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.State), nil
+	}
+}
+
+func waitBackupJobCompleted(ctx context.Context, conn *backup.Client, id string, timeout time.Duration) (*backup.DescribeBackupJobOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(backuptypes.BackupJobStateCreated, backuptypes.BackupJobStatePending, backuptypes.BackupJobStateRunning),
+		Target:     enum.Slice(backuptypes.BackupJobStateCompleted),
+		Refresh:    statusBackupJob(conn, id),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*backup.DescribeBackupJobOutput); ok {
+		retry.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,319 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/yaml"
+)
+
+const (
+	kubeconfigExecAPIVersion  = "client.authentication.k8s.io/v1beta1"
+	kubeconfigExecCommandAWS  = "aws"
+	kubeconfigExecInteractive = "Never"
+)
+
+type kubeconfigAuthMode string
+
+const (
+	kubeconfigAuthModeExec  kubeconfigAuthMode = "EXEC"
+	kubeconfigAuthModeToken kubeconfigAuthMode = "TOKEN"
+)
+
+func (kubeconfigAuthMode) Values() []kubeconfigAuthMode {
+	return []kubeconfigAuthMode{
+		kubeconfigAuthModeExec,
+		kubeconfigAuthModeToken,
+	}
+}
+
+// kubeconfigEntry is a cluster, and how to authenticate to it, to be added to a kubeconfig.
+type kubeconfigEntry struct {
+	clusterARN               string
+	certificateAuthorityData string
+	context                  string
+	endpoint                 string
+	namespace                string
+	token                    string
+	exec                     *kubeconfigExecConfig
+}
+
+// kubeconfig is the subset of the Kubernetes client configuration file format used to access EKS clusters.
+// See https://kubernetes.io/docs/concepts/configuration/organize-cluster-access-kubeconfig/.
+type kubeconfig struct {
+	APIVersion     string                   `yaml:"apiVersion"`
+	Kind           string                   `yaml:"kind"`
+	Clusters       []kubeconfigNamedCluster `yaml:"clusters"`
+	Contexts       []kubeconfigNamedContext `yaml:"contexts"`
+	CurrentContext string                   `yaml:"current-context"`
+	Users          []kubeconfigNamedUser    `yaml:"users"`
+}
+
+type kubeconfigNamedCluster struct {
+	Name    string            `yaml:"name"`
+	Cluster kubeconfigCluster `yaml:"cluster"`
+}
+
+type kubeconfigCluster struct {
+	CertificateAuthorityData string `yaml:"certificate-authority-data"`
+	Server                   string `yaml:"server"`
+}
+
+type kubeconfigNamedContext struct {
+	Name    string            `yaml:"name"`
+	Context kubeconfigContext `yaml:"context"`
+}
+
+type kubeconfigContext struct {
+	Cluster   string `yaml:"cluster"`
+	Namespace string `yaml:"namespace,omitempty"`
+	User      string `yaml:"user"`
+}
+
+type kubeconfigNamedUser struct {
+	Name string         `yaml:"name"`
+	User kubeconfigUser `yaml:"user"`
+}
+
+type kubeconfigUser struct {
+	Exec  *kubeconfigExecConfig `yaml:"exec,omitempty"`
+	Token string                `yaml:"token,omitempty"`
+}
+
+type kubeconfigExecConfig struct {
+	APIVersion      string                 `yaml:"apiVersion"`
+	Command         string                 `yaml:"command"`
+	Args            []string               `yaml:"args"`
+	Env             []kubeconfigExecEnvVar `yaml:"env,omitempty"`
+	InteractiveMode string                 `yaml:"interactiveMode"`
+}
+
+type kubeconfigExecEnvVar struct {
+	Name  string `yaml:"name"`
+	Value string `yaml:"value"`
+}
+
+// newKubeconfig returns a kubeconfig with a context, and user of the same name, for each entry.
+// Entries for the same cluster share a cluster definition. The current context defaults to that of the first entry.
+func newKubeconfig(entries []kubeconfigEntry, currentContext string) (*kubeconfig, error) {
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("at least one cluster is required")
+	}
+
+	config := &kubeconfig{
+		APIVersion: "v1",
+		Kind:       "Config",
+	}
+	contexts := make(map[string]struct{}, len(entries))
+
+	for _, entry := range entries {
+		if _, ok := contexts[entry.context]; ok {
+			return nil, fmt.Errorf("duplicate context name: %s", entry.context)
+		}
+		contexts[entry.context] = struct{}{}
+
+		if !slices.ContainsFunc(config.Clusters, func(v kubeconfigNamedCluster) bool { return v.Name == entry.clusterARN }) {
+			config.Clusters = append(config.Clusters, kubeconfigNamedCluster{
+				Name: entry.clusterARN,
+				Cluster: kubeconfigCluster{
+					CertificateAuthorityData: entry.certificateAuthorityData,
+					Server:                   entry.endpoint,
+				},
+			})
+		}
+
+		config.Contexts = append(config.Contexts, kubeconfigNamedContext{
+			Name: entry.context,
+			Context: kubeconfigContext{
+				Cluster:   entry.clusterARN,
+				Namespace: entry.namespace,
+				User:      entry.context,
+			},
+		})
+
+		config.Users = append(config.Users, kubeconfigNamedUser{
+			Name: entry.context,
+			User: kubeconfigUser{
+				Exec:  entry.exec,
+				Token: entry.token,
+			},
+		})
+	}
+
+	switch {
+	case currentContext == "":
+		config.CurrentContext = entries[0].context
+	case slices.ContainsFunc(config.Contexts, func(v kubeconfigNamedContext) bool { return v.Name == currentContext }):
+		config.CurrentContext = currentContext
+	default:
+		return nil, fmt.Errorf("current context (%s) not found", currentContext)
+	}
+
+	return config, nil
+}
+
+// newKubeconfigExecConfig returns an exec credential plugin configuration that runs `aws eks get-token`.
+func newKubeconfigExecConfig(command, region, clusterName, roleARN string, env map[string]string) *kubeconfigExecConfig {
+	if command == "" {
+		command = kubeconfigExecCommandAWS
+	}
+
+	args := []string{"--region", region, "eks", "get-token", "--cluster-name", clusterName, "--output", "json"}
+	if roleARN != "" {
+		args = append(args, "--role-arn", roleARN)
+	}
+
+	var envVars []kubeconfigExecEnvVar
+	for _, k := range slices.Sorted(maps.Keys(env)) {
+		envVars = append(envVars, kubeconfigExecEnvVar{Name: k, Value: env[k]})
+	}
+
+	return &kubeconfigExecConfig{
+		APIVersion:      kubeconfigExecAPIVersion,
+		Command:         command,
+		Args:            args,
+		Env:             envVars,
+		InteractiveMode: kubeconfigExecInteractive,
+	}
+}
+
+// renderKubeconfig describes the configured clusters and sets the computed attributes, including the kubeconfig YAML.
+// It is shared by the aws_eks_kubeconfig data source and ephemeral resource.
+func renderKubeconfig(ctx context.Context, c *conns.AWSClient, data *kubeconfigModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := c.EKSClient(ctx)
+	region := c.Region(ctx)
+
+	clusters, d := data.Clusters.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	authMode := data.AuthMode.ValueEnum()
+	if authMode == "" {
+		authMode = kubeconfigAuthModeExec
+		data.AuthMode = fwtypes.StringEnumValue(authMode)
+	}
+
+	entries := make([]kubeconfigEntry, 0, len(clusters))
+	for _, cluster := range clusters {
+		name := cluster.Name.ValueString()
+
+		output, err := findClusterByName(ctx, conn, name)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("reading EKS Cluster (%s)", name), err.Error())
+			return diags
+		}
+
+		if output.CertificateAuthority == nil || aws.ToString(output.Endpoint) == "" {
+			diags.AddError(fmt.Sprintf("reading EKS Cluster (%s)", name), fmt.Sprintf("cluster has no endpoint; status: %s", output.Status))
+			return diags
+		}
+
+		cluster.ARN = fwflex.StringToFramework(ctx, output.Arn)
+		cluster.CertificateAuthorityData = fwflex.StringToFramework(ctx, output.CertificateAuthority.Data)
+		cluster.Endpoint = fwflex.StringToFramework(ctx, output.Endpoint)
+		if cluster.Context.IsNull() || cluster.Context.IsUnknown() {
+			cluster.Context = cluster.ARN
+		}
+
+		entry := kubeconfigEntry{
+			clusterARN:               cluster.ARN.ValueString(),
+			certificateAuthorityData: cluster.CertificateAuthorityData.ValueString(),
+			context:                  cluster.Context.ValueString(),
+			endpoint:                 cluster.Endpoint.ValueString(),
+			namespace:                cluster.Namespace.ValueString(),
+		}
+
+		switch roleARN := cluster.RoleARN.ValueString(); authMode {
+		case kubeconfigAuthModeToken:
+			token, err := kubeconfigToken(ctx, c.STSClient(ctx), name, roleARN)
+			if err != nil {
+				diags.AddError(fmt.Sprintf("generating EKS Cluster (%s) authentication token", name), err.Error())
+				return diags
+			}
+
+			entry.token = token
+		default:
+			entry.exec = newKubeconfigExecConfig(data.ExecCommand.ValueString(), region, name, roleARN, fwflex.ExpandFrameworkStringValueMap(ctx, data.ExecEnv))
+		}
+
+		entries = append(entries, entry)
+	}
+
+	config, err := newKubeconfig(entries, data.CurrentContext.ValueString())
+	if err != nil {
+		diags.AddError("building kubeconfig", err.Error())
+		return diags
+	}
+
+	content, err := yaml.EncodeToString(config)
+	if err != nil {
+		diags.AddError("encoding kubeconfig", err.Error())
+		return diags
+	}
+
+	data.Clusters = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, clusters)
+	data.CurrentContext = types.StringValue(config.CurrentContext)
+	data.Kubeconfig = types.StringValue(content)
+
+	return diags
+}
+
+// kubeconfigToken returns an authentication token for the cluster, optionally assuming the specified IAM role first.
+func kubeconfigToken(ctx context.Context, conn *sts.Client, clusterName, roleARN string) (string, error) {
+	if roleARN != "" {
+		provider := stscreds.NewAssumeRoleProvider(conn, roleARN)
+		conn = sts.New(conn.Options(), func(o *sts.Options) {
+			o.Credentials = aws.NewCredentialsCache(provider)
+		})
+	}
+
+	generator, err := NewGenerator(false, false)
+	if err != nil {
+		return "", err
+	}
+
+	token, err := generator.GetWithSTS(ctx, clusterName, conn)
+	if err != nil {
+		return "", err
+	}
+
+	return token.Token, nil
+}
+
+type kubeconfigModel struct {
+	framework.WithRegionModel
+	AuthMode       fwtypes.StringEnum[kubeconfigAuthMode]                  `tfsdk:"auth_mode"`
+	Clusters       fwtypes.ListNestedObjectValueOf[kubeconfigClusterModel] `tfsdk:"cluster"`
+	CurrentContext types.String                                            `tfsdk:"current_context"`
+	ExecCommand    types.String                                            `tfsdk:"exec_command"`
+	ExecEnv        fwtypes.MapOfString                                     `tfsdk:"exec_env"`
+	Kubeconfig     types.String                                            `tfsdk:"kubeconfig"`
+}
+
+type kubeconfigClusterModel struct {
+	ARN                      types.String `tfsdk:"arn"`
+	CertificateAuthorityData types.String `tfsdk:"certificate_authority_data"`
+	Context                  types.String `tfsdk:"context"`
+	Endpoint                 types.String `tfsdk:"endpoint"`
+	Name                     types.String `tfsdk:"name"`
+	Namespace                types.String `tfsdk:"namespace"`
+	RoleARN                  fwtypes.ARN  `tfsdk:"role_arn"`
+}
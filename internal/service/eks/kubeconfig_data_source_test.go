@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEKSKubeconfigDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	dataSourceName := "data.aws_eks_kubeconfig.test"
+	resourceName := "aws_eks_cluster.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubeconfigDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "auth_mode", "EXEC"),
+					resource.TestCheckResourceAttr(dataSourceName, "cluster.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "cluster.0.arn", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "cluster.0.certificate_authority_data", resourceName, "certificate_authority.0.data"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "cluster.0.context", resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "cluster.0.endpoint", resourceName, names.AttrEndpoint),
+					resource.TestCheckResourceAttrPair(dataSourceName, "current_context", resourceName, names.AttrARN),
+					resource.TestMatchResourceAttr(dataSourceName, "kubeconfig", regexache.MustCompile(`(?s)apiVersion: v1.*command: aws.*`+rName)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEKSKubeconfigDataSource_token(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
+	dataSourceName := "data.aws_eks_kubeconfig.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubeconfigDataSourceConfig_token(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "auth_mode", "TOKEN"),
+					resource.TestCheckResourceAttr(dataSourceName, "cluster.0.context", "test"),
+					resource.TestCheckResourceAttr(dataSourceName, "cluster.0.namespace", "kube-system"),
+					resource.TestCheckResourceAttr(dataSourceName, "current_context", "test"),
+					resource.TestMatchResourceAttr(dataSourceName, "kubeconfig", regexache.MustCompile(`token: k8s-aws-v1\.`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubeconfigDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_basic(rName), `
+data "aws_eks_kubeconfig" "test" {
+  cluster {
+    name = aws_eks_cluster.test.name
+  }
+}
+`)
+}
+
+func testAccKubeconfigDataSourceConfig_token(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_basic(rName), `
+data "aws_eks_kubeconfig" "test" {
+  auth_mode = "TOKEN"
+
+  cluster {
+    name      = aws_eks_cluster.test.name
+    context   = "test"
+    namespace = "kube-system"
+  }
+}
+`)
+}
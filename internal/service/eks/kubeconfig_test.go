@@ -0,0 +1,159 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/yaml"
+)
+
+func TestNewKubeconfig(t *testing.T) {
+	t.Parallel()
+
+	const (
+		arn1 = "arn:aws:eks:us-west-2:123456789012:cluster/one" //lintignore:AWSAT003,AWSAT005
+		arn2 = "arn:aws:eks:us-west-2:123456789012:cluster/two" //lintignore:AWSAT003,AWSAT005
+	)
+
+	type testCase struct {
+		entries        []kubeconfigEntry
+		currentContext string
+		expected       string
+		expectError    bool
+	}
+	tests := map[string]testCase{
+		"no entries": {
+			expectError: true,
+		},
+		"exec": {
+			entries: []kubeconfigEntry{
+				{
+					clusterARN:               arn1,
+					certificateAuthorityData: "Y2VydA==",
+					context:                  arn1,
+					endpoint:                 "https://one.example.com",
+					exec:                     newKubeconfigExecConfig("", "us-west-2", "one", "", map[string]string{"AWS_PROFILE": "dev"}), //lintignore:AWSAT003
+				},
+			},
+			expected: `apiVersion: v1
+kind: Config
+clusters:
+  - name: arn:aws:eks:us-west-2:123456789012:cluster/one
+    cluster:
+      certificate-authority-data: Y2VydA==
+      server: https://one.example.com
+contexts:
+  - name: arn:aws:eks:us-west-2:123456789012:cluster/one
+    context:
+      cluster: arn:aws:eks:us-west-2:123456789012:cluster/one
+      user: arn:aws:eks:us-west-2:123456789012:cluster/one
+current-context: arn:aws:eks:us-west-2:123456789012:cluster/one
+users:
+  - name: arn:aws:eks:us-west-2:123456789012:cluster/one
+    user:
+      exec:
+        apiVersion: client.authentication.k8s.io/v1beta1
+        command: aws
+        args:
+          - --region
+          - us-west-2
+          - eks
+          - get-token
+          - --cluster-name
+          - one
+          - --output
+          - json
+        env:
+          - name: AWS_PROFILE
+            value: dev
+        interactiveMode: Never
+`,
+		},
+		"token with shared cluster": {
+			entries: []kubeconfigEntry{
+				{
+					clusterARN:               arn1,
+					certificateAuthorityData: "Y2VydA==",
+					context:                  "admin",
+					endpoint:                 "https://one.example.com",
+					token:                    "k8s-aws-v1.admin",
+				},
+				{
+					clusterARN:               arn1,
+					certificateAuthorityData: "Y2VydA==",
+					context:                  "reader",
+					endpoint:                 "https://one.example.com",
+					namespace:                "apps",
+					token:                    "k8s-aws-v1.reader",
+				},
+			},
+			currentContext: "reader",
+			expected: `apiVersion: v1
+kind: Config
+clusters:
+  - name: arn:aws:eks:us-west-2:123456789012:cluster/one
+    cluster:
+      certificate-authority-data: Y2VydA==
+      server: https://one.example.com
+contexts:
+  - name: admin
+    context:
+      cluster: arn:aws:eks:us-west-2:123456789012:cluster/one
+      user: admin
+  - name: reader
+    context:
+      cluster: arn:aws:eks:us-west-2:123456789012:cluster/one
+      namespace: apps
+      user: reader
+current-context: reader
+users:
+  - name: admin
+    user:
+      token: k8s-aws-v1.admin
+  - name: reader
+    user:
+      token: k8s-aws-v1.reader
+`,
+		},
+		"duplicate context": {
+			entries: []kubeconfigEntry{
+				{clusterARN: arn1, context: "dev", token: "a"},
+				{clusterARN: arn2, context: "dev", token: "b"},
+			},
+			expectError: true,
+		},
+		"unknown current context": {
+			entries: []kubeconfigEntry{
+				{clusterARN: arn1, context: "dev", token: "a"},
+			},
+			currentContext: "prod",
+			expectError:    true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			config, err := newKubeconfig(test.entries, test.currentContext)
+
+			if got, want := err != nil, test.expectError; got != want {
+				t.Fatalf("newKubeconfig() err %t, want %t: %v", got, want, err)
+			}
+			if err != nil {
+				return
+			}
+
+			got, err := yaml.EncodeToString(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != test.expected {
+				t.Errorf("got\n%s\nexpected\n%s", got, test.expected)
+			}
+		})
+	}
+}
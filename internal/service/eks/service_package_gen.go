@@ -28,6 +28,12 @@ func (p *servicePackage) EphemeralResources(ctx context.Context) []*inttypes.Ser
 			Name:     "ClusterAuth",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newKubeconfigEphemeralResource,
+			TypeName: "aws_eks_kubeconfig",
+			Name:     "Kubeconfig",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 	}
 }
 
@@ -39,6 +45,12 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.S
 			Name:     "Cluster Versions",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newKubeconfigDataSource,
+			TypeName: "aws_eks_kubeconfig",
+			Name:     "Kubeconfig",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 	}
 }
 
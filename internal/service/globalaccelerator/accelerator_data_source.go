@@ -159,6 +159,7 @@ func (d *acceleratorDataSource) Read(ctx context.Context, request datasource.Rea
 }
 
 type acceleratorDataSourceModel struct {
+	framework.WithAssumeRoleModel
 	ARN              fwtypes.ARN                                                 `tfsdk:"arn"`
 	Attributes       fwtypes.ListNestedObjectValueOf[acceleratorAttributesModel] `tfsdk:"attributes"`
 	DnsName          types.String                                                `tfsdk:"dns_name" autoflex:",legacy"`
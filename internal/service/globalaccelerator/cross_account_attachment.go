@@ -304,6 +304,7 @@ func findCrossAccountAttachmentByARN(ctx context.Context, conn *globalaccelerato
 }
 
 type crossAccountAttachmentResourceModel struct {
+	framework.WithAssumeRoleModel
 	AttachmentARN    types.String                                  `tfsdk:"arn"`
 	CreatedTime      timetypes.RFC3339                             `tfsdk:"created_time"`
 	ID               types.String                                  `tfsdk:"id"`
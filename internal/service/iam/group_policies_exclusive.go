@@ -217,6 +217,7 @@ func findGroupPoliciesByName(ctx context.Context, conn *iam.Client, groupName st
 }
 
 type groupPoliciesExclusiveResourceModel struct {
+	framework.WithAssumeRoleModel
 	GroupName   types.String        `tfsdk:"group_name"`
 	PolicyNames fwtypes.SetOfString `tfsdk:"policy_names"`
 }
@@ -211,6 +211,7 @@ func findGroupPolicyAttachmentsByName(ctx context.Context, conn *iam.Client, gro
 }
 
 type groupPolicyAttachmentsExclusiveResourceModel struct {
+	framework.WithAssumeRoleModel
 	GroupName  types.String        `tfsdk:"group_name"`
 	PolicyARNs fwtypes.SetOfString `tfsdk:"policy_arns"`
 }
@@ -174,6 +174,7 @@ func (r *organizationsFeaturesResource) Delete(ctx context.Context, request reso
 }
 
 type organizationsFeaturesResourceModel struct {
+	framework.WithAssumeRoleModel
 	EnabledFeatures fwtypes.SetValueOf[fwtypes.StringEnum[awstypes.FeatureType]] `tfsdk:"enabled_features"`
 	OrganizationID  types.String                                                 `tfsdk:"id"`
 }
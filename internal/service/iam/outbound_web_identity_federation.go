@@ -146,5 +146,6 @@ func findOutboundWebIdentityFederation(ctx context.Context, conn *iam.Client) (*
 }
 
 type outboundWebIdentityFederationResourceModel struct {
+	framework.WithAssumeRoleModel
 	IssuerIdentifier types.String `tfsdk:"issuer_identifier"`
 }
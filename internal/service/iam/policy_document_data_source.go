@@ -17,8 +17,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/iampolicy"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -331,6 +333,20 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 
 	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
 
+	if config := meta.(*conns.AWSClient).IAMPolicyValidationConfig(ctx); config != nil {
+		// The generated document may be used as either an identity-based or a resource-based policy.
+		if findings, err := iampolicy.Lint(jsonString, iampolicy.PolicyTypeUnspecified); err == nil {
+			for _, finding := range findings {
+				switch config.Severity {
+				case "warning":
+					diags = sdkdiag.AppendWarningf(diags, "IAM Policy Document: %s", finding)
+				default:
+					diags = sdkdiag.AppendErrorf(diags, "IAM Policy Document: %s", finding)
+				}
+			}
+		}
+	}
+
 	return diags
 }
 
@@ -217,6 +217,7 @@ func findRolePoliciesByName(ctx context.Context, conn *iam.Client, roleName stri
 }
 
 type rolePoliciesExclusiveResourceModel struct {
+	framework.WithAssumeRoleModel
 	RoleName    types.String        `tfsdk:"role_name"`
 	PolicyNames fwtypes.SetOfString `tfsdk:"policy_names"`
 }
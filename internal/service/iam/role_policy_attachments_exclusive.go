@@ -211,6 +211,7 @@ func findRolePolicyAttachmentsByName(ctx context.Context, conn *iam.Client, role
 }
 
 type rolePolicyAttachmentsExclusiveResourceModel struct {
+	framework.WithAssumeRoleModel
 	RoleName   types.String        `tfsdk:"role_name"`
 	PolicyARNs fwtypes.SetOfString `tfsdk:"policy_arns"`
 }
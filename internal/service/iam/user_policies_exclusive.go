@@ -217,6 +217,7 @@ func findUserPoliciesByName(ctx context.Context, conn *iam.Client, userName stri
 }
 
 type userPoliciesExclusiveResourceModel struct {
+	framework.WithAssumeRoleModel
 	UserName    types.String        `tfsdk:"user_name"`
 	PolicyNames fwtypes.SetOfString `tfsdk:"policy_names"`
 }
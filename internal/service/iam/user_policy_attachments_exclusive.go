@@ -211,6 +211,7 @@ func findUserPolicyAttachmentsByName(ctx context.Context, conn *iam.Client, user
 }
 
 type userPolicyAttachmentsExclusiveResourceModel struct {
+	framework.WithAssumeRoleModel
 	UserName   types.String        `tfsdk:"user_name"`
 	PolicyARNs fwtypes.SetOfString `tfsdk:"policy_arns"`
 }
@@ -239,7 +239,7 @@ func resourceFunction() *schema.Resource {
 			"filename": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, "source_dir", "source_file"},
 			},
 			"function_name": {
 				Type:         schema.TypeString,
@@ -302,7 +302,7 @@ func resourceFunction() *schema.Resource {
 			"image_uri": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, "source_dir", "source_file"},
 			},
 			"invoke_arn": {
 				Type:     schema.TypeString,
@@ -425,7 +425,7 @@ func resourceFunction() *schema.Resource {
 			names.AttrS3Bucket: {
 				Type:         schema.TypeString,
 				Optional:     true,
-				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket},
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, "source_dir", "source_file"},
 				RequiredWith: []string{"s3_key"},
 			},
 			"s3_key": {
@@ -436,7 +436,7 @@ func resourceFunction() *schema.Resource {
 			"s3_object_version": {
 				Type:          schema.TypeString,
 				Optional:      true,
-				ConflictsWith: []string{"filename", "image_uri"},
+				ConflictsWith: []string{"filename", "image_uri", "source_dir", "source_file"},
 			},
 			"signing_job_arn": {
 				Type:     schema.TypeString,
@@ -474,17 +474,39 @@ func resourceFunction() *schema.Resource {
 				Optional:         true,
 				Computed:         true,
 				DiffSuppressFunc: verify.SuppressMissingOptionalConfigurationBlock,
+				ConflictsWith:    []string{"source_dir", "source_file"},
 			},
 			"source_code_size": {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
+			"source_dir": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, "source_dir", "source_file"},
+			},
+			"source_excludes": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				RequiredWith: []string{"source_dir"},
+			},
+			"source_file": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"filename", "image_uri", names.AttrS3Bucket, "source_dir", "source_file"},
+			},
 			"source_kms_key_arn": {
 				Type:          schema.TypeString,
 				Optional:      true,
 				ValidateFunc:  verify.ValidARN,
 				ConflictsWith: []string{"image_uri"},
 			},
+			"source_s3_bucket": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"filename", "image_uri", names.AttrS3Bucket},
+			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 			"tenancy_config": {
@@ -578,6 +600,7 @@ func resourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			customizeDiffSourceCodeHash,
 			updateComputedAttributesOnPublish,
 			customdiff.ForceNewIfChange("durable_config", func(_ context.Context, old, new, meta any) bool {
 				// Force new when durable_config is being added (from empty to non-empty) or removed (from non-empty to empty)
@@ -625,6 +648,22 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta an
 		}
 
 		input.Code.ZipFile = zipFile
+	} else if hasSourcePackage(d) {
+		conns.GlobalMutexKV.Lock(mutexKey)
+		defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+		pkg, err := expandSourcePackage(ctx, d, meta, functionName)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating Lambda Function (%s): %s", functionName, err)
+		}
+
+		if pkg.zipFile != nil {
+			input.Code.ZipFile = pkg.zipFile
+		} else {
+			input.Code.S3Bucket = aws.String(pkg.s3Bucket)
+			input.Code.S3Key = aws.String(pkg.s3Key)
+		}
 	} else if v, ok := d.GetOk("image_uri"); ok {
 		input.Code.ImageUri = aws.String(v.(string))
 	} else {
@@ -1171,6 +1210,22 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta an
 			}
 
 			input.ZipFile = zipFile
+		} else if hasSourcePackage(d) {
+			conns.GlobalMutexKV.Lock(mutexKey)
+			defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+			pkg, err := expandSourcePackage(ctx, d, meta, d.Id())
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Lambda Function (%s) code: %s", d.Id(), err)
+			}
+
+			if pkg.zipFile != nil {
+				input.ZipFile = pkg.zipFile
+			} else {
+				input.S3Bucket = aws.String(pkg.s3Bucket)
+				input.S3Key = aws.String(pkg.s3Key)
+			}
 		} else if v, ok := d.GetOk("image_uri"); ok {
 			input.ImageUri = aws.String(v.(string))
 		} else {
@@ -2142,7 +2197,7 @@ func flattenSnapStart(apiObject *awstypes.SnapStartResponse) []any {
 // Therefore, reset them to the previous value when the update fails.
 // https://developer.hashicorp.com/terraform/plugin/framework/diagnostics#how-errors-affect-state
 func resetNonRefreshableAttributes(d *schema.ResourceData) {
-	for _, key := range []string{names.AttrS3Bucket, "s3_key", "s3_object_version", "source_code_hash", "filename"} {
+	for _, key := range []string{names.AttrS3Bucket, "s3_key", "s3_object_version", "source_code_hash", "filename", "source_dir", "source_excludes", "source_file", "source_s3_bucket"} {
 		if d.HasChange(key) {
 			old, _ := d.GetChange(key)
 			d.Set(key, old)
@@ -0,0 +1,158 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfio "github.com/hashicorp/terraform-provider-aws/internal/io"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+)
+
+const (
+	// sourcePackageMaxDirectUploadSize is the maximum size of a deployment package uploaded directly to Lambda.
+	// See https://docs.aws.amazon.com/lambda/latest/dg/gettingstarted-limits.html.
+	sourcePackageMaxDirectUploadSize = 50 * 1024 * 1024
+)
+
+// sourcePackage is a deployment package built from source_dir or source_file.
+// Exactly one of zipFile or s3Bucket/s3Key is set.
+type sourcePackage struct {
+	zipFile  []byte
+	s3Bucket string
+	s3Key    string
+}
+
+// hasSourcePackage returns whether the deployment package is built from source_dir or source_file.
+func hasSourcePackage(d sdkv2.ResourceDiffer) bool {
+	_, dirOk := d.GetOk("source_dir")
+	_, fileOk := d.GetOk("source_file")
+
+	return dirOk || fileOk
+}
+
+// buildSourcePackage returns the ZIP archive built from source_dir or source_file.
+func buildSourcePackage(d sdkv2.ResourceDiffer) ([]byte, error) {
+	if v, ok := d.GetOk("source_dir"); ok {
+		zipFile, err := tfio.ZipDirectory(v.(string), flex.ExpandStringValueSet(d.Get("source_excludes").(*schema.Set)))
+
+		if err != nil {
+			return nil, fmt.Errorf("building deployment package from source_dir (%s): %w", v, err)
+		}
+
+		return zipFile, nil
+	}
+
+	v := d.Get("source_file").(string)
+	zipFile, err := tfio.ZipFile(v)
+
+	if err != nil {
+		return nil, fmt.Errorf("building deployment package from source_file (%s): %w", v, err)
+	}
+
+	return zipFile, nil
+}
+
+// sourcePackageHash returns the Base64-encoded SHA-256 hash of the names, modes and contents of the files in the deployment package
+// built from source_dir or source_file. Unlike the package's CodeSha256, it does not depend on how the files are compressed.
+func sourcePackageHash(d sdkv2.ResourceDiffer) (string, error) {
+	if v, ok := d.GetOk("source_dir"); ok {
+		sum, err := tfio.HashDirectory(v.(string), flex.ExpandStringValueSet(d.Get("source_excludes").(*schema.Set)))
+
+		if err != nil {
+			return "", fmt.Errorf("hashing deployment package from source_dir (%s): %w", v, err)
+		}
+
+		return base64.StdEncoding.EncodeToString(sum), nil
+	}
+
+	v := d.Get("source_file").(string)
+	sum, err := tfio.HashFile(v)
+
+	if err != nil {
+		return "", fmt.Errorf("hashing deployment package from source_file (%s): %w", v, err)
+	}
+
+	return base64.StdEncoding.EncodeToString(sum), nil
+}
+
+// customizeDiffSourceCodeHash sets source_code_hash to the hash of the files in the deployment package built from source_dir or source_file
+// so that changes to the source files, and only those, are planned as code updates.
+func customizeDiffSourceCodeHash(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	for _, key := range []string{"source_dir", "source_excludes", "source_file"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("source_code_hash")
+		}
+	}
+
+	if !hasSourcePackage(d) {
+		return nil
+	}
+
+	hash, err := sourcePackageHash(d)
+
+	if err != nil {
+		return err
+	}
+
+	return d.SetNew("source_code_hash", hash)
+}
+
+// expandSourcePackage builds the deployment package from source_dir or source_file.
+// Packages larger than the direct upload limit are staged in source_s3_bucket under a content-addressed key.
+func expandSourcePackage(ctx context.Context, d *schema.ResourceData, meta any, name string) (*sourcePackage, error) {
+	// Guard against the source files changing between plan and apply.
+	if v, ok := d.GetOk("source_code_hash"); ok {
+		got, err := sourcePackageHash(d)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if want := v.(string); got != want {
+			return nil, fmt.Errorf("deployment package hash (%s) does not match planned source_code_hash (%s); source files changed after planning", got, want)
+		}
+	}
+
+	zipFile, err := buildSourcePackage(d)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(zipFile) <= sourcePackageMaxDirectUploadSize {
+		return &sourcePackage{zipFile: zipFile}, nil
+	}
+
+	bucket, ok := d.GetOk("source_s3_bucket")
+	if !ok {
+		return nil, fmt.Errorf("deployment package size (%d bytes) exceeds the direct upload limit (%d bytes); set source_s3_bucket to upload via Amazon S3", len(zipFile), sourcePackageMaxDirectUploadSize)
+	}
+
+	sum := sha256.Sum256(zipFile)
+	key := name + "/" + hex.EncodeToString(sum[:]) + ".zip"
+	input := s3.PutObjectInput{
+		Body:   bytes.NewReader(zipFile),
+		Bucket: aws.String(bucket.(string)),
+		Key:    aws.String(key),
+	}
+
+	_, err = meta.(*conns.AWSClient).S3Client(ctx).PutObject(ctx, &input)
+
+	if err != nil {
+		return nil, fmt.Errorf("uploading deployment package to S3 Bucket (%s) Key (%s): %w", bucket, key, err)
+	}
+
+	return &sourcePackage{s3Bucket: bucket.(string), s3Key: key}, nil
+}
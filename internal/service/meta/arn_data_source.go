@@ -79,6 +79,7 @@ func (d *arnDataSource) Read(ctx context.Context, request datasource.ReadRequest
 }
 
 type arnDataSourceModel struct {
+	framework.WithAssumeRoleModel
 	Account   types.String `tfsdk:"account"`
 	ARN       fwtypes.ARN  `tfsdk:"arn"`
 	ID        types.String `tfsdk:"id"`
@@ -59,6 +59,7 @@ func (d *defaultTagsDataSource) Read(ctx context.Context, request datasource.Rea
 }
 
 type defaultTagsDataSourceModel struct {
+	framework.WithAssumeRoleModel
 	ID   types.String `tfsdk:"id"`
 	Tags tftags.Map   `tfsdk:"tags"`
 }
@@ -150,6 +150,7 @@ func (d *ipRangesDataSource) Read(ctx context.Context, request datasource.ReadRe
 }
 
 type ipRangesDataSourceModel struct {
+	framework.WithAssumeRoleModel
 	CreateDate     types.String         `tfsdk:"create_date"`
 	ID             types.String         `tfsdk:"id"`
 	IPv4CIDRBlocks fwtypes.ListOfString `tfsdk:"cidr_blocks"`
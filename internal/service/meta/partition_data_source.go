@@ -64,6 +64,7 @@ func (d *partitionDataSource) Read(ctx context.Context, request datasource.ReadR
 }
 
 type partitionDataSourceModel struct {
+	framework.WithAssumeRoleModel
 	DNSSuffix        types.String `tfsdk:"dns_suffix"`
 	ID               types.String `tfsdk:"id"`
 	Partition        types.String `tfsdk:"partition"`
@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package meta
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// readProviderIdentity reads the identity and credential source used by the provider, and the endpoints of the requested services.
+// Credentials themselves are never read into the model.
+func readProviderIdentity(ctx context.Context, c *conns.AWSClient, data *providerIdentityModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	credentialsProvider := c.CredentialsProvider(ctx)
+	if credentialsProvider == nil {
+		diags.AddError("reading provider identity", "no credentials provider is configured")
+		return diags
+	}
+
+	credentials, err := credentialsProvider.Retrieve(ctx)
+	if err != nil {
+		diags.AddError("retrieving AWS credentials", err.Error())
+		return diags
+	}
+
+	var assumedRoles []assumedRoleModel
+	for _, v := range c.AssumedRoles(ctx) {
+		assumedRoles = append(assumedRoles, assumedRoleModel{
+			RoleARN:     fwflex.StringValueToFramework(ctx, v.RoleARN),
+			SessionName: fwflex.StringValueToFramework(ctx, v.SessionName),
+		})
+	}
+
+	serviceEndpoints := make(map[string]string)
+	services := fwflex.ExpandFrameworkStringValueSet(ctx, data.Services)
+	slices.Sort(services)
+	for _, service := range services {
+		endpoint, err := c.ResolveEndpoint(ctx, service)
+		if err != nil {
+			diags.AddError(fmt.Sprintf("resolving %s endpoint", service), err.Error())
+			return diags
+		}
+
+		serviceEndpoints[service] = endpoint
+	}
+
+	data.AccountID = fwflex.StringValueToFramework(ctx, c.AccountID(ctx))
+	data.AssumedRoles = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, assumedRoles)
+	data.BaseCredentialSource = fwflex.StringValueToFramework(ctx, c.BaseCredentialSource(ctx))
+	data.CredentialSource = fwflex.StringValueToFramework(ctx, credentials.Source)
+	if credentials.CanExpire {
+		data.Expiration = timetypes.NewRFC3339TimeValue(credentials.Expires)
+	} else {
+		data.Expiration = timetypes.NewRFC3339Null()
+	}
+	data.Partition = fwflex.StringValueToFramework(ctx, c.Partition(ctx))
+	data.ServiceEndpoints = fwflex.FlattenFrameworkStringValueMap(ctx, serviceEndpoints)
+
+	return diags
+}
+
+type providerIdentityModel struct {
+	framework.WithRegionModel
+	AccountID            types.String                                      `tfsdk:"account_id"`
+	AssumedRoles         fwtypes.ListNestedObjectValueOf[assumedRoleModel] `tfsdk:"assumed_roles"`
+	BaseCredentialSource types.String                                      `tfsdk:"base_credential_source"`
+	CredentialSource     types.String                                      `tfsdk:"credential_source"`
+	Expiration           timetypes.RFC3339                                 `tfsdk:"expiration"`
+	Partition            types.String                                      `tfsdk:"partition"`
+	ServiceEndpoints     types.Map                                         `tfsdk:"service_endpoints"`
+	Services             fwtypes.SetOfString                               `tfsdk:"services"`
+}
+
+type assumedRoleModel struct {
+	RoleARN     types.String `tfsdk:"role_arn"`
+	SessionName types.String `tfsdk:"session_name"`
+}
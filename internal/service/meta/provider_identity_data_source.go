@@ -0,0 +1,75 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package meta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_provider_identity", name="Provider Identity")
+func newProviderIdentityDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &providerIdentityDataSource{}
+
+	return d, nil
+}
+
+type providerIdentityDataSource struct {
+	framework.DataSourceWithModel[providerIdentityModel]
+}
+
+func (d *providerIdentityDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrAccountID: schema.StringAttribute{
+				Computed: true,
+			},
+			"assumed_roles": framework.DataSourceComputedListOfObjectAttribute[assumedRoleModel](ctx),
+			"base_credential_source": schema.StringAttribute{
+				Computed: true,
+			},
+			"credential_source": schema.StringAttribute{
+				Computed: true,
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"partition": schema.StringAttribute{
+				Computed: true,
+			},
+			"service_endpoints": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"services": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (d *providerIdentityDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data providerIdentityModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(readProviderIdentity(ctx, d.Meta(), &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package meta_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfmeta "github.com/hashicorp/terraform-provider-aws/internal/service/meta"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMetaProviderIdentityDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_provider_identity.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderIdentityDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrAccountID(ctx, dataSourceName, names.AttrAccountID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "base_credential_source"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "credential_source"),
+					resource.TestCheckResourceAttr(dataSourceName, "partition", acctest.Partition()),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrRegion, acctest.Region()),
+					resource.TestCheckResourceAttr(dataSourceName, "service_endpoints.%", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaProviderIdentityDataSource_services(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_provider_identity.test"
+
+	acctest.ParallelTest(ctx, t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderIdentityDataSourceConfig_services,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "service_endpoints.%", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "service_endpoints.sqs", "https://sqs."+acctest.Region()+"."+acctest.PartitionDNSSuffix()),
+					resource.TestCheckResourceAttr(dataSourceName, "service_endpoints.sts", "https://sts."+acctest.Region()+"."+acctest.PartitionDNSSuffix()),
+				),
+			},
+		},
+	})
+}
+
+const testAccProviderIdentityDataSourceConfig_basic = `
+data "aws_provider_identity" "test" {}
+`
+
+const testAccProviderIdentityDataSourceConfig_services = `
+data "aws_provider_identity" "test" {
+  services = ["sqs", "sts"]
+}
+`
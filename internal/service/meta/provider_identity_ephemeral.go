@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package meta
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_provider_identity", name="Provider Identity")
+func newProviderIdentityEphemeralResource(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &providerIdentityEphemeralResource{}, nil
+}
+
+type providerIdentityEphemeralResource struct {
+	framework.EphemeralResourceWithModel[providerIdentityModel]
+}
+
+func (e *providerIdentityEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrAccountID: schema.StringAttribute{
+				Computed: true,
+			},
+			"assumed_roles": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[assumedRoleModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[assumedRoleModel](ctx),
+				},
+			},
+			"base_credential_source": schema.StringAttribute{
+				Computed: true,
+			},
+			"credential_source": schema.StringAttribute{
+				Computed: true,
+			},
+			"expiration": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"partition": schema.StringAttribute{
+				Computed: true,
+			},
+			"service_endpoints": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"services": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (e *providerIdentityEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data providerIdentityModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(readProviderIdentity(ctx, e.Meta(), &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
@@ -88,6 +88,7 @@ func (d *regionsDataSource) Read(ctx context.Context, request datasource.ReadReq
 }
 
 type regionsDataSourceModel struct {
+	framework.WithAssumeRoleModel
 	AllRegions types.Bool          `tfsdk:"all_regions"`
 	Filters    tfec2.CustomFilters `tfsdk:"filter"`
 	ID         types.String        `tfsdk:"id"`
@@ -484,6 +484,7 @@ func waitDirectConnectGatewayAttachmentRejected(ctx context.Context, conn *netwo
 }
 
 type directConnectGatewayAttachmentResourceModel struct {
+	framework.WithAssumeRoleModel
 	ARN                        types.String         `tfsdk:"arn"`
 	AttachmentPolicyRuleNumber types.Int64          `tfsdk:"attachment_policy_rule_number"`
 	AttachmentType             types.String         `tfsdk:"attachment_type"`
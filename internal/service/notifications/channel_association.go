@@ -187,6 +187,7 @@ func findChannelAssociationByTwoPartKey(ctx context.Context, conn *notifications
 }
 
 type channelAssociationResourceModel struct {
+	framework.WithAssumeRoleModel
 	ARN                          fwtypes.ARN `tfsdk:"arn"`
 	NotificationConfigurationARN fwtypes.ARN `tfsdk:"notification_configuration_arn"`
 }
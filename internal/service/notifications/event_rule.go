@@ -364,6 +364,7 @@ func waitEventRuleDeleted(ctx context.Context, conn *notifications.Client, id st
 }
 
 type eventRuleResourceModel struct {
+	framework.WithAssumeRoleModel
 	ARN                          types.String        `tfsdk:"arn"`
 	EventPattern                 types.String        `tfsdk:"event_pattern"`
 	EventType                    types.String        `tfsdk:"event_type"`
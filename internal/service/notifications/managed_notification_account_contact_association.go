@@ -205,6 +205,7 @@ func findManagedNotificationChannelAssociations(ctx context.Context, conn *notif
 }
 
 type managedNotificationAccountContactAssociationResourceModel struct {
+	framework.WithAssumeRoleModel
 	ContactIdentifier                   fwtypes.StringEnum[awstypes.AccountContactType] `tfsdk:"contact_identifier"`
 	ManagedNotificationConfigurationARN fwtypes.ARN                                     `tfsdk:"managed_notification_configuration_arn"`
 }
@@ -166,6 +166,7 @@ func findManagedNotificationAdditionalChannelAssociationByTwoPartKey(ctx context
 }
 
 type managedNotificationAdditionalChannelAssociationResourceModel struct {
+	framework.WithAssumeRoleModel
 	ChannelARN                          fwtypes.ARN `tfsdk:"channel_arn"`
 	ManagedNotificationConfigurationARN fwtypes.ARN `tfsdk:"managed_notification_arn"`
 }
@@ -282,6 +282,7 @@ func waitNotificationConfigurationDeleted(ctx context.Context, conn *notificatio
 }
 
 type notificationConfigurationResourceModel struct {
+	framework.WithAssumeRoleModel
 	AggregationDuration fwtypes.StringEnum[awstypes.AggregationDuration] `tfsdk:"aggregation_duration"`
 	ARN                 types.String                                     `tfsdk:"arn"`
 	Description         types.String                                     `tfsdk:"description"`
@@ -274,6 +274,7 @@ func waitNotificationHubDeleted(ctx context.Context, conn *notifications.Client,
 }
 
 type notificationHubResourceModel struct {
+	framework.WithAssumeRoleModel
 	NotificationHubRegion types.String   `tfsdk:"notification_hub_region"`
 	Timeouts              timeouts.Value `tfsdk:"timeouts"`
 }
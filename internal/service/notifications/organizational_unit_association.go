@@ -196,6 +196,7 @@ func findOrganizationalUnits(ctx context.Context, conn *notifications.Client, in
 }
 
 type organizationalUnitAssociationResourceModel struct {
+	framework.WithAssumeRoleModel
 	NotificationConfigurationARN fwtypes.ARN  `tfsdk:"notification_configuration_arn"`
 	OrganizationalUnitID         types.String `tfsdk:"organizational_unit_id"`
 }
@@ -254,6 +254,7 @@ func findAccessForOrganization(ctx context.Context, conn *notifications.Client)
 }
 
 type organizationsAccessResourceModel struct {
+	framework.WithAssumeRoleModel
 	Enabled  types.Bool     `tfsdk:"enabled"`
 	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
@@ -203,6 +203,7 @@ func findEmailContactByARN(ctx context.Context, conn *notificationscontacts.Clie
 }
 
 type emailContactResourceModel struct {
+	framework.WithAssumeRoleModel
 	ARN          types.String `tfsdk:"arn"`
 	EmailAddress types.String `tfsdk:"email_address"`
 	Name         types.String `tfsdk:"name"`
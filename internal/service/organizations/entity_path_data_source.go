@@ -98,6 +98,7 @@ func (d *entityPathDataSource) Read(ctx context.Context, request datasource.Read
 }
 
 type entityPathDataSourceModel struct {
+	framework.WithAssumeRoleModel
 	EntityID   types.String `tfsdk:"entity_id"`
 	EntityPath types.String `tfsdk:"entity_path"`
 }
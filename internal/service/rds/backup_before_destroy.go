@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/guardrails"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func (p *servicePackage) BackupBeforeDestroy(context.Context) map[string]conns.BackupBeforeDestroyFunc {
+	return map[string]conns.BackupBeforeDestroyFunc{
+		"aws_db_instance": backupDBInstanceBeforeDestroy,
+		"aws_rds_cluster": backupClusterBeforeDestroy,
+	}
+}
+
+func backupDBInstanceBeforeDestroy(ctx context.Context, d conns.BackupResourceData, meta any, config *guardrails.BackupBeforeDestroyConfig) (string, error) {
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	identifier := d.Get(names.AttrIdentifier).(string)
+	id := config.Name(identifier)
+	input := rds.CreateDBSnapshotInput{
+		DBInstanceIdentifier: aws.String(identifier),
+		DBSnapshotIdentifier: aws.String(id),
+		Tags:                 svcTags(tftags.New(ctx, config.Tags("aws_db_instance", d.Id()))),
+	}
+	_, err := conn.CreateDBSnapshot(ctx, &input)
+
+	if errs.IsA[*types.DBInstanceNotFoundFault](err) {
+		return "", nil
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("creating RDS DB Snapshot (%s): %w", id, err)
+	}
+
+	if _, err := waitDBSnapshotCreated(ctx, conn, id, config.Timeout); err != nil {
+		return "", fmt.Errorf("waiting for RDS DB Snapshot (%s) create: %w", id, err)
+	}
+
+	return id, nil
+}
+
+func backupClusterBeforeDestroy(ctx context.Context, d conns.BackupResourceData, meta any, config *guardrails.BackupBeforeDestroyConfig) (string, error) {
+	conn := meta.(*conns.AWSClient).RDSClient(ctx)
+
+	id := config.Name(d.Id())
+	input := rds.CreateDBClusterSnapshotInput{
+		DBClusterIdentifier:         aws.String(d.Id()),
+		DBClusterSnapshotIdentifier: aws.String(id),
+		Tags:                        svcTags(tftags.New(ctx, config.Tags("aws_rds_cluster", d.Id()))),
+	}
+	_, err := conn.CreateDBClusterSnapshot(ctx, &input)
+
+	if errs.IsA[*types.DBClusterNotFoundFault](err) {
+		return "", nil
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("creating RDS DB Cluster Snapshot (%s): %w", id, err)
+	}
+
+	if _, err := waitDBClusterSnapshotCreated(ctx, conn, id, config.Timeout); err != nil {
+		return "", fmt.Errorf("waiting for RDS DB Cluster Snapshot (%s) create: %w", id, err)
+	}
+
+	return id, nil
+}
@@ -164,6 +164,7 @@ func (r *cidrCollectionResource) Delete(ctx context.Context, request resource.De
 }
 
 type cidrCollectionResourceModel struct {
+	framework.WithAssumeRoleModel
 	ARN     types.String `tfsdk:"arn"`
 	ID      types.String `tfsdk:"id"`
 	Name    types.String `tfsdk:"name"`
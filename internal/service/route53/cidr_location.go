@@ -283,6 +283,7 @@ func (r *cidrLocationResource) Delete(ctx context.Context, request resource.Dele
 }
 
 type cidrLocationResourceModel struct {
+	framework.WithAssumeRoleModel
 	CIDRBlocks       fwtypes.SetValueOf[fwtypes.CIDRBlock] `tfsdk:"cidr_blocks"`
 	CIDRCollectionID types.String                          `tfsdk:"cidr_collection_id"`
 	ID               types.String                          `tfsdk:"id"`
@@ -89,6 +89,7 @@ func (d *recordsDataSource) Read(ctx context.Context, request datasource.ReadReq
 }
 
 type recordsDataSourceModel struct {
+	framework.WithAssumeRoleModel
 	NameRegex          fwtypes.Regexp                                                  `tfsdk:"name_regex"`
 	ResourceRecordSets fwtypes.ListNestedObjectValueOf[resourceRecordSetModelReadonly] `tfsdk:"resource_record_sets"`
 	ZoneID             types.String                                                    `tfsdk:"zone_id"`
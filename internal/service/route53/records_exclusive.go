@@ -441,6 +441,7 @@ func findResourceRecordSetsForHostedZone(ctx context.Context, conn *route53.Clie
 }
 
 type recordsExclusiveResourceModel struct {
+	framework.WithAssumeRoleModel
 	ResourceRecordSet fwtypes.SetNestedObjectValueOf[resourceRecordSetModel] `tfsdk:"resource_record_set"`
 	Timeouts          timeouts.Value                                         `tfsdk:"timeouts"`
 	ZoneID            types.String                                           `tfsdk:"zone_id"`
@@ -31,6 +31,18 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.S
 			Name:     "Records",
 			Region:   unique.Make(inttypes.ResourceRegionDisabled()),
 		},
+		{
+			Factory:  newZoneFileDataSource,
+			TypeName: "aws_route53_zone_file",
+			Name:     "Zone File",
+			Region:   unique.Make(inttypes.ResourceRegionDisabled()),
+		},
+		{
+			Factory:  newZoneFileExportDataSource,
+			TypeName: "aws_route53_zone_file_export",
+			Name:     "Zone File Export",
+			Region:   unique.Make(inttypes.ResourceRegionDisabled()),
+		},
 		{
 			Factory:  newZonesDataSource,
 			TypeName: "aws_route53_zones",
@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/dns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// @FrameworkDataSource("aws_route53_zone_file", name="Zone File")
+func newZoneFileDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &zoneFileDataSource{}, nil
+}
+
+type zoneFileDataSource struct {
+	framework.DataSourceWithModel[zoneFileDataSourceModel]
+}
+
+func (d *zoneFileDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"content": schema.StringAttribute{
+				Required: true,
+			},
+			"default_ttl": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 2147483647),
+				},
+			},
+			"origin": schema.StringAttribute{
+				Required: true,
+			},
+			"resource_record_sets": framework.DataSourceComputedListOfObjectAttribute[zoneFileRecordSetModel](ctx),
+		},
+	}
+}
+
+func (d *zoneFileDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data zoneFileDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := dns.ParseZoneFile(data.Content.ValueString(), data.Origin.ValueString(), data.DefaultTTL.ValueInt64())
+
+	if err != nil {
+		response.Diagnostics.AddError("parsing zone file", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(
+		ctx,
+		struct {
+			ResourceRecordSets []dns.RecordSet
+		}{
+			ResourceRecordSets: output,
+		},
+		&data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type zoneFileDataSourceModel struct {
+	framework.WithAssumeRoleModel
+	Content            types.String                                            `tfsdk:"content"`
+	DefaultTTL         types.Int64                                             `tfsdk:"default_ttl"`
+	Origin             types.String                                            `tfsdk:"origin"`
+	ResourceRecordSets fwtypes.ListNestedObjectValueOf[zoneFileRecordSetModel] `tfsdk:"resource_record_sets"`
+}
+
+type zoneFileRecordSetModel struct {
+	Name   types.String         `tfsdk:"name"`
+	TTL    types.Int64          `tfsdk:"ttl"`
+	Type   types.String         `tfsdk:"type"`
+	Values fwtypes.ListOfString `tfsdk:"records"`
+}
@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRoute53ZoneFileDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_route53_zone_file.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneFileDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.#", "5"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.name", "www.example.com."),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.type", "A"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.ttl", "60"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.records.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.records.0", "192.0.2.1"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.0.records.1", "192.0.2.2"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.1.name", "*.example.com."),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.1.type", "CNAME"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.1.ttl", "60"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.1.records.0", "www.example.com."),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.2.name", "example.com."),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.2.type", "MX"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.2.records.0", "10 mail.example.com."),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.3.type", "TXT"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.3.records.0", `"v=spf1 include:example.net" "-all"`),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.4.name", "_sip._tcp.sub.example.com."),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.4.type", "SRV"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.4.ttl", "300"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_sets.4.records.0", "10 60 5060 sip.sub.example.com."),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53ZoneFileDataSource_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccZoneFileDataSourceConfig_invalid,
+				ExpectError: regexache.MustCompile(`line 1: no TTL`),
+			},
+		},
+	})
+}
+
+const testAccZoneFileDataSourceConfig_basic = `
+data "aws_route53_zone_file" "test" {
+  origin      = "example.com."
+  default_ttl = 3600
+
+  content = <<-EOT
+    www 60 IN A 192.0.2.1
+    www 60 IN A 192.0.2.2
+    *      IN CNAME www
+    @      IN MX 10 mail
+           IN TXT "v=spf1 include:example.net" "-all"
+    $ORIGIN sub
+    $TTL 5m
+    _sip._tcp SRV 10 60 5060 sip
+    EOT
+}
+`
+
+const testAccZoneFileDataSourceConfig_invalid = `
+data "aws_route53_zone_file" "test" {
+  origin  = "example.com."
+  content = "www IN A 192.0.2.1"
+}
+`
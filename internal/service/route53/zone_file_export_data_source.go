@@ -0,0 +1,167 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/dns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_route53_zone_file_export", name="Zone File Export")
+func newZoneFileExportDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &zoneFileExportDataSource{}, nil
+}
+
+type zoneFileExportDataSource struct {
+	framework.DataSourceWithModel[zoneFileExportDataSourceModel]
+}
+
+func (d *zoneFileExportDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"content": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Computed: true,
+			},
+			"zone_id": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (d *zoneFileExportDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data zoneFileExportDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().Route53Client(ctx)
+
+	hostedZoneID := cleanZoneID(fwflex.StringValueFromFramework(ctx, data.ZoneID))
+	hostedZone, err := findHostedZoneByID(ctx, conn, hostedZoneID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 Hosted Zone (%s)", hostedZoneID), err.Error())
+
+		return
+	}
+
+	input := route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(hostedZoneID),
+	}
+	output, err := findResourceRecordSets(ctx, conn, &input, tfslices.PredicateTrue[*route53.ListResourceRecordSetsOutput](), tfslices.PredicateTrue[*awstypes.ResourceRecordSet]())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("listing Route 53 Records (%s)", hostedZoneID), err.Error())
+
+		return
+	}
+
+	name := aws.ToString(hostedZone.HostedZone.Name)
+	content, err := dns.FormatZoneFile(name, tfslices.ApplyToAll(output, zoneFileRecordSet))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("rendering Route 53 Hosted Zone (%s) zone file", hostedZoneID), err.Error())
+
+		return
+	}
+
+	data.Content = types.StringValue(content)
+	data.Name = types.StringValue(normalizeDomainName(name))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// zoneFileRecordSet returns the record set as it is written to a zone file.
+// Route 53 specific settings, which have no zone file representation, are written as a comment.
+func zoneFileRecordSet(apiObject awstypes.ResourceRecordSet) dns.RecordSet {
+	var comments []string
+
+	if v := apiObject.AliasTarget; v != nil {
+		comments = append(comments, fmt.Sprintf("alias to %s (%s)", aws.ToString(v.DNSName), aws.ToString(v.HostedZoneId)))
+	}
+	if v := aws.ToString(apiObject.TrafficPolicyInstanceId); v != "" {
+		comments = append(comments, "traffic_policy_instance_id="+v)
+	}
+	if v := aws.ToString(apiObject.SetIdentifier); v != "" {
+		comments = append(comments, "set_identifier="+v)
+	}
+	if v := apiObject.Weight; v != nil {
+		comments = append(comments, fmt.Sprintf("weight=%d", aws.ToInt64(v)))
+	}
+	if v := apiObject.Region; v != "" {
+		comments = append(comments, "region="+string(v))
+	}
+	if v := apiObject.Failover; v != "" {
+		comments = append(comments, "failover="+string(v))
+	}
+	if v := apiObject.GeoLocation; v != nil {
+		if v := aws.ToString(v.ContinentCode); v != "" {
+			comments = append(comments, "geolocation_continent="+v)
+		}
+		if v := aws.ToString(v.CountryCode); v != "" {
+			comments = append(comments, "geolocation_country="+v)
+		}
+		if v := aws.ToString(v.SubdivisionCode); v != "" {
+			comments = append(comments, "geolocation_subdivision="+v)
+		}
+	}
+	if v := apiObject.GeoProximityLocation; v != nil {
+		if v := aws.ToString(v.AWSRegion); v != "" {
+			comments = append(comments, "geoproximity_aws_region="+v)
+		}
+		if v := aws.ToString(v.LocalZoneGroup); v != "" {
+			comments = append(comments, "geoproximity_local_zone_group="+v)
+		}
+		if v := v.Coordinates; v != nil {
+			comments = append(comments, fmt.Sprintf("geoproximity_coordinates=%s,%s", aws.ToString(v.Latitude), aws.ToString(v.Longitude)))
+		}
+		if v := v.Bias; v != nil {
+			comments = append(comments, fmt.Sprintf("geoproximity_bias=%d", aws.ToInt32(v)))
+		}
+	}
+	if v := apiObject.CidrRoutingConfig; v != nil {
+		comments = append(comments, fmt.Sprintf("cidr_routing=%s/%s", aws.ToString(v.CollectionId), aws.ToString(v.LocationName)))
+	}
+	if aws.ToBool(apiObject.MultiValueAnswer) {
+		comments = append(comments, "multi_value_answer=true")
+	}
+	if v := aws.ToString(apiObject.HealthCheckId); v != "" {
+		comments = append(comments, "health_check_id="+v)
+	}
+
+	return dns.RecordSet{
+		Name: aws.ToString(apiObject.Name),
+		Type: string(apiObject.Type),
+		TTL:  aws.ToInt64(apiObject.TTL),
+		Values: tfslices.ApplyToAll(apiObject.ResourceRecords, func(v awstypes.ResourceRecord) string {
+			return aws.ToString(v.Value)
+		}),
+		Comment: strings.Join(comments, " "),
+	}
+}
+
+type zoneFileExportDataSourceModel struct {
+	framework.WithAssumeRoleModel
+	Content types.String `tfsdk:"content"`
+	Name    types.String `tfsdk:"name"`
+	ZoneID  types.String `tfsdk:"zone_id"`
+}
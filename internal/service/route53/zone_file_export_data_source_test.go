@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRoute53ZoneFileExportDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_route53_zone_file_export.test"
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneFileExportDataSourceConfig_basic(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrName, zoneName.FQDN().String()),
+					resource.TestMatchResourceAttr(dataSourceName, "content", regexache.MustCompile(fmt.Sprintf(`(?m)^\$ORIGIN %s$`, regexp.QuoteMeta(zoneName.FQDN().String())))),
+					resource.TestMatchResourceAttr(dataSourceName, "content", regexache.MustCompile(`(?m)^@ +\d+ +IN SOA +\S+\. \S+\. `)),
+					resource.TestMatchResourceAttr(dataSourceName, "content", regexache.MustCompile(`(?m)^@ +\d+ +IN NS +\S+\.$`)),
+					resource.TestMatchResourceAttr(dataSourceName, "content", regexache.MustCompile(`(?m)^www +300 +IN A +192\.0\.2\.1$`)),
+					resource.TestMatchResourceAttr(dataSourceName, "content", regexache.MustCompile(`(?m)^\* +300 +IN CNAME +www\.example\.com\.$`)),
+					resource.TestMatchResourceAttr(dataSourceName, "content", regexache.MustCompile(`(?m)^@ +300 +IN MX +10 mail\.example\.com\.$`)),
+					resource.TestMatchResourceAttr(dataSourceName, "content", regexache.MustCompile(`(?m)^txt +300 +IN TXT +"v=spf1 -all"$`)),
+				),
+			},
+			{
+				Config: testAccZoneFileExportDataSourceConfig_roundTrip(zoneName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.aws_route53_zone_file.test", "resource_record_sets.#", "6"),
+				),
+			},
+		},
+	})
+}
+
+func testAccZoneFileExportDataSourceConfig_basic(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_record" "a" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "www"
+  type    = "A"
+  ttl     = 300
+  records = ["192.0.2.1"]
+}
+
+resource "aws_route53_record" "cname" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "*"
+  type    = "CNAME"
+  ttl     = 300
+  records = ["www.example.com"]
+}
+
+resource "aws_route53_record" "mx" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = ""
+  type    = "MX"
+  ttl     = 300
+  records = ["10 mail.example.com"]
+}
+
+resource "aws_route53_record" "txt" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "txt"
+  type    = "TXT"
+  ttl     = 300
+  records = ["v=spf1 -all"]
+}
+
+data "aws_route53_zone_file_export" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  depends_on = [
+    aws_route53_record.a,
+    aws_route53_record.cname,
+    aws_route53_record.mx,
+    aws_route53_record.txt,
+  ]
+}
+`, zoneName)
+}
+
+func testAccZoneFileExportDataSourceConfig_roundTrip(zoneName string) string {
+	return acctest.ConfigCompose(testAccZoneFileExportDataSourceConfig_basic(zoneName), `
+data "aws_route53_zone_file" "test" {
+  content = data.aws_route53_zone_file_export.test.content
+  origin  = data.aws_route53_zone_file_export.test.name
+}
+`)
+}
@@ -72,6 +72,7 @@ func (d *zonesDataSource) Read(ctx context.Context, request datasource.ReadReque
 }
 
 type zonesDataSourceModel struct {
+	framework.WithAssumeRoleModel
 	ID      types.String         `tfsdk:"id"`
 	ZoneIDs fwtypes.ListOfString `tfsdk:"ids"`
 }
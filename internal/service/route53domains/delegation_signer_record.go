@@ -232,6 +232,7 @@ func (r *delegationSignerRecordResource) Delete(ctx context.Context, request res
 }
 
 type delegationSignerRecordResourceModel struct {
+	framework.WithAssumeRoleModel
 	DNSSECKeyID       types.String                                                                  `tfsdk:"dnssec_key_id"`
 	DomainName        types.String                                                                  `tfsdk:"domain_name"`
 	ID                types.String                                                                  `tfsdk:"id"`
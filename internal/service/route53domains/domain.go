@@ -689,6 +689,7 @@ func renewDomain(ctx context.Context, conn *route53domains.Client, domainName st
 }
 
 type domainResourceModel struct {
+	framework.WithAssumeRoleModel
 	AbuseContactEmail types.String                                        `tfsdk:"abuse_contact_email"`
 	AbuseContactPhone types.String                                        `tfsdk:"abuse_contact_phone"`
 	AdminContact      fwtypes.ListNestedObjectValueOf[contactDetailModel] `tfsdk:"admin_contact"`
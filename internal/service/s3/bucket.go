@@ -84,6 +84,8 @@ func resourceBucket() *schema.Resource {
 			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
+		CustomizeDiff: resourceBucketCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"acceleration_status": {
 				Type:             schema.TypeString,
@@ -2988,6 +2990,28 @@ func flattenObjectLockConfiguration(apiObject *types.ObjectLockConfiguration) []
 	return []any{m}
 }
 
+func resourceBucketCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() == "" {
+		// Create.
+		if config := d.GetRawConfig(); config.GetAttr(names.AttrBucket).IsNull() && config.GetAttr(names.AttrBucketPrefix).IsNull() {
+			c := meta.(*conns.AWSClient)
+			if err := validBucketNamingPolicy(c.NamingPolicy(ctx), c.Region(ctx)); err != nil {
+				return fmt.Errorf("naming policy cannot be applied to %s, configure %s or %s: %w", names.AttrBucket, names.AttrBucket, names.AttrBucketPrefix, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validBucketNamingPolicy validates that bucket names generated to conform to the provider-level naming policy
+// are valid in the specified Region, e.g. upper case names are only valid in us-east-1.
+func validBucketNamingPolicy(policy *create.NamingPolicy, region string) error {
+	return policy.ValidateGeneratedName(func(v string) error {
+		return validBucketName(v, region)
+	})
+}
+
 // validBucketName validates any S3 bucket name that is not inside the us-east-1 region.
 // Buckets outside of this region have to be DNS-compliant. After the same restrictions are
 // applied to buckets in the us-east-1 region, this function can be refactored as a SchemaValidateFunc
@@ -34,6 +34,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	tfstatecheck "github.com/hashicorp/terraform-provider-aws/internal/acctest/statecheck"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	tfcloudformation "github.com/hashicorp/terraform-provider-aws/internal/service/cloudformation"
 	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -2726,6 +2727,55 @@ func TestBucketName(t *testing.T) {
 	}
 }
 
+func TestValidBucketNamingPolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		policy      *create.NamingPolicy
+		region      string
+		expectError bool
+	}{
+		"no policy": {
+			region: endpoints.UsWest2RegionID,
+		},
+		"lower case": {
+			policy: &create.NamingPolicy{Casing: create.NamingPolicyCasingLower, Prefix: "Acme", Separator: "-"},
+			region: endpoints.UsWest2RegionID,
+		},
+		"upper case": {
+			policy:      &create.NamingPolicy{Casing: create.NamingPolicyCasingUpper, Prefix: "acme", Separator: "-"},
+			region:      endpoints.UsWest2RegionID,
+			expectError: true,
+		},
+		"invalid separator": {
+			policy:      &create.NamingPolicy{Prefix: "acme", Separator: "_"},
+			region:      endpoints.UsWest2RegionID,
+			expectError: true,
+		},
+		"too long": {
+			policy:      &create.NamingPolicy{MaxLength: 100, Prefix: strings.Repeat("x", 40), Separator: "-"},
+			region:      endpoints.UsWest2RegionID,
+			expectError: true,
+		},
+		"upper case us-east-1": {
+			policy: &create.NamingPolicy{Casing: create.NamingPolicyCasingUpper, Prefix: "acme", Separator: "-"},
+			region: endpoints.UsEast1RegionID,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfs3.ValidBucketNamingPolicy(testCase.policy, testCase.region)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("ValidBucketNamingPolicy() = %v, want error %t", err, want)
+			}
+		})
+	}
+}
+
 func TestBucketRegionalDomainName(t *testing.T) {
 	t.Parallel()
 
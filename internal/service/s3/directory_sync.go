@@ -0,0 +1,587 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfio "github.com/hashicorp/terraform-provider-aws/internal/io"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_s3_directory_sync", name="Directory Sync")
+func newDirectorySyncResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &directorySyncResource{}
+
+	return r, nil
+}
+
+const (
+	// See https://docs.aws.amazon.com/AmazonS3/latest/API/API_DeleteObjects.html.
+	deleteObjectsMaxKeys = 1000
+)
+
+type directorySyncResource struct {
+	framework.ResourceWithModel[directorySyncResourceModel]
+}
+
+func (r *directorySyncResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrBucket: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"delete_unmanaged": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"exclude": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"files": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"key_prefix": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					// Objects are listed by prefix, so a prefix not ending in "/" would match other prefixes' objects.
+					stringvalidator.RegexMatches(regexache.MustCompile(`(^$|/$)`), "must be empty or end with /"),
+				},
+			},
+			names.AttrKMSKeyID: schema.StringAttribute{
+				Optional: true,
+			},
+			"part_size": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(manager.DefaultUploadPartSize),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(manager.MinUploadPartSize),
+				},
+			},
+			"server_side_encryption": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ServerSideEncryption](),
+				Optional:   true,
+			},
+			"source_dir": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			names.AttrStorageClass: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.StorageClass](),
+				Optional:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrRule: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[directorySyncRuleModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"cache_control": schema.StringAttribute{
+							Optional: true,
+						},
+						"content_disposition": schema.StringAttribute{
+							Optional: true,
+						},
+						"content_encoding": schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrContentType: schema.StringAttribute{
+							Optional: true,
+						},
+						"metadata": schema.MapAttribute{
+							CustomType:  fwtypes.MapOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"pattern": schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *directorySyncResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Destroy.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan directorySyncResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.SourceDir.IsUnknown() || plan.KeyPrefix.IsUnknown() || plan.Exclude.IsUnknown() || plan.PartSize.IsUnknown() {
+		return
+	}
+
+	// Compute the desired set of objects from the local directory tree so that the plan
+	// shows a per-file summary of the objects to be uploaded or deleted.
+	files, err := walkDirectorySyncSource(ctx, plan)
+	if err != nil {
+		response.Diagnostics.AddAttributeError(path.Root("source_dir"), "reading source directory", err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("files"), flattenDirectorySyncFiles(ctx, files))...)
+}
+
+func (r *directorySyncResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data directorySyncResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.directorySyncClient(ctx, data)
+	bucket, keyPrefix := data.Bucket.ValueString(), data.KeyPrefix.ValueString()
+
+	files, err := walkDirectorySyncSource(ctx, data)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading source directory (%s)", data.SourceDir.ValueString()), err.Error())
+		return
+	}
+
+	if err := uploadDirectorySyncFiles(ctx, conn, data, files); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("syncing S3 Directory (%s) to Bucket (%s)", data.SourceDir.ValueString(), bucket), err.Error())
+		return
+	}
+
+	if data.DeleteUnmanaged.ValueBool() {
+		remote, err := findDirectorySyncObjects(ctx, conn, bucket, keyPrefix)
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("listing S3 Bucket (%s) objects", bucket), err.Error())
+			return
+		}
+
+		var unmanaged []string
+		for key := range remote {
+			if !slices.ContainsFunc(files, func(v directorySyncFile) bool { return v.key == key }) {
+				unmanaged = append(unmanaged, key)
+			}
+		}
+
+		if err := deleteDirectorySyncObjects(ctx, conn, bucket, unmanaged); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("deleting unmanaged objects from S3 Bucket (%s)", bucket), err.Error())
+			return
+		}
+	}
+
+	data.Files = flattenDirectorySyncFiles(ctx, files)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *directorySyncResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data directorySyncResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.directorySyncClient(ctx, data)
+	bucket, keyPrefix := data.Bucket.ValueString(), data.KeyPrefix.ValueString()
+
+	remote, err := findDirectorySyncObjects(ctx, conn, bucket, keyPrefix)
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("listing S3 Bucket (%s) objects", bucket), err.Error())
+		return
+	}
+
+	// ETags of objects encrypted with SSE-KMS are not MD5 digests of the object data.
+	compareETags := !slices.Contains([]awstypes.ServerSideEncryption{awstypes.ServerSideEncryptionAwsKms, awstypes.ServerSideEncryptionAwsKmsDsse}, data.ServerSideEncryption.ValueEnum())
+
+	files := make(map[string]string)
+	for key, etag := range fwflex.ExpandFrameworkStringValueMap(ctx, data.Files) {
+		remoteETag, ok := remote[key]
+		if !ok {
+			// Object deleted outside Terraform.
+			continue
+		}
+
+		if compareETags {
+			etag = remoteETag
+		}
+		files[key] = etag
+	}
+
+	// Track unmanaged objects so that their deletion shows in the plan.
+	if data.DeleteUnmanaged.ValueBool() {
+		for key, etag := range remote {
+			if _, ok := files[key]; !ok {
+				files[key] = etag
+			}
+		}
+	}
+
+	data.Files = fwflex.FlattenFrameworkStringValueMapLegacy(ctx, files)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *directorySyncResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state directorySyncResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.directorySyncClient(ctx, plan)
+	bucket := plan.Bucket.ValueString()
+
+	files, err := walkDirectorySyncSource(ctx, plan)
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading source directory (%s)", plan.SourceDir.ValueString()), err.Error())
+		return
+	}
+
+	// Any change to object settings requires all objects to be uploaded again.
+	uploadAll := !plan.SourceDir.Equal(state.SourceDir) ||
+		!plan.Rules.Equal(state.Rules) ||
+		!plan.KMSKeyID.Equal(state.KMSKeyID) ||
+		!plan.PartSize.Equal(state.PartSize) ||
+		!plan.ServerSideEncryption.Equal(state.ServerSideEncryption) ||
+		!plan.StorageClass.Equal(state.StorageClass)
+
+	oldFiles := fwflex.ExpandFrameworkStringValueMap(ctx, state.Files)
+	uploads := tfslices.Filter(files, func(v directorySyncFile) bool {
+		etag, ok := oldFiles[v.key]
+		return uploadAll || !ok || etag != v.etag
+	})
+
+	if err := uploadDirectorySyncFiles(ctx, conn, plan, uploads); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("syncing S3 Directory (%s) to Bucket (%s)", plan.SourceDir.ValueString(), bucket), err.Error())
+		return
+	}
+
+	// Delete objects that are no longer present locally. When delete_unmanaged is enabled
+	// the previous state also includes any unmanaged objects found during refresh.
+	var deletes []string
+	for key := range oldFiles {
+		if !slices.ContainsFunc(files, func(v directorySyncFile) bool { return v.key == key }) {
+			deletes = append(deletes, key)
+		}
+	}
+
+	if err := deleteDirectorySyncObjects(ctx, conn, bucket, deletes); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting objects from S3 Bucket (%s)", bucket), err.Error())
+		return
+	}
+
+	plan.Files = flattenDirectorySyncFiles(ctx, files)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *directorySyncResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data directorySyncResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.directorySyncClient(ctx, data)
+	bucket := data.Bucket.ValueString()
+
+	var keys []string
+	for key := range fwflex.ExpandFrameworkStringValueMap(ctx, data.Files) {
+		keys = append(keys, key)
+	}
+
+	if err := deleteDirectorySyncObjects(ctx, conn, bucket, keys); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting objects from S3 Bucket (%s)", bucket), err.Error())
+		return
+	}
+}
+
+func (r *directorySyncResource) directorySyncClient(ctx context.Context, data directorySyncResourceModel) *s3.Client {
+	if isDirectoryBucket(data.Bucket.ValueString()) {
+		return r.Meta().S3ExpressClient(ctx)
+	}
+
+	return r.Meta().S3Client(ctx)
+}
+
+func uploadDirectorySyncFiles(ctx context.Context, conn *s3.Client, data directorySyncResourceModel, files []directorySyncFile) error {
+	rules, diags := data.Rules.ToSlice(ctx)
+	if diags.HasError() {
+		return fwdiag.DiagnosticsError(diags)
+	}
+
+	partSize := data.PartSize.ValueInt64()
+	uploader := manager.NewUploader(conn, func(u *manager.Uploader) {
+		u.PartSize = partSize
+	})
+
+	for _, file := range files {
+		if err := uploadDirectorySyncFile(ctx, uploader, data, rules, file); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func uploadDirectorySyncFile(ctx context.Context, uploader *manager.Uploader, data directorySyncResourceModel, rules []*directorySyncRuleModel, file directorySyncFile) error {
+	f, err := os.Open(file.path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	input := &s3.PutObjectInput{
+		Body:   f,
+		Bucket: data.Bucket.ValueStringPointer(),
+		Key:    aws.String(file.key),
+	}
+
+	if v := data.KMSKeyID.ValueString(); v != "" {
+		input.SSEKMSKeyId = aws.String(v)
+	}
+
+	if v := data.ServerSideEncryption.ValueEnum(); v != "" {
+		input.ServerSideEncryption = v
+	}
+
+	if v := data.StorageClass.ValueEnum(); v != "" {
+		input.StorageClass = v
+	}
+
+	contentType, err := detectDirectorySyncContentType(f, file.relativePath)
+	if err != nil {
+		return fmt.Errorf("detecting content type of %s: %w", file.path, err)
+	}
+	input.ContentType = aws.String(contentType)
+
+	// Later rules take precedence over earlier ones.
+	for _, rule := range rules {
+		if !tfio.MatchPattern(rule.Pattern.ValueString(), file.relativePath) {
+			continue
+		}
+
+		if v := rule.CacheControl.ValueString(); v != "" {
+			input.CacheControl = aws.String(v)
+		}
+		if v := rule.ContentDisposition.ValueString(); v != "" {
+			input.ContentDisposition = aws.String(v)
+		}
+		if v := rule.ContentEncoding.ValueString(); v != "" {
+			input.ContentEncoding = aws.String(v)
+		}
+		if v := rule.ContentType.ValueString(); v != "" {
+			input.ContentType = aws.String(v)
+		}
+		if v := fwflex.ExpandFrameworkStringValueMap(ctx, rule.Metadata); len(v) > 0 {
+			if input.Metadata == nil {
+				input.Metadata = make(map[string]string)
+			}
+			for k, v := range v {
+				input.Metadata[k] = v
+			}
+		}
+	}
+
+	if _, err := uploader.Upload(ctx, input); err != nil {
+		return fmt.Errorf("uploading %s to %s: %w", file.path, file.key, err)
+	}
+
+	return nil
+}
+
+// findDirectorySyncObjects returns the keys and ETags of all objects under the specified prefix.
+func findDirectorySyncObjects(ctx context.Context, conn *s3.Client, bucket, keyPrefix string) (map[string]string, error) {
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	if keyPrefix != "" {
+		input.Prefix = aws.String(keyPrefix)
+	}
+
+	objects := make(map[string]string)
+	pages := s3.NewListObjectsV2Paginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Contents {
+			objects[aws.ToString(v.Key)] = strings.Trim(aws.ToString(v.ETag), `"`)
+		}
+	}
+
+	return objects, nil
+}
+
+func deleteDirectorySyncObjects(ctx context.Context, conn *s3.Client, bucket string, keys []string) error {
+	slices.Sort(keys)
+
+	for chunk := range slices.Chunk(keys, deleteObjectsMaxKeys) {
+		toDelete := tfslices.ApplyToAll(chunk, func(key string) awstypes.ObjectIdentifier {
+			return awstypes.ObjectIdentifier{
+				Key: aws.String(key),
+			}
+		})
+
+		if _, err := deletePage(ctx, conn, bucket, false, toDelete); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+type directorySyncFile struct {
+	etag         string
+	key          string
+	path         string
+	relativePath string
+}
+
+// walkDirectorySyncSource walks the source directory and returns the regular files to be synced,
+// in lexical order, along with their object keys and expected ETags.
+func walkDirectorySyncSource(ctx context.Context, data directorySyncResourceModel) ([]directorySyncFile, error) {
+	sourceDir := data.SourceDir.ValueString()
+	keyPrefix := data.KeyPrefix.ValueString()
+	partSize := data.PartSize.ValueInt64()
+	excludes := fwflex.ExpandFrameworkStringValueSet(ctx, data.Exclude)
+
+	var files []directorySyncFile
+	err := filepath.WalkDir(sourceDir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(sourceDir, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		if slices.ContainsFunc(excludes, func(pattern string) bool { return tfio.MatchPattern(pattern, rel) }) {
+			return nil
+		}
+
+		etag, err := computeDirectorySyncETag(p, partSize)
+		if err != nil {
+			return err
+		}
+
+		files = append(files, directorySyncFile{
+			etag:         etag,
+			key:          keyPrefix + rel,
+			path:         p,
+			relativePath: rel,
+		})
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+func flattenDirectorySyncFiles(ctx context.Context, files []directorySyncFile) types.Map {
+	m := make(map[string]string, len(files))
+	for _, v := range files {
+		m[v.key] = v.etag
+	}
+
+	return fwflex.FlattenFrameworkStringValueMapLegacy(ctx, m)
+}
+
+type directorySyncResourceModel struct {
+	framework.WithRegionModel
+	Bucket               types.String                                            `tfsdk:"bucket"`
+	DeleteUnmanaged      types.Bool                                              `tfsdk:"delete_unmanaged"`
+	Exclude              fwtypes.SetOfString                                     `tfsdk:"exclude"`
+	Files                types.Map                                               `tfsdk:"files"`
+	KeyPrefix            types.String                                            `tfsdk:"key_prefix"`
+	KMSKeyID             types.String                                            `tfsdk:"kms_key_id"`
+	PartSize             types.Int64                                             `tfsdk:"part_size"`
+	Rules                fwtypes.ListNestedObjectValueOf[directorySyncRuleModel] `tfsdk:"rule"`
+	ServerSideEncryption fwtypes.StringEnum[awstypes.ServerSideEncryption]       `tfsdk:"server_side_encryption"`
+	SourceDir            types.String                                            `tfsdk:"source_dir"`
+	StorageClass         fwtypes.StringEnum[awstypes.StorageClass]               `tfsdk:"storage_class"`
+}
+
+type directorySyncRuleModel struct {
+	CacheControl       types.String        `tfsdk:"cache_control"`
+	ContentDisposition types.String        `tfsdk:"content_disposition"`
+	ContentEncoding    types.String        `tfsdk:"content_encoding"`
+	ContentType        types.String        `tfsdk:"content_type"`
+	Metadata           fwtypes.MapOfString `tfsdk:"metadata"`
+	Pattern            types.String        `tfsdk:"pattern"`
+}
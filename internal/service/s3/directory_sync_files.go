@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+)
+
+func computeDirectorySyncETag(name string, partSize int64) (string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return "", err
+	}
+
+	return multipartETag(f, fi.Size(), partSize)
+}
+
+// multipartETag returns the ETag that S3 reports for an object of the specified size uploaded
+// by manager.Uploader with the specified part size.
+// Objects no larger than the part size are uploaded with a single PutObject call and their ETag is
+// the MD5 digest of the content. Larger objects are uploaded in parts and their ETag is the MD5 digest
+// of the concatenated part digests followed by a hyphen and the number of parts.
+func multipartETag(r io.Reader, size, partSize int64) (string, error) {
+	// Mirror manager.Uploader's part size adjustment for very large objects.
+	if size/partSize >= int64(manager.MaxUploadParts) {
+		partSize = size/int64(manager.MaxUploadParts) + 1
+	}
+
+	if size <= partSize {
+		h := md5.New()
+		if _, err := io.Copy(h, r); err != nil {
+			return "", err
+		}
+
+		return hex.EncodeToString(h.Sum(nil)), nil
+	}
+
+	var digests []byte
+	var n int
+	for {
+		h := md5.New()
+		written, err := io.CopyN(h, r, partSize)
+		if written > 0 {
+			digests = h.Sum(digests)
+			n++
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+
+	h := md5.Sum(digests)
+
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(h[:]), n), nil
+}
+
+// detectDirectorySyncContentType returns the MIME type for the specified file, based first on its
+// extension and then on its content.
+func detectDirectorySyncContentType(f io.ReadSeeker, name string) (string, error) {
+	if v := mime.TypeByExtension(path.Ext(name)); v != "" {
+		return v, nil
+	}
+
+	buf := make([]byte, 512)
+	n, err := io.ReadFull(f, buf)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", err
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	return http.DetectContentType(buf[:n]), nil
+}
@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"strings"
+	"testing"
+
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+)
+
+func TestMultipartETag(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		content  string
+		partSize int64
+		expected string
+	}
+	tests := map[string]testCase{
+		"empty": {
+			content:  "",
+			partSize: 4,
+			expected: "d41d8cd98f00b204e9800998ecf8427e",
+		},
+		"single part": {
+			content:  "abcd",
+			partSize: 4,
+			expected: "e2fc714c4727ee9395f324cd2e7f331f",
+		},
+		"single part smaller than part size": {
+			content:  "abcdefghij",
+			partSize: 16,
+			expected: "a925576942e94b2ef57a066101b48876",
+		},
+		"multiple parts": {
+			content:  "abcdefghij",
+			partSize: 4,
+			expected: "446feba4c1b5cc7ad93bf4d44a0e36ac-3",
+		},
+		"multiple equal parts": {
+			content:  "abcdefghij",
+			partSize: 5,
+			expected: "8e18a6d3619b553c27c7028ea9067e05-2",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfs3.MultipartETag(strings.NewReader(test.content), int64(len(test.content)), test.partSize)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != test.expected {
+				t.Errorf("got %s, expected %s", got, test.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,456 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3DirectorySync_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	sourceDir := testAccDirectorySyncCreateSourceDir(t, map[string]string{
+		"index.html":     "<html></html>",
+		"css/style.css":  "body {}",
+		"img/readme.txt": "images",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncExists(ctx, resourceName),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("delete_unmanaged"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("files"), knownvalue.MapSizeExact(3)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("files").AtMapKey("index.html"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("files").AtMapKey("css/style.css"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("files").AtMapKey("img/readme.txt"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("key_prefix"), knownvalue.StringExact("")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	sourceDir := testAccDirectorySyncCreateSourceDir(t, map[string]string{
+		"index.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectorySyncExists(ctx, resourceName),
+					testAccCheckDirectorySyncDisappears(ctx, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_disappears_Bucket(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	bucketResourceName := "aws_s3_bucket.test"
+	sourceDir := testAccDirectorySyncCreateSourceDir(t, map[string]string{
+		"index.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDirectorySyncExists(ctx, resourceName),
+					acctest.CheckSDKResourceDisappears(ctx, t, tfs3.ResourceBucket(), bucketResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	sourceDir := testAccDirectorySyncCreateSourceDir(t, map[string]string{
+		"index.html":    "<html></html>",
+		"css/style.css": "body {}",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_basic(rName, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncExists(ctx, resourceName),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("files"), knownvalue.MapSizeExact(2)),
+				},
+			},
+			{
+				PreConfig: func() {
+					testAccDirectorySyncWriteSourceFile(t, sourceDir, "index.html", "<html><body></body></html>")
+					testAccDirectorySyncWriteSourceFile(t, sourceDir, "js/app.js", "alert(1);")
+					if err := os.Remove(filepath.Join(sourceDir, "css", "style.css")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectorySyncConfig_basic(rName, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncExists(ctx, resourceName),
+					testAccCheckDirectorySyncObjectNotExists(ctx, resourceName, "css/style.css"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("files"), knownvalue.MapSizeExact(2)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("files").AtMapKey("index.html"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("files").AtMapKey("js/app.js"), knownvalue.NotNull()),
+				},
+			},
+			{
+				Config: testAccDirectorySyncConfig_rule(rName, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncExists(ctx, resourceName),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrRule), knownvalue.ListSizeExact(1)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_deleteUnmanaged(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_sync.test"
+	sourceDir := testAccDirectorySyncCreateSourceDir(t, map[string]string{
+		"index.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectorySyncConfig_deleteUnmanaged(rName, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncExists(ctx, resourceName),
+					testAccCheckBucketAddObjects(ctx, "aws_s3_bucket.test", "site/unmanaged.txt", "site-backup/unmanaged.txt"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccDirectorySyncConfig_deleteUnmanaged(rName, sourceDir),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectorySyncExists(ctx, resourceName),
+					testAccCheckDirectorySyncObjectNotExists(ctx, resourceName, "site/unmanaged.txt"),
+					testAccCheckDirectorySyncObjectExists(ctx, resourceName, "site-backup/unmanaged.txt"),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("files"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"site/index.html": knownvalue.NotNull(),
+					})),
+				},
+			},
+		},
+	})
+}
+
+func TestAccS3DirectorySync_keyPrefixInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	sourceDir := testAccDirectorySyncCreateSourceDir(t, map[string]string{
+		"index.html": "<html></html>",
+	})
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDirectorySyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDirectorySyncConfig_keyPrefix(rName, sourceDir, "site"),
+				ExpectError: regexache.MustCompile(`must be empty or end with /`),
+			},
+		},
+	})
+}
+
+func testAccCheckDirectorySyncDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_directory_sync" {
+				continue
+			}
+
+			bucket := rs.Primary.Attributes[names.AttrBucket]
+			for _, key := range testAccDirectorySyncKeys(rs) {
+				_, err := tfs3.FindObjectByBucketAndKey(ctx, conn, bucket, key, "", "")
+
+				if retry.NotFound(err) {
+					continue
+				}
+
+				if err != nil && strings.Contains(err.Error(), "NoSuchBucket") {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("S3 Directory Sync object %s/%s still exists", bucket, key)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		bucket := rs.Primary.Attributes[names.AttrBucket]
+		for _, key := range testAccDirectorySyncKeys(rs) {
+			if _, err := tfs3.FindObjectByBucketAndKey(ctx, conn, bucket, key, "", ""); err != nil {
+				return fmt.Errorf("S3 Directory Sync object %s/%s: %w", bucket, key, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDirectorySyncObjectNotExists(ctx context.Context, n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		bucket := rs.Primary.Attributes[names.AttrBucket]
+		_, err := tfs3.FindObjectByBucketAndKey(ctx, conn, bucket, key, "", "")
+
+		if retry.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("S3 Object %s/%s still exists", bucket, key)
+	}
+}
+
+func testAccCheckDirectorySyncObjectExists(ctx context.Context, n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		_, err := tfs3.FindObjectByBucketAndKey(ctx, conn, rs.Primary.Attributes[names.AttrBucket], key, "", "")
+
+		return err
+	}
+}
+
+// testAccCheckDirectorySyncDisappears deletes the synced objects out of band.
+func testAccCheckDirectorySyncDisappears(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		bucket := rs.Primary.Attributes[names.AttrBucket]
+		for _, key := range testAccDirectorySyncKeys(rs) {
+			input := s3.DeleteObjectInput{
+				Bucket: aws.String(bucket),
+				Key:    aws.String(key),
+			}
+
+			if _, err := conn.DeleteObject(ctx, &input); err != nil {
+				return fmt.Errorf("deleting S3 Object %s/%s: %w", bucket, key, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+// testAccDirectorySyncKeys returns the object keys recorded in the resource's `files` attribute.
+func testAccDirectorySyncKeys(rs *terraform.ResourceState) []string {
+	var keys []string
+
+	for k := range rs.Primary.Attributes {
+		if key, ok := strings.CutPrefix(k, "files."); ok && key != "%" {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+func testAccDirectorySyncCreateSourceDir(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	sourceDir := t.TempDir()
+	for name, data := range files {
+		testAccDirectorySyncWriteSourceFile(t, sourceDir, name, data)
+	}
+
+	return sourceDir
+}
+
+func testAccDirectorySyncWriteSourceFile(t *testing.T, sourceDir, name, data string) {
+	t.Helper()
+
+	filename := filepath.Join(sourceDir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filename, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testAccDirectorySyncConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+`, rName)
+}
+
+func testAccDirectorySyncConfig_basic(rName, sourceDir string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  source_dir = %[1]q
+}
+`, sourceDir))
+}
+
+func testAccDirectorySyncConfig_rule(rName, sourceDir string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  source_dir = %[1]q
+
+  rule {
+    pattern       = "**/*.js"
+    cache_control = "max-age=3600"
+    content_type  = "application/javascript"
+  }
+}
+`, sourceDir))
+}
+
+func testAccDirectorySyncConfig_deleteUnmanaged(rName, sourceDir string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket           = aws_s3_bucket.test.bucket
+  source_dir       = %[1]q
+  key_prefix       = "site/"
+  delete_unmanaged = true
+}
+`, sourceDir))
+}
+
+func testAccDirectorySyncConfig_keyPrefix(rName, sourceDir, keyPrefix string) string {
+	return acctest.ConfigCompose(testAccDirectorySyncConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_directory_sync" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  source_dir = %[1]q
+  key_prefix = %[2]q
+}
+`, sourceDir, keyPrefix))
+}
@@ -28,9 +28,11 @@ var (
 	ResourceBucketVersioning                        = resourceBucketVersioning
 	ResourceBucketWebsiteConfiguration              = resourceBucketWebsiteConfiguration
 	ResourceDirectoryBucket                         = newDirectoryBucketResource
+	ResourceDirectorySync                           = newDirectorySyncResource
 	ResourceObjectCopy                              = resourceObjectCopy
 
 	BucketUpdateTags                            = bucketUpdateTags
+	ValidBucketNamingPolicy                     = validBucketNamingPolicy
 	BucketRegionalDomainName                    = bucketRegionalDomainName
 	BucketWebsiteEndpointAndDomain              = bucketWebsiteEndpointAndDomain
 	DeleteAllObjectVersions                     = deleteAllObjectVersions
@@ -60,6 +62,7 @@ var (
 	FindServerSideEncryptionConfiguration       = findServerSideEncryptionConfiguration
 	HostedZoneIDForRegion                       = hostedZoneIDForRegion
 	IsDirectoryBucket                           = isDirectoryBucket
+	MultipartETag                               = multipartETag
 	ObjectListTags                              = objectListTags
 	ObjectUpdateTags                            = objectUpdateTags
 	SDKv1CompatibleCleanKey                     = sdkv1CompatibleCleanKey
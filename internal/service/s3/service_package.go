@@ -46,7 +46,9 @@ func (p *servicePackage) withExtraOptions(ctx context.Context, config map[string
 		func(o *s3.Options) {
 			switch region, s3USEast1RegionalEndpoint := o.Region, config["s3_us_east_1_regional_endpoint"].(string) == "regional"; region {
 			case endpoints.UsEast1RegionID:
-				if !s3USEast1RegionalEndpoint {
+				// A base endpoint, e.g. of a local AWS stand-in, has no global endpoint.
+				// It is resolved from the provider configuration, the AWS_ENDPOINT_URL environment variable or the shared configuration file.
+				if baseEndpoint, _ := config["base_endpoint"].(string); !s3USEast1RegionalEndpoint && baseEndpoint == "" {
 					// Maintain the AWS SDK for Go v1 default of using the global endpoint in us-east-1.
 					// See https://github.com/hashicorp/terraform-provider-aws/issues/33028.
 					overrideRegion := endpoints.AwsGlobalRegionID
@@ -81,6 +81,12 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 				WrappedImport: true,
 			},
 		},
+		{
+			Factory:  newDirectorySyncResource,
+			TypeName: "aws_s3_directory_sync",
+			Name:     "Directory Sync",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 	}
 }
 
@@ -400,6 +400,7 @@ func findSavingsPlans(ctx context.Context, conn *savingsplans.Client, input *sav
 }
 
 type savingsPlanResourceModel struct {
+	framework.WithAssumeRoleModel
 	Commitment             types.String                                  `tfsdk:"commitment"`
 	Currency               types.String                                  `tfsdk:"currency"`
 	Description            types.String                                  `tfsdk:"description"`
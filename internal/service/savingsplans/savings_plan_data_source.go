@@ -143,6 +143,7 @@ func (d *savingsPlanDataSource) Read(ctx context.Context, req datasource.ReadReq
 }
 
 type savingsPlanDataSourceModel struct {
+	framework.WithAssumeRoleModel
 	Commitment             types.String         `tfsdk:"commitment"`
 	Currency               types.String         `tfsdk:"currency"`
 	Description            types.String         `tfsdk:"description"`
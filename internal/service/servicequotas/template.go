@@ -318,6 +318,7 @@ func findTemplate(ctx context.Context, conn *servicequotas.Client, input *servic
 }
 
 type templateResourceModel struct {
+	framework.WithAssumeRoleModel
 	AWSRegion    types.String  `tfsdk:"aws_region"`
 	DesiredValue types.Float64 `tfsdk:"value"`
 	GlobalQuota  types.Bool    `tfsdk:"global_quota"`
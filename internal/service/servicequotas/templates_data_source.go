@@ -110,6 +110,7 @@ func findTemplates(ctx context.Context, conn *servicequotas.Client, input *servi
 }
 
 type templatesDataSourceModel struct {
+	framework.WithAssumeRoleModel
 	AWSRegion types.String                                                                `tfsdk:"aws_region"`
 	ID        types.String                                                                `tfsdk:"id"`
 	Region    types.String                                                                `tfsdk:"region"`
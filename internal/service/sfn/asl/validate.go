@@ -0,0 +1,577 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package asl implements offline validation of Amazon States Language (ASL) state machine definitions.
+// See https://states-language.net/spec.html and https://docs.aws.amazon.com/step-functions/latest/dg/concepts-amazon-states-language.html.
+package asl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	QueryLanguageJSONPath = "JSONPath"
+	QueryLanguageJSONata  = "JSONata"
+)
+
+const (
+	stateTypeChoice   = "Choice"
+	stateTypeFail     = "Fail"
+	stateTypeMap      = "Map"
+	stateTypeParallel = "Parallel"
+	stateTypePass     = "Pass"
+	stateTypeSucceed  = "Succeed"
+	stateTypeTask     = "Task"
+	stateTypeWait     = "Wait"
+)
+
+const (
+	errorStatesAll = "States.ALL"
+
+	stateNameMaxLength = 80
+)
+
+var (
+	stateTypes = []string{
+		stateTypeChoice,
+		stateTypeFail,
+		stateTypeMap,
+		stateTypeParallel,
+		stateTypePass,
+		stateTypeSucceed,
+		stateTypeTask,
+		stateTypeWait,
+	}
+
+	// Fields that may only be used with the JSONPath query language.
+	jsonPathFields = []string{
+		"InputPath",
+		"ItemsPath",
+		"OutputPath",
+		"Parameters",
+		"ResultPath",
+		"ResultSelector",
+	}
+	// Fields that may only be used with the JSONata query language.
+	jsonataFields = []string{
+		"Arguments",
+		"Items",
+		"Output",
+	}
+
+	choiceComparisonOperators = func() []string {
+		operators := []string{
+			"BooleanEquals",
+			"BooleanEqualsPath",
+			"IsBoolean",
+			"IsNull",
+			"IsNumeric",
+			"IsPresent",
+			"IsString",
+			"IsTimestamp",
+			"StringMatches",
+		}
+		for _, prefix := range []string{"Numeric", "String", "Timestamp"} {
+			for _, suffix := range []string{"Equals", "GreaterThan", "GreaterThanEquals", "LessThan", "LessThanEquals"} {
+				operators = append(operators, prefix+suffix, prefix+suffix+"Path")
+			}
+		}
+		return operators
+	}()
+)
+
+// Validate parses and validates the specified state machine definition.
+// All problems found are returned joined into a single error.
+func Validate(definition string) error {
+	decoder := json.NewDecoder(strings.NewReader(definition))
+	decoder.UseNumber()
+
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return fmt.Errorf("decoding JSON: %w", err)
+	}
+	if decoder.More() {
+		return errors.New("decoding JSON: unexpected data after top-level value")
+	}
+
+	tfMap, ok := v.(map[string]any)
+	if !ok {
+		return errors.New("definition must be a JSON object")
+	}
+
+	var errs []error
+	validator := &validator{errs: &errs}
+	queryLanguage := QueryLanguageJSONPath
+	if v, ok := tfMap["QueryLanguage"]; ok {
+		switch v {
+		case QueryLanguageJSONPath, QueryLanguageJSONata:
+			queryLanguage = v.(string)
+		default:
+			validator.addf("QueryLanguage", "must be one of %q or %q", QueryLanguageJSONPath, QueryLanguageJSONata)
+		}
+	}
+	if v, ok := tfMap["TimeoutSeconds"]; ok && !isNonNegativeInteger(v) {
+		validator.addf("TimeoutSeconds", "must be a non-negative integer")
+	}
+
+	validator.stateMachine("", tfMap, queryLanguage)
+
+	return errors.Join(errs...)
+}
+
+type validator struct {
+	errs *[]error
+}
+
+func (v *validator) addf(path, format string, a ...any) {
+	*v.errs = append(*v.errs, fmt.Errorf("%s: %s", path, fmt.Sprintf(format, a...)))
+}
+
+// stateMachine validates a (possibly nested) state machine, i.e. an object with StartAt and States fields.
+// State transitions are scoped to the enclosing state machine.
+func (v *validator) stateMachine(path string, tfMap map[string]any, queryLanguage string) {
+	states, ok := tfMap["States"].(map[string]any)
+	if !ok || len(states) == 0 {
+		v.addf(joinPath(path, "States"), "must be a non-empty object")
+		return
+	}
+
+	startAt, ok := tfMap["StartAt"].(string)
+	if !ok {
+		v.addf(joinPath(path, "StartAt"), "must be a string")
+	} else if _, ok := states[startAt]; !ok {
+		v.addf(joinPath(path, "StartAt"), "state %q does not exist", startAt)
+	}
+
+	transitions := make(map[string][]string)
+	for _, name := range sortedKeys(states) {
+		statePath := fmt.Sprintf("%s[%q]", joinPath(path, "States"), name)
+
+		if len(name) == 0 || len(name) > stateNameMaxLength {
+			v.addf(statePath, "state name must be between 1 and %d characters", stateNameMaxLength)
+		}
+
+		state, ok := states[name].(map[string]any)
+		if !ok {
+			v.addf(statePath, "must be an object")
+			continue
+		}
+
+		transitions[name] = v.state(statePath, state, states, queryLanguage)
+	}
+
+	// Report states that cannot be reached from StartAt.
+	if _, ok := states[startAt]; ok {
+		reachable := map[string]bool{startAt: true}
+		queue := []string{startAt}
+		for len(queue) > 0 {
+			name := queue[0]
+			queue = queue[1:]
+			for _, next := range transitions[name] {
+				if _, ok := states[next]; ok && !reachable[next] {
+					reachable[next] = true
+					queue = append(queue, next)
+				}
+			}
+		}
+
+		for _, name := range sortedKeys(states) {
+			if !reachable[name] {
+				v.addf(fmt.Sprintf("%s[%q]", joinPath(path, "States"), name), "state is unreachable from %q", startAt)
+			}
+		}
+	}
+}
+
+// state validates a single state and returns the names of the states it can transition to.
+func (v *validator) state(path string, state map[string]any, states map[string]any, queryLanguage string) []string {
+	var transitions []string
+
+	stateType, ok := state["Type"].(string)
+	if !ok {
+		v.addf(joinPath(path, "Type"), "must be a string")
+		return nil
+	}
+	if !slices.Contains(stateTypes, stateType) {
+		v.addf(joinPath(path, "Type"), "unsupported state type %q", stateType)
+		return nil
+	}
+
+	if value, ok := state["QueryLanguage"]; ok {
+		switch value {
+		case QueryLanguageJSONPath:
+			if queryLanguage == QueryLanguageJSONata {
+				v.addf(joinPath(path, "QueryLanguage"), "cannot be %q when the state machine uses %q", QueryLanguageJSONPath, QueryLanguageJSONata)
+			}
+			queryLanguage = QueryLanguageJSONPath
+		case QueryLanguageJSONata:
+			queryLanguage = QueryLanguageJSONata
+		default:
+			v.addf(joinPath(path, "QueryLanguage"), "must be one of %q or %q", QueryLanguageJSONPath, QueryLanguageJSONata)
+		}
+	}
+
+	v.queryLanguageFields(path, state, queryLanguage)
+
+	// Transitions.
+	next, hasNext := state["Next"]
+	end, hasEnd := state["End"]
+	switch stateType {
+	case stateTypeChoice, stateTypeFail, stateTypeSucceed:
+		if hasNext || hasEnd {
+			v.addf(path, "%s states cannot have Next or End", stateType)
+		}
+	default:
+		switch {
+		case hasNext && hasEnd:
+			v.addf(path, "only one of Next or End can be specified")
+		case hasNext:
+			transitions = append(transitions, v.transition(joinPath(path, "Next"), next, states)...)
+		case hasEnd:
+			if end != true {
+				v.addf(joinPath(path, "End"), "must be true")
+			}
+		default:
+			v.addf(path, "one of Next or End must be specified")
+		}
+	}
+
+	// Error handling.
+	switch stateType {
+	case stateTypeMap, stateTypeParallel, stateTypeTask:
+		if value, ok := state["Retry"]; ok {
+			v.retriers(joinPath(path, "Retry"), value)
+		}
+		if value, ok := state["Catch"]; ok {
+			transitions = append(transitions, v.catchers(joinPath(path, "Catch"), value, states)...)
+		}
+	default:
+		for _, field := range []string{"Catch", "Retry"} {
+			if _, ok := state[field]; ok {
+				v.addf(joinPath(path, field), "not supported for %s states", stateType)
+			}
+		}
+	}
+
+	// Type-specific fields.
+	switch stateType {
+	case stateTypeChoice:
+		transitions = append(transitions, v.choice(path, state, states, queryLanguage)...)
+	case stateTypeMap:
+		processorPath := joinPath(path, "ItemProcessor")
+		processor, ok := state["ItemProcessor"]
+		if !ok {
+			processorPath = joinPath(path, "Iterator")
+			processor, ok = state["Iterator"]
+		}
+		if !ok {
+			v.addf(path, "ItemProcessor must be specified")
+		} else if tfMap, ok := processor.(map[string]any); !ok {
+			v.addf(processorPath, "must be an object")
+		} else {
+			v.stateMachine(processorPath, tfMap, nestedQueryLanguage(tfMap, queryLanguage))
+		}
+	case stateTypeParallel:
+		branches, ok := state["Branches"].([]any)
+		if !ok || len(branches) == 0 {
+			v.addf(joinPath(path, "Branches"), "must be a non-empty array")
+			break
+		}
+		for i, branch := range branches {
+			branchPath := fmt.Sprintf("%s[%d]", joinPath(path, "Branches"), i)
+			if tfMap, ok := branch.(map[string]any); !ok {
+				v.addf(branchPath, "must be an object")
+			} else {
+				v.stateMachine(branchPath, tfMap, nestedQueryLanguage(tfMap, queryLanguage))
+			}
+		}
+	case stateTypeTask:
+		if _, ok := state["Resource"].(string); !ok {
+			v.addf(joinPath(path, "Resource"), "must be a string")
+		}
+	case stateTypeWait:
+		var n int
+		for _, field := range []string{"Seconds", "SecondsPath", "Timestamp", "TimestampPath"} {
+			if _, ok := state[field]; ok {
+				n++
+			}
+		}
+		if n != 1 {
+			v.addf(path, "exactly one of Seconds, SecondsPath, Timestamp or TimestampPath must be specified")
+		}
+	}
+
+	return transitions
+}
+
+func (v *validator) queryLanguageFields(path string, tfMap map[string]any, queryLanguage string) {
+	var fields []string
+	switch queryLanguage {
+	case QueryLanguageJSONPath:
+		fields = jsonataFields
+	case QueryLanguageJSONata:
+		fields = jsonPathFields
+	}
+
+	for _, field := range fields {
+		if _, ok := tfMap[field]; ok {
+			v.addf(joinPath(path, field), "not supported when the query language is %q", queryLanguage)
+		}
+	}
+}
+
+func (v *validator) transition(path string, value any, states map[string]any) []string {
+	next, ok := value.(string)
+	if !ok {
+		v.addf(path, "must be a string")
+		return nil
+	}
+
+	if _, ok := states[next]; !ok {
+		v.addf(path, "state %q does not exist", next)
+		return nil
+	}
+
+	return []string{next}
+}
+
+func (v *validator) choice(path string, state map[string]any, states map[string]any, queryLanguage string) []string {
+	var transitions []string
+
+	rules, ok := state["Choices"].([]any)
+	if !ok || len(rules) == 0 {
+		v.addf(joinPath(path, "Choices"), "must be a non-empty array")
+	}
+
+	for i, rule := range rules {
+		rulePath := fmt.Sprintf("%s[%d]", joinPath(path, "Choices"), i)
+		tfMap, ok := rule.(map[string]any)
+		if !ok {
+			v.addf(rulePath, "must be an object")
+			continue
+		}
+
+		if next, ok := tfMap["Next"]; ok {
+			transitions = append(transitions, v.transition(joinPath(rulePath, "Next"), next, states)...)
+		} else {
+			v.addf(rulePath, "Next must be specified")
+		}
+
+		switch queryLanguage {
+		case QueryLanguageJSONata:
+			if _, ok := tfMap["Condition"].(string); !ok {
+				v.addf(joinPath(rulePath, "Condition"), "must be a string")
+			}
+			for _, field := range append([]string{"And", "Not", "Or", "Variable"}, choiceComparisonOperators...) {
+				if _, ok := tfMap[field]; ok {
+					v.addf(joinPath(rulePath, field), "not supported when the query language is %q", queryLanguage)
+				}
+			}
+		default:
+			if _, ok := tfMap["Condition"]; ok {
+				v.addf(joinPath(rulePath, "Condition"), "not supported when the query language is %q", queryLanguage)
+			}
+			v.choiceRule(rulePath, tfMap)
+		}
+	}
+
+	if value, ok := state["Default"]; ok {
+		transitions = append(transitions, v.transition(joinPath(path, "Default"), value, states)...)
+	}
+
+	return transitions
+}
+
+// choiceRule validates a JSONPath Choice Rule, which is either a boolean expression or a data-test expression.
+func (v *validator) choiceRule(path string, tfMap map[string]any) {
+	var operators []string
+	for _, field := range []string{"And", "Not", "Or"} {
+		if _, ok := tfMap[field]; ok {
+			operators = append(operators, field)
+		}
+	}
+	for _, field := range choiceComparisonOperators {
+		if _, ok := tfMap[field]; ok {
+			operators = append(operators, field)
+		}
+	}
+
+	if len(operators) != 1 {
+		v.addf(path, "exactly one comparison operator or one of And, Not or Or must be specified, found %d", len(operators))
+		return
+	}
+
+	switch operator := operators[0]; operator {
+	case "And", "Or":
+		rules, ok := tfMap[operator].([]any)
+		if !ok || len(rules) == 0 {
+			v.addf(joinPath(path, operator), "must be a non-empty array")
+			return
+		}
+		for i, rule := range rules {
+			v.nestedChoiceRule(fmt.Sprintf("%s[%d]", joinPath(path, operator), i), rule)
+		}
+	case "Not":
+		v.nestedChoiceRule(joinPath(path, operator), tfMap[operator])
+	default:
+		if _, ok := tfMap["Variable"].(string); !ok {
+			v.addf(joinPath(path, "Variable"), "must be a string")
+		}
+	}
+}
+
+func (v *validator) nestedChoiceRule(path string, value any) {
+	tfMap, ok := value.(map[string]any)
+	if !ok {
+		v.addf(path, "must be an object")
+		return
+	}
+
+	if _, ok := tfMap["Next"]; ok {
+		v.addf(joinPath(path, "Next"), "only supported on top-level Choice Rules")
+	}
+
+	v.choiceRule(path, tfMap)
+}
+
+func (v *validator) retriers(path string, value any) {
+	retriers, ok := value.([]any)
+	if !ok {
+		v.addf(path, "must be an array")
+		return
+	}
+
+	for i, retrier := range retriers {
+		retrierPath := fmt.Sprintf("%s[%d]", path, i)
+		tfMap, ok := retrier.(map[string]any)
+		if !ok {
+			v.addf(retrierPath, "must be an object")
+			continue
+		}
+
+		v.errorEquals(retrierPath, tfMap, i == len(retriers)-1)
+
+		if value, ok := tfMap["IntervalSeconds"]; ok && !isPositiveInteger(value) {
+			v.addf(joinPath(retrierPath, "IntervalSeconds"), "must be a positive integer")
+		}
+		if value, ok := tfMap["MaxAttempts"]; ok && !isNonNegativeInteger(value) {
+			v.addf(joinPath(retrierPath, "MaxAttempts"), "must be a non-negative integer")
+		}
+		if value, ok := tfMap["BackoffRate"]; ok {
+			if n, ok := value.(json.Number); !ok {
+				v.addf(joinPath(retrierPath, "BackoffRate"), "must be a number")
+			} else if f, err := n.Float64(); err != nil || f < 1.0 {
+				v.addf(joinPath(retrierPath, "BackoffRate"), "must be greater than or equal to 1.0")
+			}
+		}
+	}
+}
+
+func (v *validator) catchers(path string, value any, states map[string]any) []string {
+	var transitions []string
+
+	catchers, ok := value.([]any)
+	if !ok {
+		v.addf(path, "must be an array")
+		return nil
+	}
+
+	for i, catcher := range catchers {
+		catcherPath := fmt.Sprintf("%s[%d]", path, i)
+		tfMap, ok := catcher.(map[string]any)
+		if !ok {
+			v.addf(catcherPath, "must be an object")
+			continue
+		}
+
+		v.errorEquals(catcherPath, tfMap, i == len(catchers)-1)
+
+		if next, ok := tfMap["Next"]; ok {
+			transitions = append(transitions, v.transition(joinPath(catcherPath, "Next"), next, states)...)
+		} else {
+			v.addf(catcherPath, "Next must be specified")
+		}
+	}
+
+	return transitions
+}
+
+// errorEquals validates the ErrorEquals field of a Retrier or Catcher.
+// "States.ALL" must appear alone and only in the last Retrier or Catcher.
+func (v *validator) errorEquals(path string, tfMap map[string]any, last bool) {
+	path = joinPath(path, "ErrorEquals")
+
+	errorNames, ok := tfMap["ErrorEquals"].([]any)
+	if !ok || len(errorNames) == 0 {
+		v.addf(path, "must be a non-empty array")
+		return
+	}
+
+	for i, errorName := range errorNames {
+		if _, ok := errorName.(string); !ok {
+			v.addf(fmt.Sprintf("%s[%d]", path, i), "must be a string")
+			continue
+		}
+
+		if errorName == errorStatesAll {
+			if len(errorNames) != 1 {
+				v.addf(path, "%q must appear alone", errorStatesAll)
+			}
+			if !last {
+				v.addf(path, "%q must appear in the last element", errorStatesAll)
+			}
+		}
+	}
+}
+
+func nestedQueryLanguage(tfMap map[string]any, queryLanguage string) string {
+	if v, ok := tfMap["QueryLanguage"].(string); ok && (v == QueryLanguageJSONPath || v == QueryLanguageJSONata) {
+		return v
+	}
+
+	return queryLanguage
+}
+
+func isNonNegativeInteger(v any) bool {
+	n, ok := v.(json.Number)
+	if !ok {
+		return false
+	}
+
+	i, err := n.Int64()
+
+	return err == nil && i >= 0
+}
+
+func isPositiveInteger(v any) bool {
+	n, ok := v.(json.Number)
+	if !ok {
+		return false
+	}
+
+	i, err := n.Int64()
+
+	return err == nil && i > 0
+}
+
+func joinPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+
+	return path + "." + field
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
@@ -0,0 +1,266 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package asl_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/sfn/asl"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		definition string
+		expected   []string
+	}
+	tests := map[string]testCase{
+		"invalid JSON": {
+			definition: `{"StartAt":`,
+			expected:   []string{"decoding JSON: unexpected EOF"},
+		},
+		"not an object": {
+			definition: `[]`,
+			expected:   []string{"definition must be a JSON object"},
+		},
+		"valid": {
+			definition: `{
+  "Comment": "A Hello World example",
+  "StartAt": "HelloWorld",
+  "States": {
+    "HelloWorld": {
+      "Type": "Task",
+      "Resource": "arn:aws:lambda:us-west-2:123456789012:function:hello",
+      "Retry": [
+        {"ErrorEquals": ["Lambda.ServiceException"], "IntervalSeconds": 2, "MaxAttempts": 3, "BackoffRate": 1.5},
+        {"ErrorEquals": ["States.ALL"]}
+      ],
+      "Catch": [
+        {"ErrorEquals": ["States.ALL"], "Next": "Failed"}
+      ],
+      "Next": "IsDone"
+    },
+    "IsDone": {
+      "Type": "Choice",
+      "Choices": [
+        {"Variable": "$.done", "BooleanEquals": true, "Next": "Done"},
+        {"And": [{"Variable": "$.n", "NumericGreaterThan": 1}, {"Not": {"Variable": "$.s", "IsNull": true}}], "Next": "Wait"}
+      ],
+      "Default": "Failed"
+    },
+    "Wait": {"Type": "Wait", "Seconds": 10, "Next": "Fan"},
+    "Fan": {
+      "Type": "Parallel",
+      "Branches": [
+        {"StartAt": "A", "States": {"A": {"Type": "Pass", "End": true}}},
+        {"StartAt": "B", "States": {"B": {"Type": "Pass", "End": true}}}
+      ],
+      "Next": "Each"
+    },
+    "Each": {
+      "Type": "Map",
+      "ItemsPath": "$.items",
+      "ItemProcessor": {"StartAt": "C", "States": {"C": {"Type": "Succeed"}}},
+      "End": true
+    },
+    "Done": {"Type": "Succeed"},
+    "Failed": {"Type": "Fail", "Error": "Failed"}
+  }
+}`,
+		},
+		"valid JSONata": {
+			definition: `{
+  "QueryLanguage": "JSONata",
+  "StartAt": "Check",
+  "States": {
+    "Check": {
+      "Type": "Choice",
+      "Choices": [{"Condition": "{% $states.input.ok %}", "Next": "Done"}],
+      "Default": "Call"
+    },
+    "Call": {"Type": "Task", "Resource": "arn:aws:states:::lambda:invoke", "Arguments": {"FunctionName": "f"}, "Output": "{% $states.result %}", "Next": "Done"},
+    "Done": {"Type": "Succeed"}
+  }
+}`,
+		},
+		"missing StartAt state": {
+			definition: `{"StartAt": "Missing", "States": {"A": {"Type": "Succeed"}}}`,
+			expected: []string{
+				`StartAt: state "Missing" does not exist`,
+			},
+		},
+		"missing States": {
+			definition: `{"StartAt": "A"}`,
+			expected: []string{
+				"States: must be a non-empty object",
+			},
+		},
+		"invalid transitions": {
+			definition: `{
+  "StartAt": "A",
+  "States": {
+    "A": {"Type": "Pass", "Next": "Missing"},
+    "B": {"Type": "Pass"},
+    "C": {"Type": "Pass", "Next": "A", "End": true},
+    "D": {"Type": "Succeed", "End": true}
+  }
+}`,
+			expected: []string{
+				`States["A"].Next: state "Missing" does not exist`,
+				`States["B"]: one of Next or End must be specified`,
+				`States["C"]: only one of Next or End can be specified`,
+				`States["D"]: Succeed states cannot have Next or End`,
+				`States["B"]: state is unreachable from "A"`,
+				`States["C"]: state is unreachable from "A"`,
+				`States["D"]: state is unreachable from "A"`,
+			},
+		},
+		"invalid state type": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Lambda", "End": true}}}`,
+			expected: []string{
+				`States["A"].Type: unsupported state type "Lambda"`,
+			},
+		},
+		"invalid choice rules": {
+			definition: `{
+  "StartAt": "A",
+  "States": {
+    "A": {
+      "Type": "Choice",
+      "Choices": [
+        {"Variable": "$.x", "Next": "B"},
+        {"Variable": "$.x", "StringEquals": "a", "NumericEquals": 1, "Next": "B"},
+        {"Not": {"Variable": "$.x", "IsNull": true, "Next": "B"}, "Next": "B"},
+        {"Variable": "$.x", "IsPresent": true},
+        {"Condition": "{% true %}", "Next": "B"}
+      ],
+      "Default": "Missing"
+    },
+    "B": {"Type": "Succeed"}
+  }
+}`,
+			expected: []string{
+				`States["A"].Choices[0]: exactly one comparison operator or one of And, Not or Or must be specified, found 0`,
+				`States["A"].Choices[1]: exactly one comparison operator or one of And, Not or Or must be specified, found 2`,
+				`States["A"].Choices[2].Not.Next: only supported on top-level Choice Rules`,
+				`States["A"].Choices[3]: Next must be specified`,
+				`States["A"].Choices[4].Condition: not supported when the query language is "JSONPath"`,
+				`States["A"].Choices[4]: exactly one comparison operator or one of And, Not or Or must be specified, found 0`,
+				`States["A"].Default: state "Missing" does not exist`,
+			},
+		},
+		"invalid retry and catch": {
+			definition: `{
+  "StartAt": "A",
+  "States": {
+    "A": {
+      "Type": "Task",
+      "Resource": "arn:aws:states:::lambda:invoke",
+      "Retry": [
+        {"ErrorEquals": ["States.ALL", "States.Timeout"], "IntervalSeconds": 0, "MaxAttempts": -1, "BackoffRate": 0.5},
+        {"ErrorEquals": []}
+      ],
+      "Catch": [
+        {"ErrorEquals": ["States.ALL"]}
+      ],
+      "End": true
+    },
+    "B": {"Type": "Pass", "Retry": [], "End": true}
+  }
+}`,
+			expected: []string{
+				`States["A"].Retry[0].ErrorEquals: "States.ALL" must appear alone`,
+				`States["A"].Retry[0].ErrorEquals: "States.ALL" must appear in the last element`,
+				`States["A"].Retry[0].IntervalSeconds: must be a positive integer`,
+				`States["A"].Retry[0].MaxAttempts: must be a non-negative integer`,
+				`States["A"].Retry[0].BackoffRate: must be greater than or equal to 1.0`,
+				`States["A"].Retry[1].ErrorEquals: must be a non-empty array`,
+				`States["A"].Catch[0]: Next must be specified`,
+				`States["B"].Retry: not supported for Pass states`,
+				`States["B"]: state is unreachable from "A"`,
+			},
+		},
+		"query language fields": {
+			definition: `{
+  "QueryLanguage": "JSONata",
+  "StartAt": "A",
+  "States": {
+    "A": {"Type": "Pass", "InputPath": "$.x", "ResultPath": "$.y", "Next": "B"},
+    "B": {"Type": "Pass", "QueryLanguage": "JSONPath", "End": true}
+  }
+}`,
+			expected: []string{
+				`States["A"].InputPath: not supported when the query language is "JSONata"`,
+				`States["A"].ResultPath: not supported when the query language is "JSONata"`,
+				`States["B"].QueryLanguage: cannot be "JSONPath" when the state machine uses "JSONata"`,
+			},
+		},
+		"JSONPath state with JSONata fields": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Task", "Resource": "arn:aws:states:::lambda:invoke", "Arguments": {}, "End": true}}}`,
+			expected: []string{
+				`States["A"].Arguments: not supported when the query language is "JSONPath"`,
+			},
+		},
+		"nested state machines": {
+			definition: `{
+  "StartAt": "P",
+  "States": {
+    "P": {
+      "Type": "Parallel",
+      "Branches": [
+        {"StartAt": "X", "States": {"X": {"Type": "Pass", "Next": "Done"}}}
+      ],
+      "Next": "M"
+    },
+    "M": {"Type": "Map", "End": true},
+    "Done": {"Type": "Succeed"}
+  }
+}`,
+			expected: []string{
+				`Branches[0].States["X"].Next: state "Done" does not exist`,
+				`States["M"]: ItemProcessor must be specified`,
+				`States["Done"]: state is unreachable from "P"`,
+			},
+		},
+		"wait": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Wait", "Seconds": 1, "TimestampPath": "$.t", "End": true}}}`,
+			expected: []string{
+				`States["A"]: exactly one of Seconds, SecondsPath, Timestamp or TimestampPath must be specified`,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			if err := asl.Validate(test.definition); err != nil {
+				got = strings.Split(err.Error(), "\n")
+			}
+
+			for _, want := range test.expected {
+				if !containsSuffix(got, want) {
+					t.Errorf("expected error %q, got: %s", want, cmp.Diff(got, test.expected))
+				}
+			}
+			if len(got) != len(test.expected) {
+				t.Errorf("unexpected diff (+wanted, -got): %s", cmp.Diff(got, test.expected))
+			}
+		})
+	}
+}
+
+func containsSuffix(s []string, suffix string) bool {
+	for _, v := range s {
+		if strings.HasSuffix(v, suffix) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/sfn/asl"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -65,9 +66,11 @@ func resourceStateMachine() *schema.Resource {
 				Computed: true,
 			},
 			"definition": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringLenBetween(0, 1024*1024), // 1048576
+				Type:                  schema.TypeString,
+				Required:              true,
+				ValidateFunc:          validation.StringLenBetween(0, 1024*1024), // 1048576
+				DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+				DiffSuppressOnRefresh: true,
 			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
@@ -571,11 +574,20 @@ func stateMachineDefinitionValidate(ctx context.Context, d *schema.ResourceDiff,
 	conn := meta.(*conns.AWSClient).SFNClient(ctx)
 
 	if d.HasChange("definition") {
+		if !d.NewValueKnown("definition") {
+			return nil
+		}
+
 		definition := d.Get("definition").(string)
 		if definition == "" {
 			return nil
 		}
 
+		// Catch common mistakes offline before calling the API.
+		if err := asl.Validate(definition); err != nil {
+			return fmt.Errorf("invalid Step Functions State Machine definition: %w", err)
+		}
+
 		input := &sfn.ValidateStateMachineDefinitionInput{
 			Definition: aws.String(definition),
 			Type:       awstypes.StateMachineType(d.Get(names.AttrType).(string)),
@@ -323,6 +323,7 @@ func waitApplicationLayerAutomaticResponseDeleted(ctx context.Context, conn *shi
 }
 
 type applicationLayerAutomaticResponseResourceModel struct {
+	framework.WithAssumeRoleModel
 	Action      fwtypes.StringEnum[applicationLayerAutomaticResponseAction] `tfsdk:"action"`
 	ID          types.String                                                `tfsdk:"id"`
 	ResourceARN fwtypes.ARN                                                 `tfsdk:"resource_arn"`
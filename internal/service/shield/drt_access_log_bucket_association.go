@@ -223,6 +223,7 @@ func findDRTAccess(ctx context.Context, conn *shield.Client) (*shield.DescribeDR
 }
 
 type drtAccessLogBucketAssociationResourceModel struct {
+	framework.WithAssumeRoleModel
 	ID                   types.String   `tfsdk:"id"`
 	LogBucket            types.String   `tfsdk:"log_bucket"`
 	RoleARNAssociationID types.String   `tfsdk:"role_arn_association_id"`
@@ -224,6 +224,7 @@ func findDRTRoleARNAssociation(ctx context.Context, conn *shield.Client, arn str
 }
 
 type drtAccessRoleARNAssociationResourceModel struct {
+	framework.WithAssumeRoleModel
 	ID       types.String   `tfsdk:"id"`
 	RoleARN  fwtypes.ARN    `tfsdk:"role_arn"`
 	Timeouts timeouts.Value `tfsdk:"timeouts"`
@@ -342,6 +342,7 @@ func findSubscription(ctx context.Context, conn *shield.Client) (*awstypes.Subsc
 }
 
 type proactiveEngagementResourceModel struct {
+	framework.WithAssumeRoleModel
 	EmergencyContactList fwtypes.ListNestedObjectValueOf[emergencyContactModel] `tfsdk:"emergency_contact"`
 	Enabled              types.Bool                                             `tfsdk:"enabled"`
 	ID                   types.String                                           `tfsdk:"id"`
@@ -99,6 +99,7 @@ func (d *protectionDataSource) Read(ctx context.Context, req datasource.ReadRequ
 }
 
 type protectionDataSourceModel struct {
+	framework.WithAssumeRoleModel
 	ID            types.String `tfsdk:"id"`
 	Name          types.String `tfsdk:"name"`
 	ProtectionARN types.String `tfsdk:"protection_arn"`
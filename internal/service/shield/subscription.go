@@ -194,6 +194,7 @@ func findSubscriptionByID(ctx context.Context, conn *shield.Client) (*awstypes.S
 }
 
 type subscriptionResourceModel struct {
+	framework.WithAssumeRoleModel
 	AutoRenew   fwtypes.StringEnum[awstypes.AutoRenew] `tfsdk:"auto_renew"`
 	ID          types.String                           `tfsdk:"id"`
 	SkipDestroy types.Bool                             `tfsdk:"skip_destroy"`
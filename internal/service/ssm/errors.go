@@ -4,5 +4,7 @@
 package ssm
 
 const (
-	errCodeValidationException = "ValidationException"
+	errCodeInvalidRequestException   = "InvalidRequestException"
+	errCodeResourceNotFoundException = "ResourceNotFoundException"
+	errCodeValidationException       = "ValidationException"
 )
@@ -0,0 +1,839 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	smtypes "github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_ssm_parameter_sync", name="Parameter Sync")
+func newParameterSyncResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &parameterSyncResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	// See https://docs.aws.amazon.com/systems-manager/latest/APIReference/API_GetParameters.html.
+	getParametersMaxNames = 10
+	// See https://docs.aws.amazon.com/systems-manager/latest/APIReference/API_DeleteParameters.html.
+	deleteParametersMaxNames = 10
+	// See https://docs.aws.amazon.com/secretsmanager/latest/apireference/API_BatchGetSecretValue.html.
+	batchGetSecretValueMaxSecretIDs = 20
+)
+
+type parameterSyncStore string
+
+const (
+	parameterSyncStoreSecretsManager    parameterSyncStore = "SECRETS_MANAGER"
+	parameterSyncStoreSSMParameterStore parameterSyncStore = "SSM_PARAMETER_STORE"
+)
+
+func (parameterSyncStore) Values() []parameterSyncStore {
+	return []parameterSyncStore{
+		parameterSyncStoreSecretsManager,
+		parameterSyncStoreSSMParameterStore,
+	}
+}
+
+type parameterSyncResource struct {
+	framework.ResourceWithModel[parameterSyncResourceModel]
+	framework.WithTimeouts
+}
+
+func (r *parameterSyncResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"document_wo": schema.StringAttribute{
+				Required:  true,
+				WriteOnly: true,
+				Sensitive: true,
+			},
+			names.AttrKMSKeyID: schema.StringAttribute{
+				Optional: true,
+			},
+			"parameters": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"path_prefix": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1024),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"recovery_window_in_days": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(30),
+				Validators: []validator.Int64{
+					int64validator.Any(
+						int64validator.OneOf(0),
+						int64validator.Between(7, 30),
+					),
+				},
+			},
+			"store": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[parameterSyncStore](),
+				Optional:   true,
+				Computed:   true,
+				Default:    stringdefault.StaticString(string(parameterSyncStoreSSMParameterStore)),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ParameterType](),
+				Optional:   true,
+				Computed:   true,
+				Default:    stringdefault.StaticString(string(awstypes.ParameterTypeSecureString)),
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(awstypes.ParameterTypeSecureString), string(awstypes.ParameterTypeString)),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"key_override": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[parameterSyncKeyOverrideModel](ctx),
+				Validators: []validator.List{
+					listvalidator.UniqueValues(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrKey: schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrKMSKeyID: schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrType: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ParameterType](),
+							Optional:   true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *parameterSyncResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Destroy.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, config parameterSyncResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	// Write-only attribute values are only available in configuration.
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if config.DocumentWO.IsUnknown() || plan.PathPrefix.IsUnknown() || plan.Store.IsUnknown() || plan.Type.IsUnknown() || plan.KMSKeyID.IsUnknown() || plan.KeyOverrides.IsUnknown() {
+		return
+	}
+
+	// Compute the desired parameters from the document so that changes to the document's values are planned.
+	entries, d := expandParameterSyncEntries(ctx, plan, config.DocumentWO.ValueString())
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// A new resource's hash key is generated on creation.
+	key, d := parameterSyncHashKey(ctx, request.Private)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if key == nil {
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("parameters"), types.MapUnknown(types.StringType))...)
+		return
+	}
+
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("parameters"), fwflex.FlattenFrameworkStringValueMapLegacy(ctx, parameterSyncHashes(key, entries)))...)
+}
+
+func (r *parameterSyncResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data, config parameterSyncResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	pathPrefix := data.PathPrefix.ValueString()
+	entries, d := expandParameterSyncEntries(ctx, data, config.DocumentWO.ValueString())
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	key, err := newParameterSyncHashKey()
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating SSM Parameter Sync (%s)", pathPrefix), err.Error())
+		return
+	}
+	response.Diagnostics.Append(setParameterSyncHashKey(ctx, response.Private, key)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := checkParameterSyncPlanned(ctx, &data, key, entries); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating SSM Parameter Sync (%s)", pathPrefix), err.Error())
+		return
+	}
+
+	if err := r.putParameterSyncEntries(ctx, data, slices.Collect(maps.Values(entries)), r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating SSM Parameter Sync (%s)", pathPrefix), err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *parameterSyncResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data parameterSyncResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	pathPrefix := data.PathPrefix.ValueString()
+	overrides, d := parameterSyncKeyOverrides(ctx, data)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	parameterNames := slices.Sorted(maps.Keys(fwflex.ExpandFrameworkStringValueMap(ctx, data.Parameters)))
+
+	// Resources created before hashes were keyed have no hash key. Their hashes are recomputed with a new key.
+	key, d := parameterSyncHashKey(ctx, request.Private)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if key == nil {
+		var err error
+		key, err = newParameterSyncHashKey()
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading SSM Parameter Sync (%s)", pathPrefix), err.Error())
+			return
+		}
+		response.Diagnostics.Append(setParameterSyncHashKey(ctx, response.Private, key)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Parameters deleted outside Terraform are removed from state and parameters changed outside Terraform
+	// have their hash updated, so that the next plan writes them again.
+	var (
+		hashes map[string]string
+		err    error
+	)
+	switch data.Store.ValueEnum() {
+	case parameterSyncStoreSecretsManager:
+		hashes, err = findParameterSyncSecretHashes(ctx, r.Meta().SecretsManagerClient(ctx), key, parameterNames, func(name string) string {
+			_, kmsKeyID := parameterSyncKeySettings(data, overrides, parameterSyncKey(pathPrefix, name), false)
+			return kmsKeyID
+		})
+	default:
+		hashes, err = findParameterSyncParameterHashes(ctx, r.Meta().SSMClient(ctx), key, parameterNames, func(name string) string {
+			_, kmsKeyID := parameterSyncKeySettings(data, overrides, parameterSyncKey(pathPrefix, name), false)
+			return kmsKeyID
+		})
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading SSM Parameter Sync (%s)", pathPrefix), err.Error())
+		return
+	}
+
+	data.Parameters = fwflex.FlattenFrameworkStringValueMapLegacy(ctx, hashes)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *parameterSyncResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var plan, state, config parameterSyncResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	pathPrefix := plan.PathPrefix.ValueString()
+	entries, d := expandParameterSyncEntries(ctx, plan, config.DocumentWO.ValueString())
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Resources created before hashes were keyed and not refreshed since have no hash key.
+	// All of their parameters are written.
+	key, d := parameterSyncHashKey(ctx, request.Private)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if key == nil {
+		var err error
+		key, err = newParameterSyncHashKey()
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating SSM Parameter Sync (%s)", pathPrefix), err.Error())
+			return
+		}
+		response.Diagnostics.Append(setParameterSyncHashKey(ctx, response.Private, key)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if err := checkParameterSyncPlanned(ctx, &plan, key, entries); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating SSM Parameter Sync (%s)", pathPrefix), err.Error())
+		return
+	}
+
+	oldHashes := fwflex.ExpandFrameworkStringValueMap(ctx, state.Parameters)
+	var puts []parameterSyncEntry
+	for _, name := range slices.Sorted(maps.Keys(entries)) {
+		if entry := entries[name]; oldHashes[name] != entry.hash(key) {
+			puts = append(puts, entry)
+		}
+	}
+	var deletes []string
+	for _, name := range slices.Sorted(maps.Keys(oldHashes)) {
+		if _, ok := entries[name]; !ok {
+			deletes = append(deletes, name)
+		}
+	}
+
+	timeout := r.UpdateTimeout(ctx, plan.Timeouts)
+
+	if err := r.putParameterSyncEntries(ctx, plan, puts, timeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating SSM Parameter Sync (%s)", pathPrefix), err.Error())
+		return
+	}
+
+	if err := r.deleteParameterSyncEntries(ctx, plan, deletes, timeout); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating SSM Parameter Sync (%s)", pathPrefix), err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *parameterSyncResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data parameterSyncResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	parameterNames := slices.Sorted(maps.Keys(fwflex.ExpandFrameworkStringValueMap(ctx, data.Parameters)))
+
+	if err := r.deleteParameterSyncEntries(ctx, data, parameterNames, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting SSM Parameter Sync (%s)", data.PathPrefix.ValueString()), err.Error())
+		return
+	}
+}
+
+func (r *parameterSyncResource) putParameterSyncEntries(ctx context.Context, data parameterSyncResourceModel, entries []parameterSyncEntry, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for _, entry := range entries {
+		var err error
+
+		switch data.Store.ValueEnum() {
+		case parameterSyncStoreSecretsManager:
+			err = putParameterSyncSecret(ctx, r.Meta().SecretsManagerClient(ctx), entry)
+		default:
+			err = putParameterSyncParameter(ctx, r.Meta().SSMClient(ctx), entry)
+		}
+
+		if err != nil {
+			return fmt.Errorf("writing %s: %w", entry.name, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *parameterSyncResource) deleteParameterSyncEntries(ctx context.Context, data parameterSyncResourceModel, parameterNames []string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	switch data.Store.ValueEnum() {
+	case parameterSyncStoreSecretsManager:
+		conn := r.Meta().SecretsManagerClient(ctx)
+		recoveryWindowInDays := data.RecoveryWindowInDays.ValueInt64()
+
+		for _, name := range parameterNames {
+			if err := deleteParameterSyncSecret(ctx, conn, name, recoveryWindowInDays); err != nil {
+				return fmt.Errorf("deleting %s: %w", name, err)
+			}
+		}
+	default:
+		conn := r.Meta().SSMClient(ctx)
+
+		for chunk := range slices.Chunk(parameterNames, deleteParametersMaxNames) {
+			input := ssm.DeleteParametersInput{
+				Names: chunk,
+			}
+
+			// Parameters that do not exist are returned in InvalidParameters and are ignored.
+			if _, err := conn.DeleteParameters(ctx, &input); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// parameterSyncEntry is a parameter or secret to be written.
+type parameterSyncEntry struct {
+	name     string
+	value    string
+	typ      awstypes.ParameterType
+	kmsKeyID string
+}
+
+func (e parameterSyncEntry) hash(key []byte) string {
+	return parameterSyncHash(key, e.name, string(e.typ), e.kmsKeyID, e.value)
+}
+
+// expandParameterSyncEntries returns the parameters or secrets in the document, by name.
+func expandParameterSyncEntries(ctx context.Context, data parameterSyncResourceModel, document string) (map[string]parameterSyncEntry, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	values, err := parseParameterSyncDocument(document)
+	if err != nil {
+		diags.AddAttributeError(path.Root("document_wo"), "parsing document", err.Error())
+		return nil, diags
+	}
+
+	overrides, d := parameterSyncKeyOverrides(ctx, data)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for key := range overrides {
+		if _, ok := values[key]; !ok {
+			diags.AddAttributeError(path.Root("key_override"), "Invalid Key Override", fmt.Sprintf("key %s is not in the document", key))
+		}
+	}
+
+	isSecretsManager := data.Store.ValueEnum() == parameterSyncStoreSecretsManager
+	if isSecretsManager {
+		for key, override := range overrides {
+			if !override.Type.IsNull() {
+				diags.AddAttributeError(path.Root("key_override"), "Invalid Key Override", fmt.Sprintf("type cannot be set for key %s when store is %s", key, parameterSyncStoreSecretsManager))
+			}
+		}
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	pathPrefix := data.PathPrefix.ValueString()
+	entries := make(map[string]parameterSyncEntry, len(values))
+	for key, v := range values {
+		typ, kmsKeyID := parameterSyncKeySettings(data, overrides, key, v.list)
+
+		if !isSecretsManager && v.list != (typ == awstypes.ParameterTypeStringList) {
+			diags.AddAttributeError(path.Root("document_wo"), "Invalid Document Value", fmt.Sprintf("key %s: only list values can be stored as %s", key, awstypes.ParameterTypeStringList))
+			continue
+		}
+
+		name := parameterSyncName(pathPrefix, key)
+		entries[name] = parameterSyncEntry{
+			name:     name,
+			value:    v.value,
+			typ:      typ,
+			kmsKeyID: kmsKeyID,
+		}
+	}
+
+	return entries, diags
+}
+
+func parameterSyncKeyOverrides(ctx context.Context, data parameterSyncResourceModel) (map[string]*parameterSyncKeyOverrideModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	overrides, d := data.KeyOverrides.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	m := make(map[string]*parameterSyncKeyOverrideModel, len(overrides))
+	for _, override := range overrides {
+		key := override.Key.ValueString()
+		if _, ok := m[key]; ok {
+			diags.AddAttributeError(path.Root("key_override"), "Invalid Key Override", fmt.Sprintf("duplicate key: %s", key))
+			return nil, diags
+		}
+
+		m[key] = override
+	}
+
+	return m, diags
+}
+
+// parameterSyncKeySettings returns the parameter type and KMS key for a document key.
+// Secrets Manager secrets have no type.
+func parameterSyncKeySettings(data parameterSyncResourceModel, overrides map[string]*parameterSyncKeyOverrideModel, key string, list bool) (awstypes.ParameterType, string) {
+	typ, kmsKeyID := data.Type.ValueEnum(), data.KMSKeyID.ValueString()
+	if list {
+		typ = awstypes.ParameterTypeStringList
+	}
+
+	if override, ok := overrides[key]; ok {
+		if !override.Type.IsNull() {
+			typ = override.Type.ValueEnum()
+		}
+		if !override.KMSKeyID.IsNull() {
+			kmsKeyID = override.KMSKeyID.ValueString()
+		}
+	}
+
+	if data.Store.ValueEnum() == parameterSyncStoreSecretsManager {
+		return "", kmsKeyID
+	}
+
+	// Only SecureString parameters are encrypted.
+	if typ != awstypes.ParameterTypeSecureString {
+		kmsKeyID = ""
+	}
+
+	return typ, kmsKeyID
+}
+
+func parameterSyncHashes(key []byte, entries map[string]parameterSyncEntry) map[string]string {
+	hashes := make(map[string]string, len(entries))
+
+	for name, entry := range entries {
+		hashes[name] = entry.hash(key)
+	}
+
+	return hashes
+}
+
+const (
+	// parameterSyncHashKeyPrivateStateKey is the private state key of the resource's hash key.
+	parameterSyncHashKeyPrivateStateKey = "hash_key"
+	parameterSyncHashKeySize            = 32
+)
+
+type privateState interface {
+	GetKey(context.Context, string) ([]byte, diag.Diagnostics)
+	SetKey(context.Context, string, []byte) diag.Diagnostics
+}
+
+// newParameterSyncHashKey returns a new random hash key.
+func newParameterSyncHashKey() ([]byte, error) {
+	key := make([]byte, parameterSyncHashKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("generating hash key: %w", err)
+	}
+
+	return key, nil
+}
+
+// parameterSyncHashKey returns the resource's hash key from private state, or nil if it has none.
+func parameterSyncHashKey(ctx context.Context, private privateState) ([]byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if private == nil {
+		return nil, diags
+	}
+
+	v, d := private.GetKey(ctx, parameterSyncHashKeyPrivateStateKey)
+	diags.Append(d...)
+	if diags.HasError() || len(v) == 0 {
+		return nil, diags
+	}
+
+	// Private state values are JSON.
+	var s string
+	if err := json.Unmarshal(v, &s); err != nil {
+		diags.AddError("reading hash key", err.Error())
+		return nil, diags
+	}
+
+	key, err := hex.DecodeString(s)
+	if err != nil {
+		diags.AddError("reading hash key", err.Error())
+		return nil, diags
+	}
+
+	return key, diags
+}
+
+func setParameterSyncHashKey(ctx context.Context, private privateState, key []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	v, err := json.Marshal(hex.EncodeToString(key))
+	if err != nil {
+		diags.AddError("writing hash key", err.Error())
+		return diags
+	}
+
+	return private.SetKey(ctx, parameterSyncHashKeyPrivateStateKey, v)
+}
+
+// checkParameterSyncPlanned returns an error if the entries differ from the planned entries, i.e. the document changed after planning.
+// Entries not known at plan time are set from the document.
+func checkParameterSyncPlanned(ctx context.Context, data *parameterSyncResourceModel, key []byte, entries map[string]parameterSyncEntry) error {
+	hashes := parameterSyncHashes(key, entries)
+
+	if data.Parameters.IsUnknown() {
+		data.Parameters = fwflex.FlattenFrameworkStringValueMapLegacy(ctx, hashes)
+
+		return nil
+	}
+
+	if !maps.Equal(fwflex.ExpandFrameworkStringValueMap(ctx, data.Parameters), hashes) {
+		return fmt.Errorf("document_wo changed after planning")
+	}
+
+	return nil
+}
+
+func putParameterSyncParameter(ctx context.Context, conn *ssm.Client, entry parameterSyncEntry) error {
+	input := ssm.PutParameterInput{
+		Name:      aws.String(entry.name),
+		Overwrite: aws.Bool(true),
+		Type:      entry.typ,
+		Value:     aws.String(entry.value),
+	}
+
+	if entry.kmsKeyID != "" {
+		input.KeyId = aws.String(entry.kmsKeyID)
+	}
+
+	_, err := conn.PutParameter(ctx, &input)
+
+	return err
+}
+
+// findParameterSyncParameterHashes returns the hashes of the values of the named parameters that exist.
+func findParameterSyncParameterHashes(ctx context.Context, conn *ssm.Client, key []byte, parameterNames []string, kmsKeyID func(string) string) (map[string]string, error) {
+	hashes := make(map[string]string, len(parameterNames))
+
+	for chunk := range slices.Chunk(parameterNames, getParametersMaxNames) {
+		input := ssm.GetParametersInput{
+			Names:          chunk,
+			WithDecryption: aws.Bool(true),
+		}
+
+		output, err := conn.GetParameters(ctx, &input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// Parameters that do not exist are returned in InvalidParameters.
+		for _, v := range output.Parameters {
+			name, typ := aws.ToString(v.Name), v.Type
+			var k string
+			if typ == awstypes.ParameterTypeSecureString {
+				k = kmsKeyID(name)
+			}
+
+			hashes[name] = parameterSyncHash(key, name, string(typ), k, aws.ToString(v.Value))
+		}
+	}
+
+	return hashes, nil
+}
+
+func putParameterSyncSecret(ctx context.Context, conn *secretsmanager.Client, entry parameterSyncEntry) error {
+	updateInput := secretsmanager.UpdateSecretInput{
+		SecretId:     aws.String(entry.name),
+		SecretString: aws.String(entry.value),
+	}
+
+	if entry.kmsKeyID != "" {
+		updateInput.KmsKeyId = aws.String(entry.kmsKeyID)
+	}
+
+	_, err := conn.UpdateSecret(ctx, &updateInput)
+
+	// A secret removed from the document and added back again may still be scheduled for deletion.
+	if errs.IsAErrorMessageContains[*smtypes.InvalidRequestException](err, "because it was marked for deletion") {
+		input := secretsmanager.RestoreSecretInput{
+			SecretId: aws.String(entry.name),
+		}
+
+		if _, err := conn.RestoreSecret(ctx, &input); err != nil {
+			return err
+		}
+
+		_, err = conn.UpdateSecret(ctx, &updateInput)
+
+		return err
+	}
+
+	if !errs.IsA[*smtypes.ResourceNotFoundException](err) {
+		return err
+	}
+
+	createInput := secretsmanager.CreateSecretInput{
+		Name:         aws.String(entry.name),
+		SecretString: aws.String(entry.value),
+	}
+
+	if entry.kmsKeyID != "" {
+		createInput.KmsKeyId = aws.String(entry.kmsKeyID)
+	}
+
+	// Retry for secret recreation after deletion without recovery.
+	_, err = tfresource.RetryWhenIsAErrorMessageContains[any, *smtypes.InvalidRequestException](ctx, propagationTimeout, func(ctx context.Context) (any, error) {
+		return conn.CreateSecret(ctx, &createInput)
+	}, "was deleted")
+
+	return err
+}
+
+func deleteParameterSyncSecret(ctx context.Context, conn *secretsmanager.Client, name string, recoveryWindowInDays int64) error {
+	input := secretsmanager.DeleteSecretInput{
+		SecretId: aws.String(name),
+	}
+
+	if recoveryWindowInDays == 0 {
+		input.ForceDeleteWithoutRecovery = aws.Bool(true)
+	} else {
+		input.RecoveryWindowInDays = aws.Int64(recoveryWindowInDays)
+	}
+
+	_, err := conn.DeleteSecret(ctx, &input)
+
+	if errs.IsA[*smtypes.ResourceNotFoundException](err) || errs.IsAErrorMessageContains[*smtypes.InvalidRequestException](err, "because it was marked for deletion") {
+		return nil
+	}
+
+	return err
+}
+
+// findParameterSyncSecretHashes returns the hashes of the values of the named secrets that exist and are not scheduled for deletion.
+func findParameterSyncSecretHashes(ctx context.Context, conn *secretsmanager.Client, key []byte, parameterNames []string, kmsKeyID func(string) string) (map[string]string, error) {
+	hashes := make(map[string]string, len(parameterNames))
+
+	for chunk := range slices.Chunk(parameterNames, batchGetSecretValueMaxSecretIDs) {
+		input := secretsmanager.BatchGetSecretValueInput{
+			SecretIdList: chunk,
+		}
+
+		pages := secretsmanager.NewBatchGetSecretValuePaginator(conn, &input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, v := range page.Errors {
+				switch code := aws.ToString(v.ErrorCode); code {
+				case errCodeResourceNotFoundException, errCodeInvalidRequestException:
+				default:
+					return nil, fmt.Errorf("%s: %s: %s", aws.ToString(v.SecretId), code, aws.ToString(v.Message))
+				}
+			}
+
+			for _, v := range page.SecretValues {
+				name := aws.ToString(v.Name)
+				hashes[name] = parameterSyncHash(key, name, "", kmsKeyID(name), aws.ToString(v.SecretString))
+			}
+		}
+	}
+
+	return hashes, nil
+}
+
+type parameterSyncResourceModel struct {
+	framework.WithRegionModel
+	DocumentWO           types.String                                                   `tfsdk:"document_wo"`
+	KeyOverrides         fwtypes.ListNestedObjectValueOf[parameterSyncKeyOverrideModel] `tfsdk:"key_override"`
+	KMSKeyID             types.String                                                   `tfsdk:"kms_key_id"`
+	Parameters           types.Map                                                      `tfsdk:"parameters"`
+	PathPrefix           types.String                                                   `tfsdk:"path_prefix"`
+	RecoveryWindowInDays types.Int64                                                    `tfsdk:"recovery_window_in_days"`
+	Store                fwtypes.StringEnum[parameterSyncStore]                         `tfsdk:"store"`
+	Timeouts             timeouts.Value                                                 `tfsdk:"timeouts"`
+	Type                 fwtypes.StringEnum[awstypes.ParameterType]                     `tfsdk:"type"`
+}
+
+type parameterSyncKeyOverrideModel struct {
+	Key      types.String                               `tfsdk:"key"`
+	KMSKeyID types.String                               `tfsdk:"kms_key_id"`
+	Type     fwtypes.StringEnum[awstypes.ParameterType] `tfsdk:"type"`
+}
@@ -0,0 +1,141 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	tfyaml "github.com/hashicorp/terraform-provider-aws/internal/yaml"
+)
+
+const (
+	parameterSyncKeySeparator       = "/"
+	parameterSyncListValueSeparator = ","
+)
+
+// parameterSyncValue is a value in a parameter sync document.
+type parameterSyncValue struct {
+	value string
+	// list is true if the value is a list of scalars, stored comma-separated.
+	list bool
+}
+
+// parseParameterSyncDocument parses a JSON or YAML document of key/value pairs.
+// Nested objects are flattened, with keys joined by "/", e.g. `{"db": {"host": "x"}}` has the key `db/host`.
+// Scalar values are converted to strings and lists of scalars are joined by ",".
+func parseParameterSyncDocument(document string) (map[string]parameterSyncValue, error) {
+	var v any
+	if err := tfyaml.DecodeFromString(document, &v); err != nil {
+		return nil, err
+	}
+
+	tfMap, ok := v.(map[string]any)
+	if !ok {
+		return nil, errors.New("document is not an object")
+	}
+
+	values := make(map[string]parameterSyncValue)
+	if err := flattenParameterSyncDocument(values, "", tfMap); err != nil {
+		return nil, err
+	}
+
+	if len(values) == 0 {
+		return nil, errors.New("document contains no values")
+	}
+
+	return values, nil
+}
+
+func flattenParameterSyncDocument(values map[string]parameterSyncValue, prefix string, tfMap map[string]any) error {
+	for _, k := range slices.Sorted(maps.Keys(tfMap)) {
+		if k == "" || strings.Contains(k, parameterSyncKeySeparator) {
+			return fmt.Errorf("invalid key %q in %q: keys must be non-empty and must not contain %q", k, prefix, parameterSyncKeySeparator)
+		}
+
+		key := k
+		if prefix != "" {
+			key = prefix + parameterSyncKeySeparator + k
+		}
+
+		switch v := tfMap[k].(type) {
+		case map[string]any:
+			if err := flattenParameterSyncDocument(values, key, v); err != nil {
+				return err
+			}
+		case []any:
+			elems := make([]string, 0, len(v))
+			for i, v := range v {
+				elem, err := parameterSyncScalarValue(v)
+				if err != nil {
+					return fmt.Errorf("%s[%d]: %w", key, i, err)
+				}
+
+				elems = append(elems, elem)
+			}
+
+			values[key] = parameterSyncValue{value: strings.Join(elems, parameterSyncListValueSeparator), list: true}
+		default:
+			value, err := parameterSyncScalarValue(v)
+			if err != nil {
+				return fmt.Errorf("%s: %w", key, err)
+			}
+
+			values[key] = parameterSyncValue{value: value}
+		}
+	}
+
+	return nil
+}
+
+func parameterSyncScalarValue(v any) (string, error) {
+	switch v := v.(type) {
+	case string:
+		if v == "" {
+			return "", errors.New("empty value")
+		}
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case int, int64, uint64:
+		return fmt.Sprint(v), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case nil:
+		return "", errors.New("null value")
+	default:
+		return "", fmt.Errorf("unsupported value type: %T", v)
+	}
+}
+
+// parameterSyncName returns the full parameter or secret name of a document key.
+func parameterSyncName(pathPrefix, key string) string {
+	return strings.TrimSuffix(pathPrefix, parameterSyncKeySeparator) + parameterSyncKeySeparator + key
+}
+
+// parameterSyncKey returns the document key of a full parameter or secret name.
+func parameterSyncKey(pathPrefix, name string) string {
+	return strings.TrimPrefix(name, strings.TrimSuffix(pathPrefix, parameterSyncKeySeparator)+parameterSyncKeySeparator)
+}
+
+// parameterSyncHash returns the hash persisted in state for a parameter or secret.
+// The hash is an HMAC keyed with the resource's own random key, so that hashes cannot be compared across resources
+// or checked against precomputed hashes of common values.
+// The name is included so that equal values under different names do not have the same hash.
+func parameterSyncHash(key []byte, name, typ, kmsKeyID, value string) string {
+	h := hmac.New(sha256.New, key)
+	for _, v := range []string{name, typ, kmsKeyID, value} {
+		h.Write([]byte(v))
+		h.Write([]byte{0})
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,129 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseParameterSyncDocument(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		document      string
+		expected      map[string]parameterSyncValue
+		expectedError bool
+	}{
+		"JSON": {
+			document: `{"db": {"host": "db.example.com", "port": 5432}, "debug": false, "ratio": 0.25}`,
+			expected: map[string]parameterSyncValue{
+				"db/host": {value: "db.example.com"},
+				"db/port": {value: "5432"},
+				"debug":   {value: "false"},
+				"ratio":   {value: "0.25"},
+			},
+		},
+		"YAML": {
+			document: `
+api:
+  key: s3cr3t
+  hosts:
+    - a.example.com
+    - b.example.com
+offset: -1
+`,
+			expected: map[string]parameterSyncValue{
+				"api/key":   {value: "s3cr3t"},
+				"api/hosts": {value: "a.example.com,b.example.com", list: true},
+				"offset":    {value: "-1"},
+			},
+		},
+		"not an object": {
+			document:      `["a", "b"]`,
+			expectedError: true,
+		},
+		"empty": {
+			document:      `{"a": {}}`,
+			expectedError: true,
+		},
+		"null value": {
+			document:      `{"a": null}`,
+			expectedError: true,
+		},
+		"empty value": {
+			document:      `{"a": ""}`,
+			expectedError: true,
+		},
+		"key with separator": {
+			document:      `{"a/b": "c"}`,
+			expectedError: true,
+		},
+		"nested list": {
+			document:      `{"a": [["b"]]}`,
+			expectedError: true,
+		},
+		"invalid": {
+			document:      `{"a": `,
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseParameterSyncDocument(testCase.document)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("parseParameterSyncDocument() err %t, want %t: %v", got, want, err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected, cmp.AllowUnexported(parameterSyncValue{})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestParameterSyncName(t *testing.T) {
+	t.Parallel()
+
+	for _, pathPrefix := range []string{"/app/prod", "/app/prod/"} {
+		name := parameterSyncName(pathPrefix, "db/host")
+
+		if got, want := name, "/app/prod/db/host"; got != want {
+			t.Errorf("parameterSyncName(%q) = %q, want %q", pathPrefix, got, want)
+		}
+
+		if got, want := parameterSyncKey(pathPrefix, name), "db/host"; got != want {
+			t.Errorf("parameterSyncKey(%q) = %q, want %q", pathPrefix, got, want)
+		}
+	}
+}
+
+func TestParameterSyncHash(t *testing.T) {
+	t.Parallel()
+
+	key := []byte("key")
+	hash := parameterSyncHash(key, "/app/a", "SecureString", "", "value")
+
+	if got := parameterSyncHash(key, "/app/a", "SecureString", "", "value"); got != hash {
+		t.Errorf("parameterSyncHash() is not stable: %s, %s", got, hash)
+	}
+
+	for _, got := range []string{
+		parameterSyncHash([]byte("other key"), "/app/a", "SecureString", "", "value"),
+		parameterSyncHash(key, "/app/b", "SecureString", "", "value"),
+		parameterSyncHash(key, "/app/a", "String", "", "value"),
+		parameterSyncHash(key, "/app/a", "SecureString", "alias/key", "value"),
+		parameterSyncHash(key, "/app/a", "SecureString", "", "value2"),
+		parameterSyncHash(key, "/app/a", "SecureStrin", "g", "value"),
+	} {
+		if got == hash {
+			t.Errorf("parameterSyncHash() collision: %s", got)
+		}
+	}
+}
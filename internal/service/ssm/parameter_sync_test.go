@@ -0,0 +1,357 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package ssm_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	secretsmanagertypes "github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/hashicorp/go-version"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfssm "github.com/hashicorp/terraform-provider-aws/internal/service/ssm"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSSMParameterSync_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameter_sync.test"
+	pathPrefix := "/" + rName
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.SSMServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParameterSyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterSyncConfig_basic(pathPrefix, `{"db": {"host": "db.example.com", "port": 5432}, "hosts": ["a", "b"]}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckParameterSyncExists(ctx, resourceName),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("document_wo"), knownvalue.Null()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("parameters"), knownvalue.MapSizeExact(3)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("parameters").AtMapKey(pathPrefix+"/db/host"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("parameters").AtMapKey(pathPrefix+"/db/port"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("parameters").AtMapKey(pathPrefix+"/hosts"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("path_prefix"), knownvalue.StringExact(pathPrefix)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("store"), knownvalue.StringExact("SSM_PARAMETER_STORE")),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrType), knownvalue.StringExact("SecureString")),
+				},
+			},
+		},
+	})
+}
+
+func TestAccSSMParameterSync_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameter_sync.test"
+	pathPrefix := "/" + rName
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.SSMServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParameterSyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterSyncConfig_basic(pathPrefix, `{"key1": "value1", "key2": "value2"}`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterSyncExists(ctx, resourceName),
+					testAccCheckParameterSyncDisappears(ctx, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSSMParameterSync_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameter_sync.test"
+	pathPrefix := "/" + rName
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.SSMServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParameterSyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterSyncConfig_basic(pathPrefix, `{"key1": "value1", "key2": "value2"}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckParameterSyncExists(ctx, resourceName),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("parameters"), knownvalue.MapSizeExact(2)),
+				},
+			},
+			{
+				Config: testAccParameterSyncConfig_basic(pathPrefix, `{"key1": "value1-updated", "key3": "value3"}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckParameterSyncExists(ctx, resourceName),
+					testAccCheckParameterSyncParameterNotExists(ctx, pathPrefix+"/key2"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("parameters"), knownvalue.MapSizeExact(2)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("parameters").AtMapKey(pathPrefix+"/key1"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("parameters").AtMapKey(pathPrefix+"/key3"), knownvalue.NotNull()),
+				},
+			},
+			{
+				Config: testAccParameterSyncConfig_keyOverride(pathPrefix, `{"key1": "value1-updated", "key3": "value3"}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckParameterSyncExists(ctx, resourceName),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("key_override"), knownvalue.ListSizeExact(1)),
+				},
+			},
+		},
+	})
+}
+
+func TestAccSSMParameterSync_secretsManager(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameter_sync.test"
+	pathPrefix := rName
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.SSMServiceID, names.SecretsManagerServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckParameterSyncDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterSyncConfig_secretsManager(pathPrefix, `{"username": "admin", "password": "not-a-real-password"}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckParameterSyncExists(ctx, resourceName),
+				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("parameters"), knownvalue.MapSizeExact(2)),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("parameters").AtMapKey(pathPrefix+"/username"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New("store"), knownvalue.StringExact("SECRETS_MANAGER")),
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckParameterSyncDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ssm_parameter_sync" {
+				continue
+			}
+
+			for _, name := range testAccParameterSyncNames(rs) {
+				exists, err := testAccParameterSyncEntryExists(ctx, rs, name)
+
+				if err != nil {
+					return err
+				}
+
+				if exists {
+					return fmt.Errorf("SSM Parameter Sync (%s) entry %s still exists", rs.Primary.Attributes["path_prefix"], name)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckParameterSyncExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		for _, name := range testAccParameterSyncNames(rs) {
+			exists, err := testAccParameterSyncEntryExists(ctx, rs, name)
+
+			if err != nil {
+				return err
+			}
+
+			if !exists {
+				return fmt.Errorf("SSM Parameter Sync (%s) entry %s not found", rs.Primary.Attributes["path_prefix"], name)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckParameterSyncParameterNotExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		_, err := tfssm.FindParameterByName(ctx, conn, name, false)
+
+		if retry.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SSM Parameter %s still exists", name)
+	}
+}
+
+// testAccCheckParameterSyncDisappears deletes the synced parameters out of band.
+func testAccCheckParameterSyncDisappears(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+		input := ssm.DeleteParametersInput{
+			Names: testAccParameterSyncNames(rs),
+		}
+
+		_, err := conn.DeleteParameters(ctx, &input)
+
+		return err
+	}
+}
+
+// testAccParameterSyncEntryExists reports whether the named parameter or secret exists.
+// Secrets scheduled for deletion do not exist.
+func testAccParameterSyncEntryExists(ctx context.Context, rs *terraform.ResourceState, name string) (bool, error) {
+	if rs.Primary.Attributes["store"] == "SECRETS_MANAGER" {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecretsManagerClient(ctx)
+
+		input := secretsmanager.DescribeSecretInput{
+			SecretId: aws.String(name),
+		}
+
+		output, err := conn.DescribeSecret(ctx, &input)
+
+		if errs.IsA[*secretsmanagertypes.ResourceNotFoundException](err) {
+			return false, nil
+		}
+
+		if err != nil {
+			return false, err
+		}
+
+		return output.DeletedDate == nil, nil
+	}
+
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SSMClient(ctx)
+
+	_, err := tfssm.FindParameterByName(ctx, conn, name, false)
+
+	if retry.NotFound(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// testAccParameterSyncNames returns the parameter or secret names recorded in the resource's `parameters` attribute.
+func testAccParameterSyncNames(rs *terraform.ResourceState) []string {
+	var parameterNames []string
+
+	for k := range rs.Primary.Attributes {
+		if name, ok := strings.CutPrefix(k, "parameters."); ok && name != "%" {
+			parameterNames = append(parameterNames, name)
+		}
+	}
+
+	return parameterNames
+}
+
+func testAccParameterSyncConfig_basic(pathPrefix, document string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter_sync" "test" {
+  path_prefix = %[1]q
+  document_wo = %[2]q
+}
+`, pathPrefix, document)
+}
+
+func testAccParameterSyncConfig_keyOverride(pathPrefix, document string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter_sync" "test" {
+  path_prefix = %[1]q
+  document_wo = %[2]q
+
+  key_override {
+    key  = "key3"
+    type = "String"
+  }
+}
+`, pathPrefix, document)
+}
+
+func testAccParameterSyncConfig_secretsManager(pathPrefix, document string) string {
+	return fmt.Sprintf(`
+resource "aws_ssm_parameter_sync" "test" {
+  path_prefix             = %[1]q
+  document_wo             = %[2]q
+  store                   = "SECRETS_MANAGER"
+  recovery_window_in_days = 0
+}
+`, pathPrefix, document)
+}
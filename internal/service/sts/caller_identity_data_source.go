@@ -93,6 +93,7 @@ func findCallerIdentity(ctx context.Context, conn *sts.Client) (*sts.GetCallerId
 }
 
 type callerIdentityDataSourceModel struct {
+	framework.WithAssumeRoleModel
 	AccountID types.String `tfsdk:"account_id"`
 	ARN       types.String `tfsdk:"arn"`
 	ID        types.String `tfsdk:"id"`
@@ -0,0 +1,14 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package yaml
+
+import (
+	yaml "github.com/goccy/go-yaml"
+)
+
+// ToJSON converts the given byte slice, containing valid YAML, to JSON.
+// Mapping key order is preserved.
+func ToJSON(b []byte) ([]byte, error) {
+	return yaml.YAMLToJSON(b)
+}
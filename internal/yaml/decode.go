@@ -5,10 +5,13 @@ package yaml
 
 import (
 	"bytes"
+	"fmt"
 	"io"
 	"strings"
 
 	yaml "github.com/goccy/go-yaml"
+	"github.com/goccy/go-yaml/ast"
+	"github.com/goccy/go-yaml/parser"
 )
 
 // DecodeFromBytes decodes (unmarshals) the given byte slice, containing valid YAML, into `to`.
@@ -35,3 +38,89 @@ func DecodeFromReader(r io.Reader, to any) error {
 func DecodeFromString(s string, to any) error {
 	return DecodeFromReader(strings.NewReader(s), to)
 }
+
+// DecodeFromStringWithTags decodes (unmarshals) the given string, containing a single YAML document, into a generic value.
+// goccy/go-yaml discards local tags such as `!Ref`, so each value with a local tag is instead passed, decoded, to tagFunc
+// and replaced by its result.
+func DecodeFromStringWithTags(s string, tagFunc func(tag string, v any) (any, error)) (any, error) {
+	f, err := parser.ParseBytes([]byte(s), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(f.Docs) == 0 || f.Docs[0].Body == nil {
+		return nil, nil
+	}
+
+	return decodeNodeWithTags(f.Docs[0].Body, tagFunc)
+}
+
+func decodeNodeWithTags(node ast.Node, tagFunc func(tag string, v any) (any, error)) (any, error) {
+	switch node := node.(type) {
+	case nil:
+		return nil, nil
+	case *ast.AnchorNode:
+		return decodeNodeWithTags(node.Value, tagFunc)
+	case *ast.TagNode:
+		// Standard tags, e.g. `!!str`, are handled by the decoder.
+		if tag := node.Start.Value; !strings.HasPrefix(tag, "!!") {
+			v, err := decodeNodeWithTags(node.Value, tagFunc)
+			if err != nil {
+				return nil, err
+			}
+
+			return tagFunc(tag, v)
+		}
+	case *ast.MappingNode:
+		m := make(map[string]any, len(node.Values))
+		for _, v := range node.Values {
+			if err := decodeMappingValueWithTags(m, v, tagFunc); err != nil {
+				return nil, err
+			}
+		}
+
+		return m, nil
+	case *ast.MappingValueNode:
+		m := make(map[string]any, 1)
+		if err := decodeMappingValueWithTags(m, node, tagFunc); err != nil {
+			return nil, err
+		}
+
+		return m, nil
+	case *ast.SequenceNode:
+		s := make([]any, 0, len(node.Values))
+		for _, v := range node.Values {
+			v, err := decodeNodeWithTags(v, tagFunc)
+			if err != nil {
+				return nil, err
+			}
+
+			s = append(s, v)
+		}
+
+		return s, nil
+	}
+
+	var v any
+	if err := yaml.NodeToValue(node, &v); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+func decodeMappingValueWithTags(m map[string]any, node *ast.MappingValueNode, tagFunc func(tag string, v any) (any, error)) error {
+	var k any
+	if err := yaml.NodeToValue(node.Key, &k); err != nil {
+		return err
+	}
+
+	v, err := decodeNodeWithTags(node.Value, tagFunc)
+	if err != nil {
+		return err
+	}
+
+	m[fmt.Sprint(k)] = v
+
+	return nil
+}
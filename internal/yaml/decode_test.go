@@ -82,3 +82,82 @@ B: 42
 		})
 	}
 }
+
+func TestDecodeFromStringWithTags(t *testing.T) {
+	t.Parallel()
+
+	tagFunc := func(tag string, v any) (any, error) {
+		return map[string]any{tag: v}, nil
+	}
+
+	testCases := []struct {
+		testName   string
+		input      string
+		wantOutput any
+		wantErr    bool
+	}{
+		{
+			testName: "empty YAML",
+			input:    ``,
+		},
+		{
+			testName: "bad YAML",
+			input:    "a: [",
+			wantErr:  true,
+		},
+		{
+			testName: "no tags",
+			input: `
+A: test1
+B: 42
+C: [true, null]
+`,
+			wantOutput: map[string]any{
+				"A": "test1",
+				"B": uint64(42),
+				"C": []any{true, nil},
+			},
+		},
+		{
+			testName: "local tags",
+			input: `
+A: !Ref test1
+B: !If
+  - test2
+  - !GetAtt test3.Arn
+  - C: !Base64 {D: test4}
+`,
+			wantOutput: map[string]any{
+				"A": map[string]any{"!Ref": "test1"},
+				"B": map[string]any{"!If": []any{
+					"test2",
+					map[string]any{"!GetAtt": "test3.Arn"},
+					map[string]any{"C": map[string]any{"!Base64": map[string]any{"D": "test4"}}},
+				}},
+			},
+		},
+		{
+			testName: "standard tags",
+			input:    `A: !!str 42`,
+			wantOutput: map[string]any{
+				"A": "42",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := yaml.DecodeFromStringWithTags(testCase.input, tagFunc)
+			if got, want := err != nil, testCase.wantErr; !cmp.Equal(got, want) {
+				t.Errorf("DecodeFromStringWithTags(%s) err %t, want %t", testCase.input, got, want)
+			}
+			if err == nil {
+				if diff := cmp.Diff(got, testCase.wantOutput); diff != "" {
+					t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package yaml
+
+import (
+	yaml "github.com/goccy/go-yaml"
+)
+
+// EncodeToString encodes (marshals) the given value to a YAML string.
+// Struct fields are encoded in declaration order.
+func EncodeToString(v any) (string, error) {
+	b, err := yaml.MarshalWithOptions(v, yaml.IndentSequence(true))
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package yaml_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/yaml"
+)
+
+func TestEncodeToString(t *testing.T) {
+	t.Parallel()
+
+	type nested struct {
+		A bool   `yaml:"a"`
+		B string `yaml:"b,omitempty"`
+	}
+	type from struct {
+		Z string   `yaml:"z"`
+		A []nested `yaml:"a"`
+		M []string `yaml:"m,omitempty"`
+	}
+
+	testCases := []struct {
+		testName   string
+		input      any
+		wantOutput string
+	}{
+		{
+			testName:   "empty",
+			input:      from{},
+			wantOutput: "z: \"\"\na: []\n",
+		},
+		{
+			testName: "field order",
+			input: from{
+				Z: "test1",
+				A: []nested{{A: true, B: "test2"}, {}},
+				M: []string{"test3"},
+			},
+			wantOutput: "z: test1\na:\n  - a: true\n    b: test2\n  - a: false\nm:\n  - test3\n",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := yaml.EncodeToString(testCase.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.wantOutput {
+				t.Errorf("got %q, want %q", got, testCase.wantOutput)
+			}
+		})
+	}
+}
@@ -304,3 +304,13 @@ const (
 
 	topLevelRegionDefaultDescription = `Defaults to the Region set in the [provider configuration](https://registry.terraform.io/providers/hashicorp/aws/latest/docs#aws-configuration-reference).`
 )
+
+const (
+	TopLevelAssumeRoleAttribute            = "assume_role"
+	TopLevelAssumeRoleSessionNameAttribute = "session_name"
+
+	TopLevelAssumeRoleAttributeDescription            = `IAM role to assume when managing this resource. Defaults to the credentials set in the [provider configuration](https://registry.terraform.io/providers/hashicorp/aws/latest/docs#aws-configuration-reference).`
+	TopLevelAssumeRoleExternalIDAttributeDescription  = `External identifier to use when assuming the role.`
+	TopLevelAssumeRoleRoleARNAttributeDescription     = `ARN of the IAM role to assume.`
+	TopLevelAssumeRoleSessionNameAttributeDescription = `Session name to use when assuming the role.`
+)
@@ -0,0 +1,102 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package action
+
+import (
+	"go/format"
+	"strings"
+	"testing"
+)
+
+func testTemplateData(comments bool) TemplateData {
+	return TemplateData{
+		Action:               "StartBuild",
+		ActionLower:          "startbuild",
+		ActionLowerCamel:     "startBuild",
+		ActionSnake:          "start_build",
+		IncludeComments:      comments,
+		HumanFriendlyService: "CodeBuild",
+		SDKPackage:           "codebuild",
+		ServicePackage:       "codebuild",
+		Service:              "CodeBuild",
+		ServiceLower:         "codebuild",
+		AWSServiceName:       "AWS CodeBuild",
+		HumanActionName:      "Start Build",
+		ProviderResourceName: "aws_codebuild_start_build",
+	}
+}
+
+func TestRenderTemplate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		Template string
+		GoSource bool
+		Contains []string
+	}{
+		{
+			TestName: "action",
+			Template: actionTmpl,
+			GoSource: true,
+			Contains: []string{
+				"// @Action(aws_codebuild_start_build, name=\"Start Build\")",
+				"func newStartBuildAction(_ context.Context) (action.ActionWithConfigure, error) {",
+				"framework.ActionWithModel[startBuildActionModel]",
+				"framework.WithRegionModel",
+				"actionwait.WaitForStatus(",
+				"resp.SendProgress(action.InvokeProgressEvent{",
+			},
+		},
+		{
+			TestName: "action test",
+			Template: actionTestTmpl,
+			GoSource: true,
+			Contains: []string{
+				"package codebuild_test",
+				"func TestAccCodeBuildStartBuildAction_basic(t *testing.T) {",
+				"tfversion.SkipBelow(tfversion.Version1_14_0)",
+				"actions = [action.aws_codebuild_start_build.test]",
+			},
+		},
+		{
+			TestName: "website doc",
+			Template: websiteTmpl,
+			Contains: []string{
+				"page_title: \"AWS: aws_codebuild_start_build\"",
+				"# Action: aws_codebuild_start_build",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		for _, comments := range []bool{true, false} {
+			t.Run(testCase.TestName, func(t *testing.T) {
+				t.Parallel()
+
+				b, err := renderTemplate(testCase.TestName, testCase.Template, testTemplateData(comments))
+				if err != nil {
+					t.Fatalf("rendering template: %s", err)
+				}
+
+				if testCase.GoSource {
+					if _, err := format.Source(b); err != nil {
+						t.Fatalf("rendered template is not valid Go source: %s\n%s", err, b)
+					}
+				}
+
+				got := string(b)
+				for _, want := range testCase.Contains {
+					if !strings.Contains(got, want) {
+						t.Errorf("rendered template does not contain %q", want)
+					}
+				}
+
+				if hasTip := strings.Contains(got, "TIP:"); hasTip != comments && testCase.GoSource {
+					t.Errorf("rendered template contains TIP comments = %t, want %t", hasTip, comments)
+				}
+			})
+		}
+	}
+}
@@ -144,23 +144,17 @@ func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateD
 		return fmt.Errorf("file (%s) already exists and force is not set", filename)
 	}
 
-	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
-	if err != nil {
-		return fmt.Errorf("error opening file (%s): %s", filename, err)
-	}
-
-	tplate, err := template.New(templateName).Parse(tmpl)
+	b, err := renderTemplate(templateName, tmpl, td)
 	if err != nil {
-		return fmt.Errorf("error parsing template: %s", err)
+		return err
 	}
 
-	var buffer bytes.Buffer
-	err = tplate.Execute(&buffer, td)
+	f, err := os.OpenFile(filename, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0644)
 	if err != nil {
-		return fmt.Errorf("error executing template: %s", err)
+		return fmt.Errorf("error opening file (%s): %s", filename, err)
 	}
 
-	if _, err := f.Write(buffer.Bytes()); err != nil {
+	if _, err := f.Write(b); err != nil {
 		f.Close() // ignore error; Write error takes precedence
 		return fmt.Errorf("error writing to file (%s): %s", filename, err)
 	}
@@ -171,3 +165,18 @@ func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateD
 
 	return nil
 }
+
+func renderTemplate(templateName, tmpl string, td TemplateData) ([]byte, error) {
+	tplate, err := template.New(templateName).Parse(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	err = tplate.Execute(&buffer, td)
+	if err != nil {
+		return nil, fmt.Errorf("error executing template: %s", err)
+	}
+
+	return buffer.Bytes(), nil
+}
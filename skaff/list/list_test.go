@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package list
+
+import (
+	"go/format"
+	"strings"
+	"testing"
+)
+
+func testTemplateData(comments bool) TemplateData {
+	return TemplateData{
+		ListResource:           "Queue",
+		ListResourceLower:      "queue",
+		ListResourceLowerCamel: "queue",
+		ListResourceSnake:      "queue",
+		IncludeComments:        comments,
+		HumanFriendlyService:   "SQS",
+		SDKPackage:             "sqs",
+		ServicePackage:         "sqs",
+		Service:                "SQS",
+		ServiceLower:           "sqs",
+		AWSServiceName:         "Amazon SQS",
+		HumanListResourceName:  "Queue",
+		ProviderResourceName:   "aws_sqs_queue",
+	}
+}
+
+func TestRenderTemplate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		TestName string
+		Template string
+		GoSource bool
+		Contains []string
+	}{
+		{
+			TestName: "framework",
+			Template: listTmplFramework,
+			GoSource: true,
+			Contains: []string{
+				"request.IncludeResource",
+			},
+		},
+		{
+			TestName: "sdkv2",
+			Template: listTmplSdkV2,
+			GoSource: true,
+			Contains: []string{
+				"request.IncludeResource",
+			},
+		},
+		{
+			TestName: "list test",
+			Template: listTestTmpl,
+			GoSource: true,
+			Contains: []string{
+				"func TestAccSQSQueue_List_Basic(t *testing.T) {",
+				`tfquerycheck.ExpectNoResourceObject("aws_sqs_queue.test", tfqueryfilter.ByResourceIdentityFunc(identity1.Checks()))`,
+				`querycheck.ExpectResourceKnownValues("aws_sqs_queue.include", tfqueryfilter.ByResourceIdentityFunc(identity1.Checks()), []querycheck.KnownValueCheck{`,
+			},
+		},
+		{
+			TestName: "query",
+			Template: queryTmpl,
+			Contains: []string{
+				`list "aws_sqs_queue" "test" {`,
+				`list "aws_sqs_queue" "include" {`,
+				"include_resource = true",
+			},
+		},
+		{
+			TestName: "test config",
+			Template: lisTestConfigTmpl,
+			Contains: []string{
+				`resource "aws_sqs_queue" "test" {`,
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		for _, comments := range []bool{true, false} {
+			t.Run(testCase.TestName, func(t *testing.T) {
+				t.Parallel()
+
+				b, err := renderTemplate(testCase.TestName, testCase.Template, testTemplateData(comments))
+				if err != nil {
+					t.Fatalf("rendering template: %s", err)
+				}
+
+				if testCase.GoSource {
+					if _, err := format.Source(b); err != nil {
+						t.Fatalf("rendered template is not valid Go source: %s\n%s", err, b)
+					}
+				}
+
+				got := string(b)
+				for _, want := range testCase.Contains {
+					if !strings.Contains(got, want) {
+						t.Errorf("rendered template does not contain %q", want)
+					}
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright IBM Corp. 2014, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/provider/sdkv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/schemasnapshot"
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tschemasnapshot snapshot <output-file>\n")
+	fmt.Fprintf(os.Stderr, "\tschemasnapshot compare [-all] <old-snapshot-file> <new-snapshot-file>\n\n")
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	args := flag.Args()
+
+	if len(args) < 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	var err error
+
+	switch args[0] {
+	case "snapshot":
+		if len(args) != 2 {
+			flag.Usage()
+			os.Exit(2)
+		}
+		err = snapshot(context.Background(), args[1])
+	case "compare":
+		flags := flag.NewFlagSet("compare", flag.ExitOnError)
+		all := flags.Bool("all", false, "Report non-breaking changes")
+		flags.Usage = usage
+		flags.Parse(args[1:]) //nolint:errcheck // flag.ExitOnError
+
+		if flags.NArg() != 2 {
+			flag.Usage()
+			os.Exit(2)
+		}
+
+		var breaking bool
+		breaking, err = compare(flags.Arg(0), flags.Arg(1), *all)
+		if err == nil && breaking {
+			os.Exit(1)
+		}
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err)
+		os.Exit(2)
+	}
+}
+
+func snapshot(ctx context.Context, filename string) error {
+	primary, err := sdkv2.NewProvider(ctx)
+	if err != nil {
+		return fmt.Errorf("creating SDKv2 provider: %w", err)
+	}
+
+	secondary, err := framework.NewProvider(ctx, primary)
+	if err != nil {
+		return fmt.Errorf("creating Framework provider: %w", err)
+	}
+
+	s := schemasnapshot.New()
+
+	if err := s.AddSDKv2(primary); err != nil {
+		return err
+	}
+
+	if err := s.AddFramework(ctx, secondary); err != nil {
+		return err
+	}
+
+	f, err := os.Create(filename)
+	if err != nil {
+		return fmt.Errorf("creating %s: %w", filename, err)
+	}
+	defer f.Close()
+
+	return s.Write(f)
+}
+
+func compare(oldFilename, newFilename string, all bool) (bool, error) {
+	oldSnapshot, err := readSnapshot(oldFilename)
+	if err != nil {
+		return false, err
+	}
+
+	newSnapshot, err := readSnapshot(newFilename)
+	if err != nil {
+		return false, err
+	}
+
+	changes := schemasnapshot.Compare(oldSnapshot, newSnapshot)
+
+	for _, change := range changes {
+		if all || change.Breaking {
+			fmt.Fprintln(os.Stdout, change.String())
+		}
+	}
+
+	return schemasnapshot.HasBreakingChanges(changes), nil
+}
+
+func readSnapshot(filename string) (*schemasnapshot.Snapshot, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, fmt.Errorf("opening %s: %w", filename, err)
+	}
+	defer f.Close()
+
+	s, err := schemasnapshot.Read(f)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", filename, err)
+	}
+
+	return s, nil
+}